@@ -163,7 +163,7 @@ export interface GetUsersByIDsResponse {
 export interface Guild {
 	id: string;
 	name: string;
-	ownerId: string;
+	ownerId?: string;
 	description: string;
 	iconUrl: string;
 	defaultChannelId: string;
@@ -174,7 +174,7 @@ export interface Guild {
 export interface GuildDetail {
 	id: string;
 	name: string;
-	ownerId: string;
+	ownerId?: string;
 	description: string;
 	iconUrl: string;
 	defaultChannelId: string;
@@ -185,7 +185,7 @@ export interface GuildDetail {
 export interface GuildWithMemberCount {
 	id: string;
 	name: string;
-	ownerId: string;
+	ownerId?: string;
 	description: string;
 	iconUrl: string;
 	defaultChannelId: string;
@@ -196,7 +196,7 @@ export interface GuildWithMemberCount {
 export interface GuildWithMembers {
 	id: string;
 	name: string;
-	ownerId: string;
+	ownerId?: string;
 	description: string;
 	iconUrl: string;
 	defaultChannelId: string;
@@ -218,8 +218,10 @@ func (x *GetGuildOverviewResponse) GetGuild() *GuildDetail {
 }
 
 type GetGuildByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// trueの場合、メンバーごとに現在のオンライン状態を返す
+	WithPresence  bool `protobuf:"varint,2,opt,name=with_presence,json=withPresence,proto3" json:"with_presence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +263,13 @@ func (x *GetGuildByIDRequest) GetGuildId() string {
 	return ""
 }
 
+func (x *GetGuildByIDRequest) GetWithPresence() bool {
+	if x != nil {
+		return x.WithPresence
+	}
+	return false
+}
+
 type GetGuildByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Guild         *GuildWithMembers      `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
@@ -505,28 +514,27 @@ func (x *UpdateGuildResponse) GetGuild() *Guild {
 	return nil
 }
 
-type DeleteGuildMemberRequest struct {
+type DeleteGuildRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGuildMemberRequest) Reset() {
-	*x = DeleteGuildMemberRequest{}
+func (x *DeleteGuildRequest) Reset() {
+	*x = DeleteGuildRequest{}
 	mi := &file_guild_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGuildMemberRequest) String() string {
+func (x *DeleteGuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGuildMemberRequest) ProtoMessage() {}
+func (*DeleteGuildRequest) ProtoMessage() {}
 
-func (x *DeleteGuildMemberRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteGuildRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,46 +546,39 @@ func (x *DeleteGuildMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGuildMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGuildMemberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGuildRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuildRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteGuildMemberRequest) GetGuildId() string {
+func (x *DeleteGuildRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-func (x *DeleteGuildMemberRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type DeleteGuildMemberResponse struct {
+type DeleteGuildResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGuildMemberResponse) Reset() {
-	*x = DeleteGuildMemberResponse{}
+func (x *DeleteGuildResponse) Reset() {
+	*x = DeleteGuildResponse{}
 	mi := &file_guild_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGuildMemberResponse) String() string {
+func (x *DeleteGuildResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGuildMemberResponse) ProtoMessage() {}
+func (*DeleteGuildResponse) ProtoMessage() {}
 
-func (x *DeleteGuildMemberResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteGuildResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,39 +590,41 @@ func (x *DeleteGuildMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGuildMemberResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGuildMemberResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGuildResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGuildResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteGuildMemberResponse) GetEmpty() *emptypb.Empty {
+func (x *DeleteGuildResponse) GetEmpty() *emptypb.Empty {
 	if x != nil {
 		return x.Empty
 	}
 	return nil
 }
 
-type LeaveGuildRequest struct {
+type TransferGuildOwnershipRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveGuildRequest) Reset() {
-	*x = LeaveGuildRequest{}
+func (x *TransferGuildOwnershipRequest) Reset() {
+	*x = TransferGuildOwnershipRequest{}
 	mi := &file_guild_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveGuildRequest) String() string {
+func (x *TransferGuildOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveGuildRequest) ProtoMessage() {}
+func (*TransferGuildOwnershipRequest) ProtoMessage() {}
 
-func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
+func (x *TransferGuildOwnershipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,39 +636,53 @@ func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveGuildRequest.ProtoReflect.Descriptor instead.
-func (*LeaveGuildRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferGuildOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferGuildOwnershipRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LeaveGuildRequest) GetGuildId() string {
+func (x *TransferGuildOwnershipRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-type LeaveGuildResponse struct {
+func (x *TransferGuildOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+func (x *TransferGuildOwnershipRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type TransferGuildOwnershipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	Guild         *Guild                 `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveGuildResponse) Reset() {
-	*x = LeaveGuildResponse{}
+func (x *TransferGuildOwnershipResponse) Reset() {
+	*x = TransferGuildOwnershipResponse{}
 	mi := &file_guild_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveGuildResponse) String() string {
+func (x *TransferGuildOwnershipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveGuildResponse) ProtoMessage() {}
+func (*TransferGuildOwnershipResponse) ProtoMessage() {}
 
-func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
+func (x *TransferGuildOwnershipResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -677,39 +694,46 @@ func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveGuildResponse.ProtoReflect.Descriptor instead.
-func (*LeaveGuildResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferGuildOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferGuildOwnershipResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LeaveGuildResponse) GetEmpty() *emptypb.Empty {
+func (x *TransferGuildOwnershipResponse) GetGuild() *Guild {
 	if x != nil {
-		return x.Empty
+		return x.Guild
 	}
 	return nil
 }
 
-type GetGuildInvitesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+type ListAuditLogRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ActorId *string                `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	// GUILD_UPDATE, CHANNEL_CREATE などの操作の種類
+	ActionType *string `protobuf:"bytes,3,opt,name=action_type,json=actionType,proto3,oneof" json:"action_type,omitempty"`
+	TargetId   *string `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	// 前回のレスポンスのnext_cursor
+	Cursor        *string `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32  `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGuildInvitesRequest) Reset() {
-	*x = GetGuildInvitesRequest{}
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
 	mi := &file_guild_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGuildInvitesRequest) String() string {
+func (x *ListAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGuildInvitesRequest) ProtoMessage() {}
+func (*ListAuditLogRequest) ProtoMessage() {}
 
-func (x *GetGuildInvitesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,39 +745,77 @@ func (x *GetGuildInvitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGuildInvitesRequest.ProtoReflect.Descriptor instead.
-func (*GetGuildInvitesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetGuildInvitesRequest) GetGuildId() string {
+func (x *ListAuditLogRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-type GetGuildInvitesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+func (x *ListAuditLogRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetActionType() string {
+	if x != nil && x.ActionType != nil {
+		return *x.ActionType
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// created_atの降順で返す
+	Entries       []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	HasMore       bool             `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    *string          `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGuildInvitesResponse) Reset() {
-	*x = GetGuildInvitesResponse{}
+func (x *ListAuditLogResponse) Reset() {
+	*x = ListAuditLogResponse{}
 	mi := &file_guild_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGuildInvitesResponse) String() string {
+func (x *ListAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGuildInvitesResponse) ProtoMessage() {}
+func (*ListAuditLogResponse) ProtoMessage() {}
 
-func (x *GetGuildInvitesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListAuditLogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,39 +827,54 @@ func (x *GetGuildInvitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGuildInvitesResponse.ProtoReflect.Descriptor instead.
-func (*GetGuildInvitesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetGuildInvitesResponse) GetInvites() []*Invite {
+func (x *ListAuditLogResponse) GetEntries() []*AuditLogEntry {
 	if x != nil {
-		return x.Invites
+		return x.Entries
 	}
 	return nil
 }
 
-type GetGuildByInviteCodeRequest struct {
+func (x *ListAuditLogResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListAuditLogResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type DeleteGuildMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGuildByInviteCodeRequest) Reset() {
-	*x = GetGuildByInviteCodeRequest{}
+func (x *DeleteGuildMemberRequest) Reset() {
+	*x = DeleteGuildMemberRequest{}
 	mi := &file_guild_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGuildByInviteCodeRequest) String() string {
+func (x *DeleteGuildMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGuildByInviteCodeRequest) ProtoMessage() {}
+func (*DeleteGuildMemberRequest) ProtoMessage() {}
 
-func (x *GetGuildByInviteCodeRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteGuildMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,39 +886,46 @@ func (x *GetGuildByInviteCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGuildByInviteCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetGuildByInviteCodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGuildMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuildMemberRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetGuildByInviteCodeRequest) GetInviteCode() string {
+func (x *DeleteGuildMemberRequest) GetGuildId() string {
 	if x != nil {
-		return x.InviteCode
+		return x.GuildId
 	}
 	return ""
 }
 
-type GetGuildByInviteCodeResponse struct {
+func (x *DeleteGuildMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteGuildMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGuildByInviteCodeResponse) Reset() {
-	*x = GetGuildByInviteCodeResponse{}
+func (x *DeleteGuildMemberResponse) Reset() {
+	*x = DeleteGuildMemberResponse{}
 	mi := &file_guild_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGuildByInviteCodeResponse) String() string {
+func (x *DeleteGuildMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGuildByInviteCodeResponse) ProtoMessage() {}
+func (*DeleteGuildMemberResponse) ProtoMessage() {}
 
-func (x *GetGuildByInviteCodeResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteGuildMemberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,41 +937,39 @@ func (x *GetGuildByInviteCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGuildByInviteCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetGuildByInviteCodeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteGuildMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGuildMemberResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGuildByInviteCodeResponse) GetInvite() *Invite {
+func (x *DeleteGuildMemberResponse) GetEmpty() *emptypb.Empty {
 	if x != nil {
-		return x.Invite
+		return x.Empty
 	}
 	return nil
 }
 
-type CreateGuildInviteRequest struct {
+type LeaveGuildRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	MaxUses       *int32                 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGuildInviteRequest) Reset() {
-	*x = CreateGuildInviteRequest{}
+func (x *LeaveGuildRequest) Reset() {
+	*x = LeaveGuildRequest{}
 	mi := &file_guild_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuildInviteRequest) String() string {
+func (x *LeaveGuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuildInviteRequest) ProtoMessage() {}
+func (*LeaveGuildRequest) ProtoMessage() {}
 
-func (x *CreateGuildInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,53 +981,39 @@ func (x *CreateGuildInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGuildInviteRequest.ProtoReflect.Descriptor instead.
-func (*CreateGuildInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveGuildRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGuildRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateGuildInviteRequest) GetGuildId() string {
+func (x *LeaveGuildRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-func (x *CreateGuildInviteRequest) GetMaxUses() int32 {
-	if x != nil && x.MaxUses != nil {
-		return *x.MaxUses
-	}
-	return 0
-}
-
-func (x *CreateGuildInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return nil
-}
-
-type CreateGuildInviteResponse struct {
+type LeaveGuildResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGuildInviteResponse) Reset() {
-	*x = CreateGuildInviteResponse{}
+func (x *LeaveGuildResponse) Reset() {
+	*x = LeaveGuildResponse{}
 	mi := &file_guild_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuildInviteResponse) String() string {
+func (x *LeaveGuildResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuildInviteResponse) ProtoMessage() {}
+func (*LeaveGuildResponse) ProtoMessage() {}
 
-func (x *CreateGuildInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -957,39 +1025,42 @@ func (x *CreateGuildInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGuildInviteResponse.ProtoReflect.Descriptor instead.
-func (*CreateGuildInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaveGuildResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGuildResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateGuildInviteResponse) GetInvite() *Invite {
+func (x *LeaveGuildResponse) GetEmpty() *emptypb.Empty {
 	if x != nil {
-		return x.Invite
+		return x.Empty
 	}
 	return nil
 }
 
-type DeleteGuildInviteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TimeoutMemberRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 省略した場合はタイムアウトを解除する。最大28日後
+	CommunicationDisabledUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=communication_disabled_until,json=communicationDisabledUntil,proto3,oneof" json:"communication_disabled_until,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *DeleteGuildInviteRequest) Reset() {
-	*x = DeleteGuildInviteRequest{}
+func (x *TimeoutMemberRequest) Reset() {
+	*x = TimeoutMemberRequest{}
 	mi := &file_guild_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGuildInviteRequest) String() string {
+func (x *TimeoutMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGuildInviteRequest) ProtoMessage() {}
+func (*TimeoutMemberRequest) ProtoMessage() {}
 
-func (x *DeleteGuildInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *TimeoutMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,39 +1072,53 @@ func (x *DeleteGuildInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGuildInviteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGuildInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeoutMemberRequest.ProtoReflect.Descriptor instead.
+func (*TimeoutMemberRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteGuildInviteRequest) GetInviteCode() string {
+func (x *TimeoutMemberRequest) GetGuildId() string {
 	if x != nil {
-		return x.InviteCode
+		return x.GuildId
 	}
 	return ""
 }
 
-type DeleteGuildInviteResponse struct {
+func (x *TimeoutMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimeoutMemberRequest) GetCommunicationDisabledUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommunicationDisabledUntil
+	}
+	return nil
+}
+
+type TimeoutMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGuildInviteResponse) Reset() {
-	*x = DeleteGuildInviteResponse{}
+func (x *TimeoutMemberResponse) Reset() {
+	*x = TimeoutMemberResponse{}
 	mi := &file_guild_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGuildInviteResponse) String() string {
+func (x *TimeoutMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGuildInviteResponse) ProtoMessage() {}
+func (*TimeoutMemberResponse) ProtoMessage() {}
 
-func (x *DeleteGuildInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *TimeoutMemberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,39 +1130,45 @@ func (x *DeleteGuildInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGuildInviteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGuildInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeoutMemberResponse.ProtoReflect.Descriptor instead.
+func (*TimeoutMemberResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteGuildInviteResponse) GetEmpty() *emptypb.Empty {
+func (x *TimeoutMemberResponse) GetMember() *Member {
 	if x != nil {
-		return x.Empty
+		return x.Member
 	}
 	return nil
 }
 
-type JoinGuildRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BanMemberRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason  string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 省略した場合は無期限
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// 指定した秒数以内に送信されたメッセージを削除する。最大7日。削除に失敗してもBANは成功として返す
+	DeleteMessageSeconds int32 `protobuf:"varint,5,opt,name=delete_message_seconds,json=deleteMessageSeconds,proto3" json:"delete_message_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *JoinGuildRequest) Reset() {
-	*x = JoinGuildRequest{}
+func (x *BanMemberRequest) Reset() {
+	*x = BanMemberRequest{}
 	mi := &file_guild_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGuildRequest) String() string {
+func (x *BanMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGuildRequest) ProtoMessage() {}
+func (*BanMemberRequest) ProtoMessage() {}
 
-func (x *JoinGuildRequest) ProtoReflect() protoreflect.Message {
+func (x *BanMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,39 +1180,67 @@ func (x *JoinGuildRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGuildRequest.ProtoReflect.Descriptor instead.
-func (*JoinGuildRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanMemberRequest.ProtoReflect.Descriptor instead.
+func (*BanMemberRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *JoinGuildRequest) GetInviteCode() string {
+func (x *BanMemberRequest) GetGuildId() string {
 	if x != nil {
-		return x.InviteCode
+		return x.GuildId
 	}
 	return ""
 }
 
-type JoinGuildResponse struct {
+func (x *BanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BanMemberRequest) GetDeleteMessageSeconds() int32 {
+	if x != nil {
+		return x.DeleteMessageSeconds
+	}
+	return 0
+}
+
+type BanMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Ban           *Ban                   `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinGuildResponse) Reset() {
-	*x = JoinGuildResponse{}
+func (x *BanMemberResponse) Reset() {
+	*x = BanMemberResponse{}
 	mi := &file_guild_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGuildResponse) String() string {
+func (x *BanMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGuildResponse) ProtoMessage() {}
+func (*BanMemberResponse) ProtoMessage() {}
 
-func (x *JoinGuildResponse) ProtoReflect() protoreflect.Message {
+func (x *BanMemberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,40 +1252,40 @@ func (x *JoinGuildResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGuildResponse.ProtoReflect.Descriptor instead.
-func (*JoinGuildResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanMemberResponse.ProtoReflect.Descriptor instead.
+func (*BanMemberResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *JoinGuildResponse) GetMember() *Member {
+func (x *BanMemberResponse) GetBan() *Ban {
 	if x != nil {
-		return x.Member
+		return x.Ban
 	}
 	return nil
 }
 
-type CreateCategoryRequest struct {
+type UnbanMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
+func (x *UnbanMemberRequest) Reset() {
+	*x = UnbanMemberRequest{}
 	mi := &file_guild_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *UnbanMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*UnbanMemberRequest) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+func (x *UnbanMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1178,46 +1297,46 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnbanMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnbanMemberRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateCategoryRequest) GetGuildId() string {
+func (x *UnbanMemberRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *UnbanMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-type CreateCategoryResponse struct {
+type UnbanMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
+func (x *UnbanMemberResponse) Reset() {
+	*x = UnbanMemberResponse{}
 	mi := &file_guild_message_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *UnbanMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
+func (*UnbanMemberResponse) ProtoMessage() {}
 
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+func (x *UnbanMemberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1229,40 +1348,39 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnbanMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnbanMemberResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateCategoryResponse) GetCategory() *Category {
+func (x *UnbanMemberResponse) GetEmpty() *emptypb.Empty {
 	if x != nil {
-		return x.Category
+		return x.Empty
 	}
 	return nil
 }
 
-type UpdateCategoryRequest struct {
+type ListBansRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
 	mi := &file_guild_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *ListBansRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*ListBansRequest) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,46 +1392,40 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateCategoryRequest) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
-	}
-	return ""
-}
-
-func (x *UpdateCategoryRequest) GetName() string {
+func (x *ListBansRequest) GetGuildId() string {
 	if x != nil {
-		return x.Name
+		return x.GuildId
 	}
 	return ""
 }
 
-type UpdateCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+type ListBansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 期限切れのものは含まない
+	Bans          []*Ban `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryResponse) Reset() {
-	*x = UpdateCategoryResponse{}
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
 	mi := &file_guild_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryResponse) String() string {
+func (x *ListBansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryResponse) ProtoMessage() {}
+func (*ListBansResponse) ProtoMessage() {}
 
-func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,39 +1437,39 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateCategoryResponse) GetCategory() *Category {
+func (x *ListBansResponse) GetBans() []*Ban {
 	if x != nil {
-		return x.Category
+		return x.Bans
 	}
 	return nil
 }
 
-type DeleteCategoryRequest struct {
+type GetGuildInvitesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryRequest) Reset() {
-	*x = DeleteCategoryRequest{}
+func (x *GetGuildInvitesRequest) Reset() {
+	*x = GetGuildInvitesRequest{}
 	mi := &file_guild_message_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryRequest) String() string {
+func (x *GetGuildInvitesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryRequest) ProtoMessage() {}
+func (*GetGuildInvitesRequest) ProtoMessage() {}
 
-func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGuildInvitesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_guild_message_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,40 +1481,2718 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGuildInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetGuildInvitesRequest) Descriptor() ([]byte, []int) {
 	return file_guild_message_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteCategoryRequest) GetCategoryId() string {
+func (x *GetGuildInvitesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+type GetGuildInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildInvitesResponse) Reset() {
+	*x = GetGuildInvitesResponse{}
+	mi := &file_guild_message_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildInvitesResponse) ProtoMessage() {}
+
+func (x *GetGuildInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetGuildInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetGuildInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type GetGuildByInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildByInviteCodeRequest) Reset() {
+	*x = GetGuildByInviteCodeRequest{}
+	mi := &file_guild_message_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildByInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildByInviteCodeRequest) ProtoMessage() {}
+
+func (x *GetGuildByInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildByInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetGuildByInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetGuildByInviteCodeRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type GetGuildByInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuildByInviteCodeResponse) Reset() {
+	*x = GetGuildByInviteCodeResponse{}
+	mi := &file_guild_message_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuildByInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuildByInviteCodeResponse) ProtoMessage() {}
+
+func (x *GetGuildByInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuildByInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetGuildByInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGuildByInviteCodeResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type CreateGuildInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	MaxUses       *int32                 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuildInviteRequest) Reset() {
+	*x = CreateGuildInviteRequest{}
+	mi := &file_guild_message_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuildInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildInviteRequest) ProtoMessage() {}
+
+func (x *CreateGuildInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuildInviteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateGuildInviteRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CreateGuildInviteRequest) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateGuildInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateGuildInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuildInviteResponse) Reset() {
+	*x = CreateGuildInviteResponse{}
+	mi := &file_guild_message_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuildInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuildInviteResponse) ProtoMessage() {}
+
+func (x *CreateGuildInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuildInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuildInviteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateGuildInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type DeleteGuildInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuildInviteRequest) Reset() {
+	*x = DeleteGuildInviteRequest{}
+	mi := &file_guild_message_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuildInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuildInviteRequest) ProtoMessage() {}
+
+func (x *DeleteGuildInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuildInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuildInviteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteGuildInviteRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type DeleteGuildInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuildInviteResponse) Reset() {
+	*x = DeleteGuildInviteResponse{}
+	mi := &file_guild_message_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuildInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuildInviteResponse) ProtoMessage() {}
+
+func (x *DeleteGuildInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuildInviteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGuildInviteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteGuildInviteResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type SetGuildVanityInviteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// 3〜16文字の英小文字、数字、ハイフン
+	InviteCode    string `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGuildVanityInviteRequest) Reset() {
+	*x = SetGuildVanityInviteRequest{}
+	mi := &file_guild_message_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGuildVanityInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildVanityInviteRequest) ProtoMessage() {}
+
+func (x *SetGuildVanityInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildVanityInviteRequest.ProtoReflect.Descriptor instead.
+func (*SetGuildVanityInviteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetGuildVanityInviteRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *SetGuildVanityInviteRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type SetGuildVanityInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGuildVanityInviteResponse) Reset() {
+	*x = SetGuildVanityInviteResponse{}
+	mi := &file_guild_message_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGuildVanityInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGuildVanityInviteResponse) ProtoMessage() {}
+
+func (x *SetGuildVanityInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGuildVanityInviteResponse.ProtoReflect.Descriptor instead.
+func (*SetGuildVanityInviteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetGuildVanityInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListGuildInviteUsesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuildInviteUsesRequest) Reset() {
+	*x = ListGuildInviteUsesRequest{}
+	mi := &file_guild_message_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuildInviteUsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuildInviteUsesRequest) ProtoMessage() {}
+
+func (x *ListGuildInviteUsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuildInviteUsesRequest.ProtoReflect.Descriptor instead.
+func (*ListGuildInviteUsesRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListGuildInviteUsesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ListGuildInviteUsesRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type ListGuildInviteUsesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新しい順
+	Uses          []*InviteUse `protobuf:"bytes,1,rep,name=uses,proto3" json:"uses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuildInviteUsesResponse) Reset() {
+	*x = ListGuildInviteUsesResponse{}
+	mi := &file_guild_message_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuildInviteUsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuildInviteUsesResponse) ProtoMessage() {}
+
+func (x *ListGuildInviteUsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuildInviteUsesResponse.ProtoReflect.Descriptor instead.
+func (*ListGuildInviteUsesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListGuildInviteUsesResponse) GetUses() []*InviteUse {
+	if x != nil {
+		return x.Uses
+	}
+	return nil
+}
+
+type JoinGuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGuildRequest) Reset() {
+	*x = JoinGuildRequest{}
+	mi := &file_guild_message_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGuildRequest) ProtoMessage() {}
+
+func (x *JoinGuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGuildRequest.ProtoReflect.Descriptor instead.
+func (*JoinGuildRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *JoinGuildRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type JoinGuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGuildResponse) Reset() {
+	*x = JoinGuildResponse{}
+	mi := &file_guild_message_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGuildResponse) ProtoMessage() {}
+
+func (x *JoinGuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGuildResponse.ProtoReflect.Descriptor instead.
+func (*JoinGuildResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *JoinGuildResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_guild_message_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateCategoryRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_guild_message_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_guild_message_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_guild_message_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_guild_message_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_guild_message_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteCategoryResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	mi := &file_guild_message_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateChannelRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
+	mi := &file_guild_message_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelResponse) ProtoMessage() {}
+
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateChannelResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChannelRequest) Reset() {
+	*x = UpdateChannelRequest{}
+	mi := &file_guild_message_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelRequest) ProtoMessage() {}
+
+func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UpdateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChannelResponse) Reset() {
+	*x = UpdateChannelResponse{}
+	mi := &file_guild_message_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelResponse) ProtoMessage() {}
+
+func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChannelResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateChannelResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type DeleteChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
+	mi := &file_guild_message_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelRequest) ProtoMessage() {}
+
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type DeleteChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelResponse) Reset() {
+	*x = DeleteChannelResponse{}
+	mi := &file_guild_message_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelResponse) ProtoMessage() {}
+
+func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteChannelResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ReorderChannelsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// ギルドのすべてのカテゴリーを上から順に並べ、それぞれにすべてのチャンネルを振り分けたもの。
+	// チャンネルを別のカテゴリーに入れると移動する
+	Categories    []*CategoryLayout `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChannelsRequest) Reset() {
+	*x = ReorderChannelsRequest{}
+	mi := &file_guild_message_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChannelsRequest) ProtoMessage() {}
+
+func (x *ReorderChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReorderChannelsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ReorderChannelsRequest) GetCategories() []*CategoryLayout {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ReorderChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryLayout      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChannelsResponse) Reset() {
+	*x = ReorderChannelsResponse{}
+	mi := &file_guild_message_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChannelsResponse) ProtoMessage() {}
+
+func (x *ReorderChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReorderChannelsResponse) GetCategories() []*CategoryLayout {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_guild_message_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListRolesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+type ListRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// positionの昇順。先頭は@everyone
+	Roles         []*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_guild_message_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 自分が持っていない権限は付けられない
+	Permissions   int64 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_guild_message_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateRoleRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type CreateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_guild_message_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoleId string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// @everyoneの名前は変えられない
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   int64  `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_guild_message_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_guild_message_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_guild_message_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_guild_message_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteRoleResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ReorderRolesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// @everyone以外のすべてのロールを、下から上の順に並べる
+	RoleIds       []string `protobuf:"bytes,2,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRolesRequest) Reset() {
+	*x = ReorderRolesRequest{}
+	mi := &file_guild_message_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRolesRequest) ProtoMessage() {}
+
+func (x *ReorderRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRolesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRolesRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReorderRolesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ReorderRolesRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+type ReorderRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRolesResponse) Reset() {
+	*x = ReorderRolesResponse{}
+	mi := &file_guild_message_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRolesResponse) ProtoMessage() {}
+
+func (x *ReorderRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRolesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderRolesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReorderRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type AddMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberRoleRequest) Reset() {
+	*x = AddMemberRoleRequest{}
+	mi := &file_guild_message_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberRoleRequest) ProtoMessage() {}
+
+func (x *AddMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*AddMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddMemberRoleRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *AddMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddMemberRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type AddMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberRoleResponse) Reset() {
+	*x = AddMemberRoleResponse{}
+	mi := &file_guild_message_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberRoleResponse) ProtoMessage() {}
+
+func (x *AddMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*AddMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AddMemberRoleResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type RemoveMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRoleRequest) Reset() {
+	*x = RemoveMemberRoleRequest{}
+	mi := &file_guild_message_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRoleRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveMemberRoleRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type RemoveMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRoleResponse) Reset() {
+	*x = RemoveMemberRoleResponse{}
+	mi := &file_guild_message_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRoleResponse) ProtoMessage() {}
+
+func (x *RemoveMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveMemberRoleResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ListChannelPermissionOverwritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelPermissionOverwritesRequest) Reset() {
+	*x = ListChannelPermissionOverwritesRequest{}
+	mi := &file_guild_message_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelPermissionOverwritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelPermissionOverwritesRequest) ProtoMessage() {}
+
+func (x *ListChannelPermissionOverwritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelPermissionOverwritesRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelPermissionOverwritesRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListChannelPermissionOverwritesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListChannelPermissionOverwritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrites    []*PermissionOverwrite `protobuf:"bytes,1,rep,name=overwrites,proto3" json:"overwrites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelPermissionOverwritesResponse) Reset() {
+	*x = ListChannelPermissionOverwritesResponse{}
+	mi := &file_guild_message_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelPermissionOverwritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelPermissionOverwritesResponse) ProtoMessage() {}
+
+func (x *ListChannelPermissionOverwritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelPermissionOverwritesResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelPermissionOverwritesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListChannelPermissionOverwritesResponse) GetOverwrites() []*PermissionOverwrite {
+	if x != nil {
+		return x.Overwrites
+	}
+	return nil
+}
+
+type SetChannelPermissionOverwriteRequest struct {
+	state      protoimpl.MessageState        `protogen:"open.v1"`
+	ChannelId  string                        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TargetId   string                        `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetType PermissionOverwriteTargetType `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=guild.PermissionOverwriteTargetType" json:"target_type,omitempty"`
+	// チャンネルに関係する権限だけを指定でき、allowとdenyで同じビットは指定できない
+	Allow         int64 `protobuf:"varint,4,opt,name=allow,proto3" json:"allow,omitempty"`
+	Deny          int64 `protobuf:"varint,5,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelPermissionOverwriteRequest) Reset() {
+	*x = SetChannelPermissionOverwriteRequest{}
+	mi := &file_guild_message_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelPermissionOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelPermissionOverwriteRequest) ProtoMessage() {}
+
+func (x *SetChannelPermissionOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelPermissionOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelPermissionOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetChannelPermissionOverwriteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SetChannelPermissionOverwriteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SetChannelPermissionOverwriteRequest) GetTargetType() PermissionOverwriteTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *SetChannelPermissionOverwriteRequest) GetAllow() int64 {
+	if x != nil {
+		return x.Allow
+	}
+	return 0
+}
+
+func (x *SetChannelPermissionOverwriteRequest) GetDeny() int64 {
+	if x != nil {
+		return x.Deny
+	}
+	return 0
+}
+
+type SetChannelPermissionOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrite     *PermissionOverwrite   `protobuf:"bytes,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelPermissionOverwriteResponse) Reset() {
+	*x = SetChannelPermissionOverwriteResponse{}
+	mi := &file_guild_message_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelPermissionOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelPermissionOverwriteResponse) ProtoMessage() {}
+
+func (x *SetChannelPermissionOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelPermissionOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelPermissionOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetChannelPermissionOverwriteResponse) GetOverwrite() *PermissionOverwrite {
+	if x != nil {
+		return x.Overwrite
+	}
+	return nil
+}
+
+type DeleteChannelPermissionOverwriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelPermissionOverwriteRequest) Reset() {
+	*x = DeleteChannelPermissionOverwriteRequest{}
+	mi := &file_guild_message_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelPermissionOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelPermissionOverwriteRequest) ProtoMessage() {}
+
+func (x *DeleteChannelPermissionOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelPermissionOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelPermissionOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteChannelPermissionOverwriteRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DeleteChannelPermissionOverwriteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type DeleteChannelPermissionOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelPermissionOverwriteResponse) Reset() {
+	*x = DeleteChannelPermissionOverwriteResponse{}
+	mi := &file_guild_message_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelPermissionOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelPermissionOverwriteResponse) ProtoMessage() {}
+
+func (x *DeleteChannelPermissionOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelPermissionOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelPermissionOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteChannelPermissionOverwriteResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ListCategoryPermissionOverwritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryPermissionOverwritesRequest) Reset() {
+	*x = ListCategoryPermissionOverwritesRequest{}
+	mi := &file_guild_message_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryPermissionOverwritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryPermissionOverwritesRequest) ProtoMessage() {}
+
+func (x *ListCategoryPermissionOverwritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryPermissionOverwritesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryPermissionOverwritesRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCategoryPermissionOverwritesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type ListCategoryPermissionOverwritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrites    []*PermissionOverwrite `protobuf:"bytes,1,rep,name=overwrites,proto3" json:"overwrites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryPermissionOverwritesResponse) Reset() {
+	*x = ListCategoryPermissionOverwritesResponse{}
+	mi := &file_guild_message_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryPermissionOverwritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryPermissionOverwritesResponse) ProtoMessage() {}
+
+func (x *ListCategoryPermissionOverwritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryPermissionOverwritesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryPermissionOverwritesResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListCategoryPermissionOverwritesResponse) GetOverwrites() []*PermissionOverwrite {
+	if x != nil {
+		return x.Overwrites
+	}
+	return nil
+}
+
+type SetCategoryPermissionOverwriteRequest struct {
+	state      protoimpl.MessageState        `protogen:"open.v1"`
+	CategoryId string                        `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TargetId   string                        `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetType PermissionOverwriteTargetType `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=guild.PermissionOverwriteTargetType" json:"target_type,omitempty"`
+	// チャンネルに関係する権限だけを指定でき、allowとdenyで同じビットは指定できない
+	Allow         int64 `protobuf:"varint,4,opt,name=allow,proto3" json:"allow,omitempty"`
+	Deny          int64 `protobuf:"varint,5,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) Reset() {
+	*x = SetCategoryPermissionOverwriteRequest{}
+	mi := &file_guild_message_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryPermissionOverwriteRequest) ProtoMessage() {}
+
+func (x *SetCategoryPermissionOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryPermissionOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryPermissionOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) GetTargetType() PermissionOverwriteTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) GetAllow() int64 {
+	if x != nil {
+		return x.Allow
+	}
+	return 0
+}
+
+func (x *SetCategoryPermissionOverwriteRequest) GetDeny() int64 {
+	if x != nil {
+		return x.Deny
+	}
+	return 0
+}
+
+type SetCategoryPermissionOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overwrite     *PermissionOverwrite   `protobuf:"bytes,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryPermissionOverwriteResponse) Reset() {
+	*x = SetCategoryPermissionOverwriteResponse{}
+	mi := &file_guild_message_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryPermissionOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryPermissionOverwriteResponse) ProtoMessage() {}
+
+func (x *SetCategoryPermissionOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryPermissionOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryPermissionOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetCategoryPermissionOverwriteResponse) GetOverwrite() *PermissionOverwrite {
+	if x != nil {
+		return x.Overwrite
+	}
+	return nil
+}
+
+type DeleteCategoryPermissionOverwriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryPermissionOverwriteRequest) Reset() {
+	*x = DeleteCategoryPermissionOverwriteRequest{}
+	mi := &file_guild_message_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryPermissionOverwriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryPermissionOverwriteRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryPermissionOverwriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryPermissionOverwriteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryPermissionOverwriteRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteCategoryPermissionOverwriteRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *DeleteCategoryPermissionOverwriteRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type DeleteCategoryPermissionOverwriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryPermissionOverwriteResponse) Reset() {
+	*x = DeleteCategoryPermissionOverwriteResponse{}
+	mi := &file_guild_message_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryPermissionOverwriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryPermissionOverwriteResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryPermissionOverwriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryPermissionOverwriteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryPermissionOverwriteResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteCategoryPermissionOverwriteResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type CheckChannelAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckChannelAccessRequest) Reset() {
+	*x = CheckChannelAccessRequest{}
+	mi := &file_guild_message_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckChannelAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckChannelAccessRequest) ProtoMessage() {}
+
+func (x *CheckChannelAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckChannelAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckChannelAccessRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CheckChannelAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckChannelAccessRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type CheckChannelAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// チャンネルが存在しないか見られない場合はすべてfalse
+	Permissions *ChannelPermissions `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	// タイムアウト中の場合のみ設定される。解除されるまでメッセージの送信やリアクションはできない
+	CommunicationDisabledUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=communication_disabled_until,json=communicationDisabledUntil,proto3,oneof" json:"communication_disabled_until,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CheckChannelAccessResponse) Reset() {
+	*x = CheckChannelAccessResponse{}
+	mi := &file_guild_message_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckChannelAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckChannelAccessResponse) ProtoMessage() {}
+
+func (x *CheckChannelAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckChannelAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckChannelAccessResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CheckChannelAccessResponse) GetPermissions() *ChannelPermissions {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CheckChannelAccessResponse) GetCommunicationDisabledUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CategoryId
+		return x.CommunicationDisabledUntil
 	}
-	return ""
+	return nil
 }
 
-type DeleteCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 上書きを適用した、チャンネルでの実効権限
+type ChannelPermissions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ViewChannel     bool                   `protobuf:"varint,1,opt,name=view_channel,json=viewChannel,proto3" json:"view_channel,omitempty"`
+	SendMessages    bool                   `protobuf:"varint,2,opt,name=send_messages,json=sendMessages,proto3" json:"send_messages,omitempty"`
+	AttachFiles     bool                   `protobuf:"varint,3,opt,name=attach_files,json=attachFiles,proto3" json:"attach_files,omitempty"`
+	AddReactions    bool                   `protobuf:"varint,4,opt,name=add_reactions,json=addReactions,proto3" json:"add_reactions,omitempty"`
+	MentionEveryone bool                   `protobuf:"varint,5,opt,name=mention_everyone,json=mentionEveryone,proto3" json:"mention_everyone,omitempty"`
+	ManageMessages  bool                   `protobuf:"varint,6,opt,name=manage_messages,json=manageMessages,proto3" json:"manage_messages,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryResponse) Reset() {
-	*x = DeleteCategoryResponse{}
-	mi := &file_guild_message_proto_msgTypes[29]
+func (x *ChannelPermissions) Reset() {
+	*x = ChannelPermissions{}
+	mi := &file_guild_message_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryResponse) String() string {
+func (x *ChannelPermissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryResponse) ProtoMessage() {}
+func (*ChannelPermissions) ProtoMessage() {}
 
-func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[29]
+func (x *ChannelPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,41 +4203,77 @@ func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ChannelPermissions.ProtoReflect.Descriptor instead.
+func (*ChannelPermissions) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DeleteCategoryResponse) GetEmpty() *emptypb.Empty {
+func (x *ChannelPermissions) GetViewChannel() bool {
 	if x != nil {
-		return x.Empty
+		return x.ViewChannel
 	}
-	return nil
+	return false
 }
 
-type CreateChannelRequest struct {
+func (x *ChannelPermissions) GetSendMessages() bool {
+	if x != nil {
+		return x.SendMessages
+	}
+	return false
+}
+
+func (x *ChannelPermissions) GetAttachFiles() bool {
+	if x != nil {
+		return x.AttachFiles
+	}
+	return false
+}
+
+func (x *ChannelPermissions) GetAddReactions() bool {
+	if x != nil {
+		return x.AddReactions
+	}
+	return false
+}
+
+func (x *ChannelPermissions) GetMentionEveryone() bool {
+	if x != nil {
+		return x.MentionEveryone
+	}
+	return false
+}
+
+func (x *ChannelPermissions) GetManageMessages() bool {
+	if x != nil {
+		return x.ManageMessages
+	}
+	return false
+}
+
+type FilterMentionTargetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ChannelIds    []string               `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
-	mi := &file_guild_message_proto_msgTypes[30]
+func (x *FilterMentionTargetsRequest) Reset() {
+	*x = FilterMentionTargetsRequest{}
+	mi := &file_guild_message_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *FilterMentionTargetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*FilterMentionTargetsRequest) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[30]
+func (x *FilterMentionTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,47 +4284,57 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use FilterMentionTargetsRequest.ProtoReflect.Descriptor instead.
+func (*FilterMentionTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateChannelRequest) GetCategoryId() string {
+func (x *FilterMentionTargetsRequest) GetChannelId() string {
 	if x != nil {
-		return x.CategoryId
+		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *CreateChannelRequest) GetName() string {
+func (x *FilterMentionTargetsRequest) GetUserIds() []string {
 	if x != nil {
-		return x.Name
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-type CreateChannelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+func (x *FilterMentionTargetsRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type FilterMentionTargetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel_idと同じギルドのメンバーのみ
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// channel_idと同じギルドのチャンネルのみ
+	ChannelIds    []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChannelResponse) Reset() {
-	*x = CreateChannelResponse{}
-	mi := &file_guild_message_proto_msgTypes[31]
+func (x *FilterMentionTargetsResponse) Reset() {
+	*x = FilterMentionTargetsResponse{}
+	mi := &file_guild_message_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChannelResponse) String() string {
+func (x *FilterMentionTargetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelResponse) ProtoMessage() {}
+func (*FilterMentionTargetsResponse) ProtoMessage() {}
 
-func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[31]
+func (x *FilterMentionTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,41 +4345,48 @@ func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
-func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use FilterMentionTargetsResponse.ProtoReflect.Descriptor instead.
+func (*FilterMentionTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CreateChannelResponse) GetChannel() *Channel {
+func (x *FilterMentionTargetsResponse) GetUserIds() []string {
 	if x != nil {
-		return x.Channel
+		return x.UserIds
 	}
 	return nil
 }
 
-type UpdateChannelRequest struct {
+func (x *FilterMentionTargetsResponse) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type ListAccessibleChannelIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateChannelRequest) Reset() {
-	*x = UpdateChannelRequest{}
-	mi := &file_guild_message_proto_msgTypes[32]
+func (x *ListAccessibleChannelIDsRequest) Reset() {
+	*x = ListAccessibleChannelIDsRequest{}
+	mi := &file_guild_message_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateChannelRequest) String() string {
+func (x *ListAccessibleChannelIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChannelRequest) ProtoMessage() {}
+func (*ListAccessibleChannelIDsRequest) ProtoMessage() {}
 
-func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[32]
+func (x *ListAccessibleChannelIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,47 +4397,48 @@ func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListAccessibleChannelIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessibleChannelIDsRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UpdateChannelRequest) GetChannelId() string {
+func (x *ListAccessibleChannelIDsRequest) GetUserId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateChannelRequest) GetName() string {
+func (x *ListAccessibleChannelIDsRequest) GetGuildId() string {
 	if x != nil {
-		return x.Name
+		return x.GuildId
 	}
 	return ""
 }
 
-type UpdateChannelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+type ListAccessibleChannelIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CheckChannelAccessが許可されるチャンネルのみ
+	ChannelIds    []string `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateChannelResponse) Reset() {
-	*x = UpdateChannelResponse{}
-	mi := &file_guild_message_proto_msgTypes[33]
+func (x *ListAccessibleChannelIDsResponse) Reset() {
+	*x = ListAccessibleChannelIDsResponse{}
+	mi := &file_guild_message_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateChannelResponse) String() string {
+func (x *ListAccessibleChannelIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChannelResponse) ProtoMessage() {}
+func (*ListAccessibleChannelIDsResponse) ProtoMessage() {}
 
-func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[33]
+func (x *ListAccessibleChannelIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,40 +4449,41 @@ func (x *UpdateChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChannelResponse.ProtoReflect.Descriptor instead.
-func (*UpdateChannelResponse) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListAccessibleChannelIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessibleChannelIDsResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UpdateChannelResponse) GetChannel() *Channel {
+func (x *ListAccessibleChannelIDsResponse) GetChannelIds() []string {
 	if x != nil {
-		return x.Channel
+		return x.ChannelIds
 	}
 	return nil
 }
 
-type DeleteChannelRequest struct {
+type BatchCheckChannelAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelIds    []string               `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteChannelRequest) Reset() {
-	*x = DeleteChannelRequest{}
-	mi := &file_guild_message_proto_msgTypes[34]
+func (x *BatchCheckChannelAccessRequest) Reset() {
+	*x = BatchCheckChannelAccessRequest{}
+	mi := &file_guild_message_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteChannelRequest) String() string {
+func (x *BatchCheckChannelAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChannelRequest) ProtoMessage() {}
+func (*BatchCheckChannelAccessRequest) ProtoMessage() {}
 
-func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[34]
+func (x *BatchCheckChannelAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,40 +4494,48 @@ func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BatchCheckChannelAccessRequest.ProtoReflect.Descriptor instead.
+func (*BatchCheckChannelAccessRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *DeleteChannelRequest) GetChannelId() string {
+func (x *BatchCheckChannelAccessRequest) GetUserId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteChannelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+func (x *BatchCheckChannelAccessRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type BatchCheckChannelAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel_idsのうちCheckChannelAccessが許可されるもの
+	ChannelIds    []string `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteChannelResponse) Reset() {
-	*x = DeleteChannelResponse{}
-	mi := &file_guild_message_proto_msgTypes[35]
+func (x *BatchCheckChannelAccessResponse) Reset() {
+	*x = BatchCheckChannelAccessResponse{}
+	mi := &file_guild_message_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteChannelResponse) String() string {
+func (x *BatchCheckChannelAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChannelResponse) ProtoMessage() {}
+func (*BatchCheckChannelAccessResponse) ProtoMessage() {}
 
-func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[35]
+func (x *BatchCheckChannelAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,41 +4546,40 @@ func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
-func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use BatchCheckChannelAccessResponse.ProtoReflect.Descriptor instead.
+func (*BatchCheckChannelAccessResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *DeleteChannelResponse) GetEmpty() *emptypb.Empty {
+func (x *BatchCheckChannelAccessResponse) GetChannelIds() []string {
 	if x != nil {
-		return x.Empty
+		return x.ChannelIds
 	}
 	return nil
 }
 
-type CheckChannelAccessRequest struct {
+type ListUserGuildIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckChannelAccessRequest) Reset() {
-	*x = CheckChannelAccessRequest{}
-	mi := &file_guild_message_proto_msgTypes[36]
+func (x *ListUserGuildIDsRequest) Reset() {
+	*x = ListUserGuildIDsRequest{}
+	mi := &file_guild_message_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckChannelAccessRequest) String() string {
+func (x *ListUserGuildIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckChannelAccessRequest) ProtoMessage() {}
+func (*ListUserGuildIDsRequest) ProtoMessage() {}
 
-func (x *CheckChannelAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[36]
+func (x *ListUserGuildIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,47 +4590,40 @@ func (x *CheckChannelAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckChannelAccessRequest.ProtoReflect.Descriptor instead.
-func (*CheckChannelAccessRequest) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListUserGuildIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserGuildIDsRequest) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CheckChannelAccessRequest) GetUserId() string {
+func (x *ListUserGuildIDsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CheckChannelAccessRequest) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-type CheckChannelAccessResponse struct {
+type ListUserGuildIDsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasAccess     bool                   `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	GuildIds      []string               `protobuf:"bytes,1,rep,name=guild_ids,json=guildIds,proto3" json:"guild_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckChannelAccessResponse) Reset() {
-	*x = CheckChannelAccessResponse{}
-	mi := &file_guild_message_proto_msgTypes[37]
+func (x *ListUserGuildIDsResponse) Reset() {
+	*x = ListUserGuildIDsResponse{}
+	mi := &file_guild_message_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckChannelAccessResponse) String() string {
+func (x *ListUserGuildIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckChannelAccessResponse) ProtoMessage() {}
+func (*ListUserGuildIDsResponse) ProtoMessage() {}
 
-func (x *CheckChannelAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_message_proto_msgTypes[37]
+func (x *ListUserGuildIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_message_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,16 +4634,16 @@ func (x *CheckChannelAccessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckChannelAccessResponse.ProtoReflect.Descriptor instead.
-func (*CheckChannelAccessResponse) Descriptor() ([]byte, []int) {
-	return file_guild_message_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListUserGuildIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserGuildIDsResponse) Descriptor() ([]byte, []int) {
+	return file_guild_message_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *CheckChannelAccessResponse) GetHasAccess() bool {
+func (x *ListUserGuildIDsResponse) GetGuildIds() []string {
 	if x != nil {
-		return x.HasAccess
+		return x.GuildIds
 	}
-	return false
+	return nil
 }
 
 var File_guild_message_proto protoreflect.FileDescriptor
@@ -1821,9 +4666,10 @@ const file_guild_message_proto_rawDesc = "" +
 	"\x18GetGuildOverviewResponse\x12(\n" +
 	"\x05guild\x18\x01 \x01(\v2\x12.guild.GuildDetailR\x05guild:\x1a\x92A\x17\n" +
 	"\x15\xd2\x01\x05guild\xd2\x01\n" +
-	"categories\"B\n" +
+	"categories\"g\n" +
 	"\x13GetGuildByIDRequest\x12\x19\n" +
-	"\bguild_id\x18\x01 \x01(\tR\aguildId:\x10\x92A\r\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12#\n" +
+	"\rwith_presence\x18\x02 \x01(\bR\fwithPresence:\x10\x92A\r\n" +
 	"\v\xd2\x01\bguild_id\"T\n" +
 	"\x14GetGuildByIDResponse\x12-\n" +
 	"\x05guild\x18\x01 \x01(\v2\x17.guild.GuildWithMembersR\x05guild:\r\x92A\n" +
@@ -1844,7 +4690,46 @@ const file_guild_message_proto_rawDesc = "" +
 	"\x13UpdateGuildResponse\x12\"\n" +
 	"\x05guild\x18\x01 \x01(\v2\f.guild.GuildR\x05guild:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05guild\"j\n" +
+	"\b\xd2\x01\x05guild\"A\n" +
+	"\x12DeleteGuildRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId:\x10\x92A\r\n" +
+	"\v\xd2\x01\bguild_id\"R\n" +
+	"\x13DeleteGuildResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"\xa4\x01\n" +
+	"\x1dTransferGuildOwnershipRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword:*\x92A'\n" +
+	"%\xd2\x01\bguild_id\xd2\x01\fnew_owner_id\xd2\x01\bpassword\"S\n" +
+	"\x1eTransferGuildOwnershipResponse\x12\"\n" +
+	"\x05guild\x18\x01 \x01(\v2\f.guild.GuildR\x05guild:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05guild\"\xa2\x02\n" +
+	"\x13ListAuditLogRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1e\n" +
+	"\bactor_id\x18\x02 \x01(\tH\x00R\aactorId\x88\x01\x01\x12$\n" +
+	"\vaction_type\x18\x03 \x01(\tH\x01R\n" +
+	"actionType\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\x04 \x01(\tH\x02R\btargetId\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x05 \x01(\tH\x03R\x06cursor\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x05H\x04R\x05limit\x88\x01\x01:\x10\x92A\r\n" +
+	"\v\xd2\x01\bguild_idB\v\n" +
+	"\t_actor_idB\x0e\n" +
+	"\f_action_typeB\f\n" +
+	"\n" +
+	"_target_idB\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\xb3\x01\n" +
+	"\x14ListAuditLogResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.guild.AuditLogEntryR\aentries\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\aentries\xd2\x01\bhas_moreB\x0e\n" +
+	"\f_next_cursor\"j\n" +
 	"\x18DeleteGuildMemberRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId:\x1a\x92A\x17\n" +
@@ -1859,7 +4744,44 @@ const file_guild_message_proto_rawDesc = "" +
 	"\x12LeaveGuildResponse\x12,\n" +
 	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05empty\"E\n" +
+	"\b\xd2\x01\x05empty\"\xea\x01\n" +
+	"\x14TimeoutMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12a\n" +
+	"\x1ccommunication_disabled_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x1acommunicationDisabledUntil\x88\x01\x01:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\bguild_id\xd2\x01\auser_idB\x1f\n" +
+	"\x1d_communication_disabled_until\"N\n" +
+	"\x15TimeoutMemberResponse\x12%\n" +
+	"\x06member\x18\x01 \x01(\v2\r.guild.MemberR\x06member:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06member\"\xff\x01\n" +
+	"\x10BanMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x124\n" +
+	"\x16delete_message_seconds\x18\x05 \x01(\x05R\x14deleteMessageSeconds:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\bguild_id\xd2\x01\auser_idB\r\n" +
+	"\v_expires_at\">\n" +
+	"\x11BanMemberResponse\x12\x1c\n" +
+	"\x03ban\x18\x01 \x01(\v2\n" +
+	".guild.BanR\x03ban:\v\x92A\b\n" +
+	"\x06\xd2\x01\x03ban\"d\n" +
+	"\x12UnbanMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\bguild_id\xd2\x01\auser_id\"R\n" +
+	"\x13UnbanMemberResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\">\n" +
+	"\x0fListBansRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId:\x10\x92A\r\n" +
+	"\v\xd2\x01\bguild_id\"@\n" +
+	"\x10ListBansResponse\x12\x1e\n" +
+	"\x04bans\x18\x01 \x03(\v2\n" +
+	".guild.BanR\x04bans:\f\x92A\t\n" +
+	"\a\xd2\x01\x04bans\"E\n" +
 	"\x16GetGuildInvitesRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId:\x10\x92A\r\n" +
 	"\v\xd2\x01\bguild_id\"S\n" +
@@ -1892,7 +4814,23 @@ const file_guild_message_proto_rawDesc = "" +
 	"\x19DeleteGuildInviteResponse\x12,\n" +
 	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05empty\"H\n" +
+	"\b\xd2\x01\x05empty\"y\n" +
+	"\x1bSetGuildVanityInviteRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode:\x1e\x92A\x1b\n" +
+	"\x19\xd2\x01\bguild_id\xd2\x01\vinvite_code\"U\n" +
+	"\x1cSetGuildVanityInviteResponse\x12%\n" +
+	"\x06invite\x18\x01 \x01(\v2\r.guild.InviteR\x06invite:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06invite\"x\n" +
+	"\x1aListGuildInviteUsesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode:\x1e\x92A\x1b\n" +
+	"\x19\xd2\x01\bguild_id\xd2\x01\vinvite_code\"Q\n" +
+	"\x1bListGuildInviteUsesResponse\x12$\n" +
+	"\x04uses\x18\x01 \x03(\v2\x10.guild.InviteUseR\x04uses:\f\x92A\t\n" +
+	"\a\xd2\x01\x04uses\"H\n" +
 	"\x10JoinGuildRequest\x12\x1f\n" +
 	"\vinvite_code\x18\x01 \x01(\tR\n" +
 	"inviteCode:\x13\x92A\x10\n" +
@@ -1951,14 +4889,185 @@ const file_guild_message_proto_rawDesc = "" +
 	"\x15DeleteChannelResponse\x12,\n" +
 	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
 	"\n" +
+	"\b\xd2\x01\x05empty\"\x89\x01\n" +
+	"\x16ReorderChannelsRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x125\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x15.guild.CategoryLayoutR\n" +
+	"categories:\x1d\x92A\x1a\n" +
+	"\x18\xd2\x01\bguild_id\xd2\x01\n" +
+	"categories\"d\n" +
+	"\x17ReorderChannelsResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.guild.CategoryLayoutR\n" +
+	"categories:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"categories\"?\n" +
+	"\x10ListRolesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId:\x10\x92A\r\n" +
+	"\v\xd2\x01\bguild_id\"E\n" +
+	"\x11ListRolesResponse\x12!\n" +
+	"\x05roles\x18\x01 \x03(\v2\v.guild.RoleR\x05roles:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05roles\"\x8b\x01\n" +
+	"\x11CreateRoleRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x03R\vpermissions:%\x92A\"\n" +
+	" \xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\vpermissions\"C\n" +
+	"\x12CreateRoleResponse\x12\x1f\n" +
+	"\x04role\x18\x01 \x01(\v2\v.guild.RoleR\x04role:\f\x92A\t\n" +
+	"\a\xd2\x01\x04role\"\x88\x01\n" +
+	"\x11UpdateRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x03R\vpermissions:$\x92A!\n" +
+	"\x1f\xd2\x01\arole_id\xd2\x01\x04name\xd2\x01\vpermissions\"C\n" +
+	"\x12UpdateRoleResponse\x12\x1f\n" +
+	"\x04role\x18\x01 \x01(\v2\v.guild.RoleR\x04role:\f\x92A\t\n" +
+	"\a\xd2\x01\x04role\"=\n" +
+	"\x11DeleteRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId:\x0f\x92A\f\n" +
+	"\n" +
+	"\xd2\x01\arole_id\"Q\n" +
+	"\x12DeleteRoleResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"h\n" +
+	"\x13ReorderRolesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x19\n" +
+	"\brole_ids\x18\x02 \x03(\tR\aroleIds:\x1b\x92A\x18\n" +
+	"\x16\xd2\x01\bguild_id\xd2\x01\brole_ids\"H\n" +
+	"\x14ReorderRolesResponse\x12!\n" +
+	"\x05roles\x18\x01 \x03(\v2\v.guild.RoleR\x05roles:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05roles\"\x89\x01\n" +
+	"\x14AddMemberRoleRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId:$\x92A!\n" +
+	"\x1f\xd2\x01\bguild_id\xd2\x01\auser_id\xd2\x01\arole_id\"T\n" +
+	"\x15AddMemberRoleResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"\x8c\x01\n" +
+	"\x17RemoveMemberRoleRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId:$\x92A!\n" +
+	"\x1f\xd2\x01\bguild_id\xd2\x01\auser_id\xd2\x01\arole_id\"W\n" +
+	"\x18RemoveMemberRoleResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"[\n" +
+	"&ListChannelPermissionOverwritesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"channel_id\"y\n" +
+	"'ListChannelPermissionOverwritesResponse\x12:\n" +
+	"\n" +
+	"overwrites\x18\x01 \x03(\v2\x1a.guild.PermissionOverwriteR\n" +
+	"overwrites:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"overwrites\"\x90\x02\n" +
+	"$SetChannelPermissionOverwriteRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12E\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e2$.guild.PermissionOverwriteTargetTypeR\n" +
+	"targetType\x12\x14\n" +
+	"\x05allow\x18\x04 \x01(\x03R\x05allow\x12\x12\n" +
+	"\x04deny\x18\x05 \x01(\x03R\x04deny:;\x92A8\n" +
+	"6\xd2\x01\n" +
+	"channel_id\xd2\x01\ttarget_id\xd2\x01\vtarget_type\xd2\x01\x05allow\xd2\x01\x04deny\"t\n" +
+	"%SetChannelPermissionOverwriteResponse\x128\n" +
+	"\toverwrite\x18\x01 \x01(\v2\x1a.guild.PermissionOverwriteR\toverwrite:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\toverwrite\"\x85\x01\n" +
+	"'DeleteChannelPermissionOverwriteRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId:\x1e\x92A\x1b\n" +
+	"\x19\xd2\x01\n" +
+	"channel_id\xd2\x01\ttarget_id\"g\n" +
+	"(DeleteChannelPermissionOverwriteResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"_\n" +
+	"'ListCategoryPermissionOverwritesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId:\x13\x92A\x10\n" +
+	"\x0e\xd2\x01\vcategory_id\"z\n" +
+	"(ListCategoryPermissionOverwritesResponse\x12:\n" +
+	"\n" +
+	"overwrites\x18\x01 \x03(\v2\x1a.guild.PermissionOverwriteR\n" +
+	"overwrites:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"overwrites\"\x94\x02\n" +
+	"%SetCategoryPermissionOverwriteRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12E\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e2$.guild.PermissionOverwriteTargetTypeR\n" +
+	"targetType\x12\x14\n" +
+	"\x05allow\x18\x04 \x01(\x03R\x05allow\x12\x12\n" +
+	"\x04deny\x18\x05 \x01(\x03R\x04deny:<\x92A9\n" +
+	"7\xd2\x01\vcategory_id\xd2\x01\ttarget_id\xd2\x01\vtarget_type\xd2\x01\x05allow\xd2\x01\x04deny\"u\n" +
+	"&SetCategoryPermissionOverwriteResponse\x128\n" +
+	"\toverwrite\x18\x01 \x01(\v2\x1a.guild.PermissionOverwriteR\toverwrite:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\toverwrite\"\x89\x01\n" +
+	"(DeleteCategoryPermissionOverwriteRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId:\x1f\x92A\x1c\n" +
+	"\x1a\xd2\x01\vcategory_id\xd2\x01\ttarget_id\"h\n" +
+	")DeleteCategoryPermissionOverwriteResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
 	"\b\xd2\x01\x05empty\"S\n" +
 	"\x19CheckChannelAccessRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x02 \x01(\tR\tchannelId\";\n" +
-	"\x1aCheckChannelAccessResponse\x12\x1d\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"\xff\x01\n" +
+	"\x1aCheckChannelAccessResponse\x12;\n" +
+	"\vpermissions\x18\x03 \x01(\v2\x19.guild.ChannelPermissionsR\vpermissions\x12a\n" +
+	"\x1ccommunication_disabled_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x1acommunicationDisabledUntil\x88\x01\x01B\x1f\n" +
+	"\x1d_communication_disabled_untilJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\n" +
+	"has_accessR\bis_owner\"\xf8\x01\n" +
+	"\x12ChannelPermissions\x12!\n" +
+	"\fview_channel\x18\x01 \x01(\bR\vviewChannel\x12#\n" +
+	"\rsend_messages\x18\x02 \x01(\bR\fsendMessages\x12!\n" +
+	"\fattach_files\x18\x03 \x01(\bR\vattachFiles\x12#\n" +
+	"\radd_reactions\x18\x04 \x01(\bR\faddReactions\x12)\n" +
+	"\x10mention_everyone\x18\x05 \x01(\bR\x0fmentionEveryone\x12'\n" +
+	"\x0fmanage_messages\x18\x06 \x01(\bR\x0emanageMessages\"x\n" +
+	"\x1bFilterMentionTargetsRequest\x12\x1d\n" +
 	"\n" +
-	"has_access\x18\x01 \x01(\bR\thasAccessBc\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x1f\n" +
+	"\vchannel_ids\x18\x03 \x03(\tR\n" +
+	"channelIds\"Z\n" +
+	"\x1cFilterMentionTargetsResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds\"U\n" +
+	"\x1fListAccessibleChannelIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\"C\n" +
+	" ListAccessibleChannelIDsResponse\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\"Z\n" +
+	"\x1eBatchCheckChannelAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds\"B\n" +
+	"\x1fBatchCheckChannelAccessResponse\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\"2\n" +
+	"\x17ListUserGuildIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x18ListUserGuildIDsResponse\x12\x1b\n" +
+	"\tguild_ids\x18\x01 \x03(\tR\bguildIdsBc\n" +
 	"\tcom.guildB\x11GuildMessageProtoP\x01Z\x0f./guild;guildpb\xa2\x02\x03GXX\xaa\x02\x05Guild\xca\x02\x05Guild\xe2\x02\x11Guild\\GPBMetadata\xea\x02\x05Guildb\x06proto3"
 
 var (
@@ -1973,82 +5082,174 @@ func file_guild_message_proto_rawDescGZIP() []byte {
 	return file_guild_message_proto_rawDescData
 }
 
-var file_guild_message_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_guild_message_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_guild_message_proto_goTypes = []any{
-	(*CreateGuildRequest)(nil),           // 0: guild.CreateGuildRequest
-	(*CreateGuildResponse)(nil),          // 1: guild.CreateGuildResponse
-	(*GetGuildOverviewRequest)(nil),      // 2: guild.GetGuildOverviewRequest
-	(*GetGuildOverviewResponse)(nil),     // 3: guild.GetGuildOverviewResponse
-	(*GetGuildByIDRequest)(nil),          // 4: guild.GetGuildByIDRequest
-	(*GetGuildByIDResponse)(nil),         // 5: guild.GetGuildByIDResponse
-	(*ListMyGuildsRequest)(nil),          // 6: guild.ListMyGuildsRequest
-	(*ListMyGuildsResponse)(nil),         // 7: guild.ListMyGuildsResponse
-	(*UpdateGuildRequest)(nil),           // 8: guild.UpdateGuildRequest
-	(*UpdateGuildResponse)(nil),          // 9: guild.UpdateGuildResponse
-	(*DeleteGuildMemberRequest)(nil),     // 10: guild.DeleteGuildMemberRequest
-	(*DeleteGuildMemberResponse)(nil),    // 11: guild.DeleteGuildMemberResponse
-	(*LeaveGuildRequest)(nil),            // 12: guild.LeaveGuildRequest
-	(*LeaveGuildResponse)(nil),           // 13: guild.LeaveGuildResponse
-	(*GetGuildInvitesRequest)(nil),       // 14: guild.GetGuildInvitesRequest
-	(*GetGuildInvitesResponse)(nil),      // 15: guild.GetGuildInvitesResponse
-	(*GetGuildByInviteCodeRequest)(nil),  // 16: guild.GetGuildByInviteCodeRequest
-	(*GetGuildByInviteCodeResponse)(nil), // 17: guild.GetGuildByInviteCodeResponse
-	(*CreateGuildInviteRequest)(nil),     // 18: guild.CreateGuildInviteRequest
-	(*CreateGuildInviteResponse)(nil),    // 19: guild.CreateGuildInviteResponse
-	(*DeleteGuildInviteRequest)(nil),     // 20: guild.DeleteGuildInviteRequest
-	(*DeleteGuildInviteResponse)(nil),    // 21: guild.DeleteGuildInviteResponse
-	(*JoinGuildRequest)(nil),             // 22: guild.JoinGuildRequest
-	(*JoinGuildResponse)(nil),            // 23: guild.JoinGuildResponse
-	(*CreateCategoryRequest)(nil),        // 24: guild.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),       // 25: guild.CreateCategoryResponse
-	(*UpdateCategoryRequest)(nil),        // 26: guild.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),       // 27: guild.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),        // 28: guild.DeleteCategoryRequest
-	(*DeleteCategoryResponse)(nil),       // 29: guild.DeleteCategoryResponse
-	(*CreateChannelRequest)(nil),         // 30: guild.CreateChannelRequest
-	(*CreateChannelResponse)(nil),        // 31: guild.CreateChannelResponse
-	(*UpdateChannelRequest)(nil),         // 32: guild.UpdateChannelRequest
-	(*UpdateChannelResponse)(nil),        // 33: guild.UpdateChannelResponse
-	(*DeleteChannelRequest)(nil),         // 34: guild.DeleteChannelRequest
-	(*DeleteChannelResponse)(nil),        // 35: guild.DeleteChannelResponse
-	(*CheckChannelAccessRequest)(nil),    // 36: guild.CheckChannelAccessRequest
-	(*CheckChannelAccessResponse)(nil),   // 37: guild.CheckChannelAccessResponse
-	(*Guild)(nil),                        // 38: guild.Guild
-	(*GuildDetail)(nil),                  // 39: guild.GuildDetail
-	(*GuildWithMembers)(nil),             // 40: guild.GuildWithMembers
-	(*GuildWithMemberCount)(nil),         // 41: guild.GuildWithMemberCount
-	(*emptypb.Empty)(nil),                // 42: google.protobuf.Empty
-	(*Invite)(nil),                       // 43: guild.Invite
-	(*timestamppb.Timestamp)(nil),        // 44: google.protobuf.Timestamp
-	(*Member)(nil),                       // 45: guild.Member
-	(*Category)(nil),                     // 46: guild.Category
-	(*Channel)(nil),                      // 47: guild.Channel
+	(*CreateGuildRequest)(nil),                        // 0: guild.CreateGuildRequest
+	(*CreateGuildResponse)(nil),                       // 1: guild.CreateGuildResponse
+	(*GetGuildOverviewRequest)(nil),                   // 2: guild.GetGuildOverviewRequest
+	(*GetGuildOverviewResponse)(nil),                  // 3: guild.GetGuildOverviewResponse
+	(*GetGuildByIDRequest)(nil),                       // 4: guild.GetGuildByIDRequest
+	(*GetGuildByIDResponse)(nil),                      // 5: guild.GetGuildByIDResponse
+	(*ListMyGuildsRequest)(nil),                       // 6: guild.ListMyGuildsRequest
+	(*ListMyGuildsResponse)(nil),                      // 7: guild.ListMyGuildsResponse
+	(*UpdateGuildRequest)(nil),                        // 8: guild.UpdateGuildRequest
+	(*UpdateGuildResponse)(nil),                       // 9: guild.UpdateGuildResponse
+	(*DeleteGuildRequest)(nil),                        // 10: guild.DeleteGuildRequest
+	(*DeleteGuildResponse)(nil),                       // 11: guild.DeleteGuildResponse
+	(*TransferGuildOwnershipRequest)(nil),             // 12: guild.TransferGuildOwnershipRequest
+	(*TransferGuildOwnershipResponse)(nil),            // 13: guild.TransferGuildOwnershipResponse
+	(*ListAuditLogRequest)(nil),                       // 14: guild.ListAuditLogRequest
+	(*ListAuditLogResponse)(nil),                      // 15: guild.ListAuditLogResponse
+	(*DeleteGuildMemberRequest)(nil),                  // 16: guild.DeleteGuildMemberRequest
+	(*DeleteGuildMemberResponse)(nil),                 // 17: guild.DeleteGuildMemberResponse
+	(*LeaveGuildRequest)(nil),                         // 18: guild.LeaveGuildRequest
+	(*LeaveGuildResponse)(nil),                        // 19: guild.LeaveGuildResponse
+	(*TimeoutMemberRequest)(nil),                      // 20: guild.TimeoutMemberRequest
+	(*TimeoutMemberResponse)(nil),                     // 21: guild.TimeoutMemberResponse
+	(*BanMemberRequest)(nil),                          // 22: guild.BanMemberRequest
+	(*BanMemberResponse)(nil),                         // 23: guild.BanMemberResponse
+	(*UnbanMemberRequest)(nil),                        // 24: guild.UnbanMemberRequest
+	(*UnbanMemberResponse)(nil),                       // 25: guild.UnbanMemberResponse
+	(*ListBansRequest)(nil),                           // 26: guild.ListBansRequest
+	(*ListBansResponse)(nil),                          // 27: guild.ListBansResponse
+	(*GetGuildInvitesRequest)(nil),                    // 28: guild.GetGuildInvitesRequest
+	(*GetGuildInvitesResponse)(nil),                   // 29: guild.GetGuildInvitesResponse
+	(*GetGuildByInviteCodeRequest)(nil),               // 30: guild.GetGuildByInviteCodeRequest
+	(*GetGuildByInviteCodeResponse)(nil),              // 31: guild.GetGuildByInviteCodeResponse
+	(*CreateGuildInviteRequest)(nil),                  // 32: guild.CreateGuildInviteRequest
+	(*CreateGuildInviteResponse)(nil),                 // 33: guild.CreateGuildInviteResponse
+	(*DeleteGuildInviteRequest)(nil),                  // 34: guild.DeleteGuildInviteRequest
+	(*DeleteGuildInviteResponse)(nil),                 // 35: guild.DeleteGuildInviteResponse
+	(*SetGuildVanityInviteRequest)(nil),               // 36: guild.SetGuildVanityInviteRequest
+	(*SetGuildVanityInviteResponse)(nil),              // 37: guild.SetGuildVanityInviteResponse
+	(*ListGuildInviteUsesRequest)(nil),                // 38: guild.ListGuildInviteUsesRequest
+	(*ListGuildInviteUsesResponse)(nil),               // 39: guild.ListGuildInviteUsesResponse
+	(*JoinGuildRequest)(nil),                          // 40: guild.JoinGuildRequest
+	(*JoinGuildResponse)(nil),                         // 41: guild.JoinGuildResponse
+	(*CreateCategoryRequest)(nil),                     // 42: guild.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),                    // 43: guild.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),                     // 44: guild.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),                    // 45: guild.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),                     // 46: guild.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),                    // 47: guild.DeleteCategoryResponse
+	(*CreateChannelRequest)(nil),                      // 48: guild.CreateChannelRequest
+	(*CreateChannelResponse)(nil),                     // 49: guild.CreateChannelResponse
+	(*UpdateChannelRequest)(nil),                      // 50: guild.UpdateChannelRequest
+	(*UpdateChannelResponse)(nil),                     // 51: guild.UpdateChannelResponse
+	(*DeleteChannelRequest)(nil),                      // 52: guild.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),                     // 53: guild.DeleteChannelResponse
+	(*ReorderChannelsRequest)(nil),                    // 54: guild.ReorderChannelsRequest
+	(*ReorderChannelsResponse)(nil),                   // 55: guild.ReorderChannelsResponse
+	(*ListRolesRequest)(nil),                          // 56: guild.ListRolesRequest
+	(*ListRolesResponse)(nil),                         // 57: guild.ListRolesResponse
+	(*CreateRoleRequest)(nil),                         // 58: guild.CreateRoleRequest
+	(*CreateRoleResponse)(nil),                        // 59: guild.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),                         // 60: guild.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                        // 61: guild.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),                         // 62: guild.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),                        // 63: guild.DeleteRoleResponse
+	(*ReorderRolesRequest)(nil),                       // 64: guild.ReorderRolesRequest
+	(*ReorderRolesResponse)(nil),                      // 65: guild.ReorderRolesResponse
+	(*AddMemberRoleRequest)(nil),                      // 66: guild.AddMemberRoleRequest
+	(*AddMemberRoleResponse)(nil),                     // 67: guild.AddMemberRoleResponse
+	(*RemoveMemberRoleRequest)(nil),                   // 68: guild.RemoveMemberRoleRequest
+	(*RemoveMemberRoleResponse)(nil),                  // 69: guild.RemoveMemberRoleResponse
+	(*ListChannelPermissionOverwritesRequest)(nil),    // 70: guild.ListChannelPermissionOverwritesRequest
+	(*ListChannelPermissionOverwritesResponse)(nil),   // 71: guild.ListChannelPermissionOverwritesResponse
+	(*SetChannelPermissionOverwriteRequest)(nil),      // 72: guild.SetChannelPermissionOverwriteRequest
+	(*SetChannelPermissionOverwriteResponse)(nil),     // 73: guild.SetChannelPermissionOverwriteResponse
+	(*DeleteChannelPermissionOverwriteRequest)(nil),   // 74: guild.DeleteChannelPermissionOverwriteRequest
+	(*DeleteChannelPermissionOverwriteResponse)(nil),  // 75: guild.DeleteChannelPermissionOverwriteResponse
+	(*ListCategoryPermissionOverwritesRequest)(nil),   // 76: guild.ListCategoryPermissionOverwritesRequest
+	(*ListCategoryPermissionOverwritesResponse)(nil),  // 77: guild.ListCategoryPermissionOverwritesResponse
+	(*SetCategoryPermissionOverwriteRequest)(nil),     // 78: guild.SetCategoryPermissionOverwriteRequest
+	(*SetCategoryPermissionOverwriteResponse)(nil),    // 79: guild.SetCategoryPermissionOverwriteResponse
+	(*DeleteCategoryPermissionOverwriteRequest)(nil),  // 80: guild.DeleteCategoryPermissionOverwriteRequest
+	(*DeleteCategoryPermissionOverwriteResponse)(nil), // 81: guild.DeleteCategoryPermissionOverwriteResponse
+	(*CheckChannelAccessRequest)(nil),                 // 82: guild.CheckChannelAccessRequest
+	(*CheckChannelAccessResponse)(nil),                // 83: guild.CheckChannelAccessResponse
+	(*ChannelPermissions)(nil),                        // 84: guild.ChannelPermissions
+	(*FilterMentionTargetsRequest)(nil),               // 85: guild.FilterMentionTargetsRequest
+	(*FilterMentionTargetsResponse)(nil),              // 86: guild.FilterMentionTargetsResponse
+	(*ListAccessibleChannelIDsRequest)(nil),           // 87: guild.ListAccessibleChannelIDsRequest
+	(*ListAccessibleChannelIDsResponse)(nil),          // 88: guild.ListAccessibleChannelIDsResponse
+	(*BatchCheckChannelAccessRequest)(nil),            // 89: guild.BatchCheckChannelAccessRequest
+	(*BatchCheckChannelAccessResponse)(nil),           // 90: guild.BatchCheckChannelAccessResponse
+	(*ListUserGuildIDsRequest)(nil),                   // 91: guild.ListUserGuildIDsRequest
+	(*ListUserGuildIDsResponse)(nil),                  // 92: guild.ListUserGuildIDsResponse
+	(*Guild)(nil),                                     // 93: guild.Guild
+	(*GuildDetail)(nil),                               // 94: guild.GuildDetail
+	(*GuildWithMembers)(nil),                          // 95: guild.GuildWithMembers
+	(*GuildWithMemberCount)(nil),                      // 96: guild.GuildWithMemberCount
+	(*emptypb.Empty)(nil),                             // 97: google.protobuf.Empty
+	(*AuditLogEntry)(nil),                             // 98: guild.AuditLogEntry
+	(*timestamppb.Timestamp)(nil),                     // 99: google.protobuf.Timestamp
+	(*Member)(nil),                                    // 100: guild.Member
+	(*Ban)(nil),                                       // 101: guild.Ban
+	(*Invite)(nil),                                    // 102: guild.Invite
+	(*InviteUse)(nil),                                 // 103: guild.InviteUse
+	(*Category)(nil),                                  // 104: guild.Category
+	(*Channel)(nil),                                   // 105: guild.Channel
+	(*CategoryLayout)(nil),                            // 106: guild.CategoryLayout
+	(*Role)(nil),                                      // 107: guild.Role
+	(*PermissionOverwrite)(nil),                       // 108: guild.PermissionOverwrite
+	(PermissionOverwriteTargetType)(0),                // 109: guild.PermissionOverwriteTargetType
 }
 var file_guild_message_proto_depIdxs = []int32{
-	38, // 0: guild.CreateGuildResponse.guild:type_name -> guild.Guild
-	39, // 1: guild.GetGuildOverviewResponse.guild:type_name -> guild.GuildDetail
-	40, // 2: guild.GetGuildByIDResponse.guild:type_name -> guild.GuildWithMembers
-	41, // 3: guild.ListMyGuildsResponse.guilds:type_name -> guild.GuildWithMemberCount
-	38, // 4: guild.UpdateGuildResponse.guild:type_name -> guild.Guild
-	42, // 5: guild.DeleteGuildMemberResponse.empty:type_name -> google.protobuf.Empty
-	42, // 6: guild.LeaveGuildResponse.empty:type_name -> google.protobuf.Empty
-	43, // 7: guild.GetGuildInvitesResponse.invites:type_name -> guild.Invite
-	43, // 8: guild.GetGuildByInviteCodeResponse.invite:type_name -> guild.Invite
-	44, // 9: guild.CreateGuildInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
-	43, // 10: guild.CreateGuildInviteResponse.invite:type_name -> guild.Invite
-	42, // 11: guild.DeleteGuildInviteResponse.empty:type_name -> google.protobuf.Empty
-	45, // 12: guild.JoinGuildResponse.member:type_name -> guild.Member
-	46, // 13: guild.CreateCategoryResponse.category:type_name -> guild.Category
-	46, // 14: guild.UpdateCategoryResponse.category:type_name -> guild.Category
-	42, // 15: guild.DeleteCategoryResponse.empty:type_name -> google.protobuf.Empty
-	47, // 16: guild.CreateChannelResponse.channel:type_name -> guild.Channel
-	47, // 17: guild.UpdateChannelResponse.channel:type_name -> guild.Channel
-	42, // 18: guild.DeleteChannelResponse.empty:type_name -> google.protobuf.Empty
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	93,  // 0: guild.CreateGuildResponse.guild:type_name -> guild.Guild
+	94,  // 1: guild.GetGuildOverviewResponse.guild:type_name -> guild.GuildDetail
+	95,  // 2: guild.GetGuildByIDResponse.guild:type_name -> guild.GuildWithMembers
+	96,  // 3: guild.ListMyGuildsResponse.guilds:type_name -> guild.GuildWithMemberCount
+	93,  // 4: guild.UpdateGuildResponse.guild:type_name -> guild.Guild
+	97,  // 5: guild.DeleteGuildResponse.empty:type_name -> google.protobuf.Empty
+	93,  // 6: guild.TransferGuildOwnershipResponse.guild:type_name -> guild.Guild
+	98,  // 7: guild.ListAuditLogResponse.entries:type_name -> guild.AuditLogEntry
+	97,  // 8: guild.DeleteGuildMemberResponse.empty:type_name -> google.protobuf.Empty
+	97,  // 9: guild.LeaveGuildResponse.empty:type_name -> google.protobuf.Empty
+	99,  // 10: guild.TimeoutMemberRequest.communication_disabled_until:type_name -> google.protobuf.Timestamp
+	100, // 11: guild.TimeoutMemberResponse.member:type_name -> guild.Member
+	99,  // 12: guild.BanMemberRequest.expires_at:type_name -> google.protobuf.Timestamp
+	101, // 13: guild.BanMemberResponse.ban:type_name -> guild.Ban
+	97,  // 14: guild.UnbanMemberResponse.empty:type_name -> google.protobuf.Empty
+	101, // 15: guild.ListBansResponse.bans:type_name -> guild.Ban
+	102, // 16: guild.GetGuildInvitesResponse.invites:type_name -> guild.Invite
+	102, // 17: guild.GetGuildByInviteCodeResponse.invite:type_name -> guild.Invite
+	99,  // 18: guild.CreateGuildInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	102, // 19: guild.CreateGuildInviteResponse.invite:type_name -> guild.Invite
+	97,  // 20: guild.DeleteGuildInviteResponse.empty:type_name -> google.protobuf.Empty
+	102, // 21: guild.SetGuildVanityInviteResponse.invite:type_name -> guild.Invite
+	103, // 22: guild.ListGuildInviteUsesResponse.uses:type_name -> guild.InviteUse
+	100, // 23: guild.JoinGuildResponse.member:type_name -> guild.Member
+	104, // 24: guild.CreateCategoryResponse.category:type_name -> guild.Category
+	104, // 25: guild.UpdateCategoryResponse.category:type_name -> guild.Category
+	97,  // 26: guild.DeleteCategoryResponse.empty:type_name -> google.protobuf.Empty
+	105, // 27: guild.CreateChannelResponse.channel:type_name -> guild.Channel
+	105, // 28: guild.UpdateChannelResponse.channel:type_name -> guild.Channel
+	97,  // 29: guild.DeleteChannelResponse.empty:type_name -> google.protobuf.Empty
+	106, // 30: guild.ReorderChannelsRequest.categories:type_name -> guild.CategoryLayout
+	106, // 31: guild.ReorderChannelsResponse.categories:type_name -> guild.CategoryLayout
+	107, // 32: guild.ListRolesResponse.roles:type_name -> guild.Role
+	107, // 33: guild.CreateRoleResponse.role:type_name -> guild.Role
+	107, // 34: guild.UpdateRoleResponse.role:type_name -> guild.Role
+	97,  // 35: guild.DeleteRoleResponse.empty:type_name -> google.protobuf.Empty
+	107, // 36: guild.ReorderRolesResponse.roles:type_name -> guild.Role
+	97,  // 37: guild.AddMemberRoleResponse.empty:type_name -> google.protobuf.Empty
+	97,  // 38: guild.RemoveMemberRoleResponse.empty:type_name -> google.protobuf.Empty
+	108, // 39: guild.ListChannelPermissionOverwritesResponse.overwrites:type_name -> guild.PermissionOverwrite
+	109, // 40: guild.SetChannelPermissionOverwriteRequest.target_type:type_name -> guild.PermissionOverwriteTargetType
+	108, // 41: guild.SetChannelPermissionOverwriteResponse.overwrite:type_name -> guild.PermissionOverwrite
+	97,  // 42: guild.DeleteChannelPermissionOverwriteResponse.empty:type_name -> google.protobuf.Empty
+	108, // 43: guild.ListCategoryPermissionOverwritesResponse.overwrites:type_name -> guild.PermissionOverwrite
+	109, // 44: guild.SetCategoryPermissionOverwriteRequest.target_type:type_name -> guild.PermissionOverwriteTargetType
+	108, // 45: guild.SetCategoryPermissionOverwriteResponse.overwrite:type_name -> guild.PermissionOverwrite
+	97,  // 46: guild.DeleteCategoryPermissionOverwriteResponse.empty:type_name -> google.protobuf.Empty
+	84,  // 47: guild.CheckChannelAccessResponse.permissions:type_name -> guild.ChannelPermissions
+	99,  // 48: guild.CheckChannelAccessResponse.communication_disabled_until:type_name -> google.protobuf.Timestamp
+	49,  // [49:49] is the sub-list for method output_type
+	49,  // [49:49] is the sub-list for method input_type
+	49,  // [49:49] is the sub-list for extension type_name
+	49,  // [49:49] is the sub-list for extension extendee
+	0,   // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_guild_message_proto_init() }
@@ -2057,14 +5258,19 @@ func file_guild_message_proto_init() {
 		return
 	}
 	file_guild_type_proto_init()
-	file_guild_message_proto_msgTypes[18].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[14].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[15].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[20].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[22].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[32].OneofWrappers = []any{}
+	file_guild_message_proto_msgTypes[83].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guild_message_proto_rawDesc), len(file_guild_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
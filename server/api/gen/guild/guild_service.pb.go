@@ -26,7 +26,7 @@ var File_guild_service_proto protoreflect.FileDescriptor
 
 const file_guild_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13guild_service.proto\x12\x05guild\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x13guild_message.proto2\xab\x13\n" +
+	"\x13guild_service.proto\x12\x05guild\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x13guild_message.proto2\xcf1\n" +
 	"\fGuildService\x12f\n" +
 	"\vCreateGuild\x12\x19.guild.CreateGuildRequest\x1a\x1a.guild.CreateGuildResponse\" \x92A\a\n" +
 	"\x05Guild\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/guilds\x12\x86\x01\n" +
@@ -37,12 +37,26 @@ const file_guild_service_proto_rawDesc = "" +
 	"\fListMyGuilds\x12\x1a.guild.ListMyGuildsRequest\x1a\x1b.guild.ListMyGuildsResponse\"&\x92A\a\n" +
 	"\x05Guild\x82\xd3\xe4\x93\x02\x16\x12\x14/api/users/me/guilds\x12q\n" +
 	"\vUpdateGuild\x12\x19.guild.UpdateGuildRequest\x1a\x1a.guild.UpdateGuildResponse\"+\x92A\a\n" +
-	"\x05Guild\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/guilds/{guild_id}\x12\x93\x01\n" +
+	"\x05Guild\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/guilds/{guild_id}\x12n\n" +
+	"\vDeleteGuild\x12\x19.guild.DeleteGuildRequest\x1a\x1a.guild.DeleteGuildResponse\"(\x92A\a\n" +
+	"\x05Guild\x82\xd3\xe4\x93\x02\x18*\x16/api/guilds/{guild_id}\x12\x98\x01\n" +
+	"\x16TransferGuildOwnership\x12$.guild.TransferGuildOwnershipRequest\x1a%.guild.TransferGuildOwnershipResponse\"1\x92A\a\n" +
+	"\x05Guild\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/guilds/{guild_id}/owner\x12|\n" +
+	"\fListAuditLog\x12\x1a.guild.ListAuditLogRequest\x1a\x1b.guild.ListAuditLogResponse\"3\x92A\a\n" +
+	"\x05Guild\x82\xd3\xe4\x93\x02#\x12!/api/guilds/{guild_id}/audit-logs\x12\x93\x01\n" +
 	"\x11DeleteGuildMember\x12\x1f.guild.DeleteGuildMemberRequest\x1a .guild.DeleteGuildMemberResponse\";\x92A\b\n" +
 	"\x06Member\x82\xd3\xe4\x93\x02**(/api/guilds/{guild_id}/members/{user_id}\x12w\n" +
 	"\n" +
 	"LeaveGuild\x12\x18.guild.LeaveGuildRequest\x1a\x19.guild.LeaveGuildResponse\"4\x92A\b\n" +
-	"\x06Member\x82\xd3\xe4\x93\x02#*!/api/guilds/{guild_id}/members/me\x12\x83\x01\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02#*!/api/guilds/{guild_id}/members/me\x12\x92\x01\n" +
+	"\rTimeoutMember\x12\x1b.guild.TimeoutMemberRequest\x1a\x1c.guild.TimeoutMemberResponse\"F\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x025:\x01*\x1a0/api/guilds/{guild_id}/members/{user_id}/timeout\x12{\n" +
+	"\tBanMember\x12\x17.guild.BanMemberRequest\x1a\x18.guild.BanMemberResponse\";\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/guilds/{guild_id}/bans/{user_id}\x12~\n" +
+	"\vUnbanMember\x12\x19.guild.UnbanMemberRequest\x1a\x1a.guild.UnbanMemberResponse\"8\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02'*%/api/guilds/{guild_id}/bans/{user_id}\x12k\n" +
+	"\bListBans\x12\x16.guild.ListBansRequest\x1a\x17.guild.ListBansResponse\".\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/guilds/{guild_id}/bans\x12\x83\x01\n" +
 	"\x0fGetGuildInvites\x12\x1d.guild.GetGuildInvitesRequest\x1a\x1e.guild.GetGuildInvitesResponse\"1\x92A\b\n" +
 	"\x06Invite\x82\xd3\xe4\x93\x02 \x12\x1e/api/guilds/{guild_id}/invites\x12\x8e\x01\n" +
 	"\x14GetGuildByInviteCode\x12\".guild.GetGuildByInviteCodeRequest\x1a#.guild.GetGuildByInviteCodeResponse\"-\x92A\b\n" +
@@ -50,7 +64,11 @@ const file_guild_service_proto_rawDesc = "" +
 	"\x11CreateGuildInvite\x12\x1f.guild.CreateGuildInviteRequest\x1a .guild.CreateGuildInviteResponse\"4\x92A\b\n" +
 	"\x06Invite\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/guilds/{guild_id}/invites\x12\x85\x01\n" +
 	"\x11DeleteGuildInvite\x12\x1f.guild.DeleteGuildInviteRequest\x1a .guild.DeleteGuildInviteResponse\"-\x92A\b\n" +
-	"\x06Invite\x82\xd3\xe4\x93\x02\x1c*\x1a/api/invites/{invite_code}\x12u\n" +
+	"\x06Invite\x82\xd3\xe4\x93\x02\x1c*\x1a/api/invites/{invite_code}\x12\x9b\x01\n" +
+	"\x14SetGuildVanityInvite\x12\".guild.SetGuildVanityInviteRequest\x1a#.guild.SetGuildVanityInviteResponse\":\x92A\b\n" +
+	"\x06Invite\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/guilds/{guild_id}/vanity-invite\x12\xa2\x01\n" +
+	"\x13ListGuildInviteUses\x12!.guild.ListGuildInviteUsesRequest\x1a\".guild.ListGuildInviteUsesResponse\"D\x92A\b\n" +
+	"\x06Invite\x82\xd3\xe4\x93\x023\x121/api/guilds/{guild_id}/invites/{invite_code}/uses\x12u\n" +
 	"\tJoinGuild\x12\x17.guild.JoinGuildRequest\x1a\x18.guild.JoinGuildResponse\"5\x92A\b\n" +
 	"\x06Invite\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/invites/{invite_code}/join\x12\x88\x01\n" +
 	"\x0eCreateCategory\x12\x1c.guild.CreateCategoryRequest\x1a\x1d.guild.CreateCategoryResponse\"9\x92A\n" +
@@ -67,50 +85,145 @@ const file_guild_service_proto_rawDesc = "" +
 	"\rUpdateChannel\x12\x1b.guild.UpdateChannelRequest\x1a\x1c.guild.UpdateChannelResponse\"1\x92A\t\n" +
 	"\aChannel\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/channels/{channel_id}\x12z\n" +
 	"\rDeleteChannel\x12\x1b.guild.DeleteChannelRequest\x1a\x1c.guild.DeleteChannelResponse\".\x92A\t\n" +
-	"\aChannel\x82\xd3\xe4\x93\x02\x1c*\x1a/api/channels/{channel_id}\x12Y\n" +
-	"\x12CheckChannelAccess\x12 .guild.CheckChannelAccessRequest\x1a!.guild.CheckChannelAccessResponse\x1a'\x92A$\n" +
+	"\aChannel\x82\xd3\xe4\x93\x02\x1c*\x1a/api/channels/{channel_id}\x12\x88\x01\n" +
+	"\x0fReorderChannels\x12\x1d.guild.ReorderChannelsRequest\x1a\x1e.guild.ReorderChannelsResponse\"6\x92A\t\n" +
+	"\aChannel\x82\xd3\xe4\x93\x02$:\x01*2\x1f/api/guilds/{guild_id}/channels\x12m\n" +
+	"\tListRoles\x12\x17.guild.ListRolesRequest\x1a\x18.guild.ListRolesResponse\"-\x92A\x06\n" +
+	"\x04Role\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/guilds/{guild_id}/roles\x12s\n" +
+	"\n" +
+	"CreateRole\x12\x18.guild.CreateRoleRequest\x1a\x19.guild.CreateRoleResponse\"0\x92A\x06\n" +
+	"\x04Role\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/guilds/{guild_id}/roles\x12y\n" +
+	"\fReorderRoles\x12\x1a.guild.ReorderRolesRequest\x1a\x1b.guild.ReorderRolesResponse\"0\x92A\x06\n" +
+	"\x04Role\x82\xd3\xe4\x93\x02!:\x01*2\x1c/api/guilds/{guild_id}/roles\x12k\n" +
+	"\n" +
+	"UpdateRole\x12\x18.guild.UpdateRoleRequest\x1a\x19.guild.UpdateRoleResponse\"(\x92A\x06\n" +
+	"\x04Role\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/roles/{role_id}\x12h\n" +
+	"\n" +
+	"DeleteRole\x12\x18.guild.DeleteRoleRequest\x1a\x19.guild.DeleteRoleResponse\"%\x92A\x06\n" +
+	"\x04Role\x82\xd3\xe4\x93\x02\x16*\x14/api/roles/{role_id}\x12\x97\x01\n" +
+	"\rAddMemberRole\x12\x1b.guild.AddMemberRoleRequest\x1a\x1c.guild.AddMemberRoleResponse\"K\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02:\x1a8/api/guilds/{guild_id}/members/{user_id}/roles/{role_id}\x12\xa0\x01\n" +
+	"\x10RemoveMemberRole\x12\x1e.guild.RemoveMemberRoleRequest\x1a\x1f.guild.RemoveMemberRoleResponse\"K\x92A\b\n" +
+	"\x06Member\x82\xd3\xe4\x93\x02:*8/api/guilds/{guild_id}/members/{user_id}/roles/{role_id}\x12\xbf\x01\n" +
+	"\x1fListChannelPermissionOverwrites\x12-.guild.ListChannelPermissionOverwritesRequest\x1a..guild.ListChannelPermissionOverwritesResponse\"=\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x02(\x12&/api/channels/{channel_id}/permissions\x12\xc8\x01\n" +
+	"\x1dSetChannelPermissionOverwrite\x12+.guild.SetChannelPermissionOverwriteRequest\x1a,.guild.SetChannelPermissionOverwriteResponse\"L\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x027:\x01*\x1a2/api/channels/{channel_id}/permissions/{target_id}\x12\xce\x01\n" +
+	" DeleteChannelPermissionOverwrite\x12..guild.DeleteChannelPermissionOverwriteRequest\x1a/.guild.DeleteChannelPermissionOverwriteResponse\"I\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x024*2/api/channels/{channel_id}/permissions/{target_id}\x12\xc5\x01\n" +
+	" ListCategoryPermissionOverwrites\x12..guild.ListCategoryPermissionOverwritesRequest\x1a/.guild.ListCategoryPermissionOverwritesResponse\"@\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x02+\x12)/api/categories/{category_id}/permissions\x12\xce\x01\n" +
+	"\x1eSetCategoryPermissionOverwrite\x12,.guild.SetCategoryPermissionOverwriteRequest\x1a-.guild.SetCategoryPermissionOverwriteResponse\"O\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x02::\x01*\x1a5/api/categories/{category_id}/permissions/{target_id}\x12\xd4\x01\n" +
+	"!DeleteCategoryPermissionOverwrite\x12/.guild.DeleteCategoryPermissionOverwriteRequest\x1a0.guild.DeleteCategoryPermissionOverwriteResponse\"L\x92A\f\n" +
+	"\n" +
+	"Permission\x82\xd3\xe4\x93\x027*5/api/categories/{category_id}/permissions/{target_id}\x12Y\n" +
+	"\x12CheckChannelAccess\x12 .guild.CheckChannelAccessRequest\x1a!.guild.CheckChannelAccessResponse\x12_\n" +
+	"\x14FilterMentionTargets\x12\".guild.FilterMentionTargetsRequest\x1a#.guild.FilterMentionTargetsResponse\x12k\n" +
+	"\x18ListAccessibleChannelIDs\x12&.guild.ListAccessibleChannelIDsRequest\x1a'.guild.ListAccessibleChannelIDsResponse\x12h\n" +
+	"\x17BatchCheckChannelAccess\x12%.guild.BatchCheckChannelAccessRequest\x1a&.guild.BatchCheckChannelAccessResponse\x12S\n" +
+	"\x10ListUserGuildIDs\x12\x1e.guild.ListUserGuildIDsRequest\x1a\x1f.guild.ListUserGuildIDsResponse\x1a'\x92A$\n" +
 	"\x05Guild\x12\x1bGuild management operationsBc\n" +
 	"\tcom.guildB\x11GuildServiceProtoP\x01Z\x0f./guild;guildpb\xa2\x02\x03GXX\xaa\x02\x05Guild\xca\x02\x05Guild\xe2\x02\x11Guild\\GPBMetadata\xea\x02\x05Guildb\x06proto3"
 
 var file_guild_service_proto_goTypes = []any{
-	(*CreateGuildRequest)(nil),           // 0: guild.CreateGuildRequest
-	(*GetGuildOverviewRequest)(nil),      // 1: guild.GetGuildOverviewRequest
-	(*GetGuildByIDRequest)(nil),          // 2: guild.GetGuildByIDRequest
-	(*ListMyGuildsRequest)(nil),          // 3: guild.ListMyGuildsRequest
-	(*UpdateGuildRequest)(nil),           // 4: guild.UpdateGuildRequest
-	(*DeleteGuildMemberRequest)(nil),     // 5: guild.DeleteGuildMemberRequest
-	(*LeaveGuildRequest)(nil),            // 6: guild.LeaveGuildRequest
-	(*GetGuildInvitesRequest)(nil),       // 7: guild.GetGuildInvitesRequest
-	(*GetGuildByInviteCodeRequest)(nil),  // 8: guild.GetGuildByInviteCodeRequest
-	(*CreateGuildInviteRequest)(nil),     // 9: guild.CreateGuildInviteRequest
-	(*DeleteGuildInviteRequest)(nil),     // 10: guild.DeleteGuildInviteRequest
-	(*JoinGuildRequest)(nil),             // 11: guild.JoinGuildRequest
-	(*CreateCategoryRequest)(nil),        // 12: guild.CreateCategoryRequest
-	(*UpdateCategoryRequest)(nil),        // 13: guild.UpdateCategoryRequest
-	(*DeleteCategoryRequest)(nil),        // 14: guild.DeleteCategoryRequest
-	(*CreateChannelRequest)(nil),         // 15: guild.CreateChannelRequest
-	(*UpdateChannelRequest)(nil),         // 16: guild.UpdateChannelRequest
-	(*DeleteChannelRequest)(nil),         // 17: guild.DeleteChannelRequest
-	(*CheckChannelAccessRequest)(nil),    // 18: guild.CheckChannelAccessRequest
-	(*CreateGuildResponse)(nil),          // 19: guild.CreateGuildResponse
-	(*GetGuildOverviewResponse)(nil),     // 20: guild.GetGuildOverviewResponse
-	(*GetGuildByIDResponse)(nil),         // 21: guild.GetGuildByIDResponse
-	(*ListMyGuildsResponse)(nil),         // 22: guild.ListMyGuildsResponse
-	(*UpdateGuildResponse)(nil),          // 23: guild.UpdateGuildResponse
-	(*DeleteGuildMemberResponse)(nil),    // 24: guild.DeleteGuildMemberResponse
-	(*LeaveGuildResponse)(nil),           // 25: guild.LeaveGuildResponse
-	(*GetGuildInvitesResponse)(nil),      // 26: guild.GetGuildInvitesResponse
-	(*GetGuildByInviteCodeResponse)(nil), // 27: guild.GetGuildByInviteCodeResponse
-	(*CreateGuildInviteResponse)(nil),    // 28: guild.CreateGuildInviteResponse
-	(*DeleteGuildInviteResponse)(nil),    // 29: guild.DeleteGuildInviteResponse
-	(*JoinGuildResponse)(nil),            // 30: guild.JoinGuildResponse
-	(*CreateCategoryResponse)(nil),       // 31: guild.CreateCategoryResponse
-	(*UpdateCategoryResponse)(nil),       // 32: guild.UpdateCategoryResponse
-	(*DeleteCategoryResponse)(nil),       // 33: guild.DeleteCategoryResponse
-	(*CreateChannelResponse)(nil),        // 34: guild.CreateChannelResponse
-	(*UpdateChannelResponse)(nil),        // 35: guild.UpdateChannelResponse
-	(*DeleteChannelResponse)(nil),        // 36: guild.DeleteChannelResponse
-	(*CheckChannelAccessResponse)(nil),   // 37: guild.CheckChannelAccessResponse
+	(*CreateGuildRequest)(nil),                        // 0: guild.CreateGuildRequest
+	(*GetGuildOverviewRequest)(nil),                   // 1: guild.GetGuildOverviewRequest
+	(*GetGuildByIDRequest)(nil),                       // 2: guild.GetGuildByIDRequest
+	(*ListMyGuildsRequest)(nil),                       // 3: guild.ListMyGuildsRequest
+	(*UpdateGuildRequest)(nil),                        // 4: guild.UpdateGuildRequest
+	(*DeleteGuildRequest)(nil),                        // 5: guild.DeleteGuildRequest
+	(*TransferGuildOwnershipRequest)(nil),             // 6: guild.TransferGuildOwnershipRequest
+	(*ListAuditLogRequest)(nil),                       // 7: guild.ListAuditLogRequest
+	(*DeleteGuildMemberRequest)(nil),                  // 8: guild.DeleteGuildMemberRequest
+	(*LeaveGuildRequest)(nil),                         // 9: guild.LeaveGuildRequest
+	(*TimeoutMemberRequest)(nil),                      // 10: guild.TimeoutMemberRequest
+	(*BanMemberRequest)(nil),                          // 11: guild.BanMemberRequest
+	(*UnbanMemberRequest)(nil),                        // 12: guild.UnbanMemberRequest
+	(*ListBansRequest)(nil),                           // 13: guild.ListBansRequest
+	(*GetGuildInvitesRequest)(nil),                    // 14: guild.GetGuildInvitesRequest
+	(*GetGuildByInviteCodeRequest)(nil),               // 15: guild.GetGuildByInviteCodeRequest
+	(*CreateGuildInviteRequest)(nil),                  // 16: guild.CreateGuildInviteRequest
+	(*DeleteGuildInviteRequest)(nil),                  // 17: guild.DeleteGuildInviteRequest
+	(*SetGuildVanityInviteRequest)(nil),               // 18: guild.SetGuildVanityInviteRequest
+	(*ListGuildInviteUsesRequest)(nil),                // 19: guild.ListGuildInviteUsesRequest
+	(*JoinGuildRequest)(nil),                          // 20: guild.JoinGuildRequest
+	(*CreateCategoryRequest)(nil),                     // 21: guild.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),                     // 22: guild.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),                     // 23: guild.DeleteCategoryRequest
+	(*CreateChannelRequest)(nil),                      // 24: guild.CreateChannelRequest
+	(*UpdateChannelRequest)(nil),                      // 25: guild.UpdateChannelRequest
+	(*DeleteChannelRequest)(nil),                      // 26: guild.DeleteChannelRequest
+	(*ReorderChannelsRequest)(nil),                    // 27: guild.ReorderChannelsRequest
+	(*ListRolesRequest)(nil),                          // 28: guild.ListRolesRequest
+	(*CreateRoleRequest)(nil),                         // 29: guild.CreateRoleRequest
+	(*ReorderRolesRequest)(nil),                       // 30: guild.ReorderRolesRequest
+	(*UpdateRoleRequest)(nil),                         // 31: guild.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),                         // 32: guild.DeleteRoleRequest
+	(*AddMemberRoleRequest)(nil),                      // 33: guild.AddMemberRoleRequest
+	(*RemoveMemberRoleRequest)(nil),                   // 34: guild.RemoveMemberRoleRequest
+	(*ListChannelPermissionOverwritesRequest)(nil),    // 35: guild.ListChannelPermissionOverwritesRequest
+	(*SetChannelPermissionOverwriteRequest)(nil),      // 36: guild.SetChannelPermissionOverwriteRequest
+	(*DeleteChannelPermissionOverwriteRequest)(nil),   // 37: guild.DeleteChannelPermissionOverwriteRequest
+	(*ListCategoryPermissionOverwritesRequest)(nil),   // 38: guild.ListCategoryPermissionOverwritesRequest
+	(*SetCategoryPermissionOverwriteRequest)(nil),     // 39: guild.SetCategoryPermissionOverwriteRequest
+	(*DeleteCategoryPermissionOverwriteRequest)(nil),  // 40: guild.DeleteCategoryPermissionOverwriteRequest
+	(*CheckChannelAccessRequest)(nil),                 // 41: guild.CheckChannelAccessRequest
+	(*FilterMentionTargetsRequest)(nil),               // 42: guild.FilterMentionTargetsRequest
+	(*ListAccessibleChannelIDsRequest)(nil),           // 43: guild.ListAccessibleChannelIDsRequest
+	(*BatchCheckChannelAccessRequest)(nil),            // 44: guild.BatchCheckChannelAccessRequest
+	(*ListUserGuildIDsRequest)(nil),                   // 45: guild.ListUserGuildIDsRequest
+	(*CreateGuildResponse)(nil),                       // 46: guild.CreateGuildResponse
+	(*GetGuildOverviewResponse)(nil),                  // 47: guild.GetGuildOverviewResponse
+	(*GetGuildByIDResponse)(nil),                      // 48: guild.GetGuildByIDResponse
+	(*ListMyGuildsResponse)(nil),                      // 49: guild.ListMyGuildsResponse
+	(*UpdateGuildResponse)(nil),                       // 50: guild.UpdateGuildResponse
+	(*DeleteGuildResponse)(nil),                       // 51: guild.DeleteGuildResponse
+	(*TransferGuildOwnershipResponse)(nil),            // 52: guild.TransferGuildOwnershipResponse
+	(*ListAuditLogResponse)(nil),                      // 53: guild.ListAuditLogResponse
+	(*DeleteGuildMemberResponse)(nil),                 // 54: guild.DeleteGuildMemberResponse
+	(*LeaveGuildResponse)(nil),                        // 55: guild.LeaveGuildResponse
+	(*TimeoutMemberResponse)(nil),                     // 56: guild.TimeoutMemberResponse
+	(*BanMemberResponse)(nil),                         // 57: guild.BanMemberResponse
+	(*UnbanMemberResponse)(nil),                       // 58: guild.UnbanMemberResponse
+	(*ListBansResponse)(nil),                          // 59: guild.ListBansResponse
+	(*GetGuildInvitesResponse)(nil),                   // 60: guild.GetGuildInvitesResponse
+	(*GetGuildByInviteCodeResponse)(nil),              // 61: guild.GetGuildByInviteCodeResponse
+	(*CreateGuildInviteResponse)(nil),                 // 62: guild.CreateGuildInviteResponse
+	(*DeleteGuildInviteResponse)(nil),                 // 63: guild.DeleteGuildInviteResponse
+	(*SetGuildVanityInviteResponse)(nil),              // 64: guild.SetGuildVanityInviteResponse
+	(*ListGuildInviteUsesResponse)(nil),               // 65: guild.ListGuildInviteUsesResponse
+	(*JoinGuildResponse)(nil),                         // 66: guild.JoinGuildResponse
+	(*CreateCategoryResponse)(nil),                    // 67: guild.CreateCategoryResponse
+	(*UpdateCategoryResponse)(nil),                    // 68: guild.UpdateCategoryResponse
+	(*DeleteCategoryResponse)(nil),                    // 69: guild.DeleteCategoryResponse
+	(*CreateChannelResponse)(nil),                     // 70: guild.CreateChannelResponse
+	(*UpdateChannelResponse)(nil),                     // 71: guild.UpdateChannelResponse
+	(*DeleteChannelResponse)(nil),                     // 72: guild.DeleteChannelResponse
+	(*ReorderChannelsResponse)(nil),                   // 73: guild.ReorderChannelsResponse
+	(*ListRolesResponse)(nil),                         // 74: guild.ListRolesResponse
+	(*CreateRoleResponse)(nil),                        // 75: guild.CreateRoleResponse
+	(*ReorderRolesResponse)(nil),                      // 76: guild.ReorderRolesResponse
+	(*UpdateRoleResponse)(nil),                        // 77: guild.UpdateRoleResponse
+	(*DeleteRoleResponse)(nil),                        // 78: guild.DeleteRoleResponse
+	(*AddMemberRoleResponse)(nil),                     // 79: guild.AddMemberRoleResponse
+	(*RemoveMemberRoleResponse)(nil),                  // 80: guild.RemoveMemberRoleResponse
+	(*ListChannelPermissionOverwritesResponse)(nil),   // 81: guild.ListChannelPermissionOverwritesResponse
+	(*SetChannelPermissionOverwriteResponse)(nil),     // 82: guild.SetChannelPermissionOverwriteResponse
+	(*DeleteChannelPermissionOverwriteResponse)(nil),  // 83: guild.DeleteChannelPermissionOverwriteResponse
+	(*ListCategoryPermissionOverwritesResponse)(nil),  // 84: guild.ListCategoryPermissionOverwritesResponse
+	(*SetCategoryPermissionOverwriteResponse)(nil),    // 85: guild.SetCategoryPermissionOverwriteResponse
+	(*DeleteCategoryPermissionOverwriteResponse)(nil), // 86: guild.DeleteCategoryPermissionOverwriteResponse
+	(*CheckChannelAccessResponse)(nil),                // 87: guild.CheckChannelAccessResponse
+	(*FilterMentionTargetsResponse)(nil),              // 88: guild.FilterMentionTargetsResponse
+	(*ListAccessibleChannelIDsResponse)(nil),          // 89: guild.ListAccessibleChannelIDsResponse
+	(*BatchCheckChannelAccessResponse)(nil),           // 90: guild.BatchCheckChannelAccessResponse
+	(*ListUserGuildIDsResponse)(nil),                  // 91: guild.ListUserGuildIDsResponse
 }
 var file_guild_service_proto_depIdxs = []int32{
 	0,  // 0: guild.GuildService.CreateGuild:input_type -> guild.CreateGuildRequest
@@ -118,41 +231,95 @@ var file_guild_service_proto_depIdxs = []int32{
 	2,  // 2: guild.GuildService.GetGuildByID:input_type -> guild.GetGuildByIDRequest
 	3,  // 3: guild.GuildService.ListMyGuilds:input_type -> guild.ListMyGuildsRequest
 	4,  // 4: guild.GuildService.UpdateGuild:input_type -> guild.UpdateGuildRequest
-	5,  // 5: guild.GuildService.DeleteGuildMember:input_type -> guild.DeleteGuildMemberRequest
-	6,  // 6: guild.GuildService.LeaveGuild:input_type -> guild.LeaveGuildRequest
-	7,  // 7: guild.GuildService.GetGuildInvites:input_type -> guild.GetGuildInvitesRequest
-	8,  // 8: guild.GuildService.GetGuildByInviteCode:input_type -> guild.GetGuildByInviteCodeRequest
-	9,  // 9: guild.GuildService.CreateGuildInvite:input_type -> guild.CreateGuildInviteRequest
-	10, // 10: guild.GuildService.DeleteGuildInvite:input_type -> guild.DeleteGuildInviteRequest
-	11, // 11: guild.GuildService.JoinGuild:input_type -> guild.JoinGuildRequest
-	12, // 12: guild.GuildService.CreateCategory:input_type -> guild.CreateCategoryRequest
-	13, // 13: guild.GuildService.UpdateCategory:input_type -> guild.UpdateCategoryRequest
-	14, // 14: guild.GuildService.DeleteCategory:input_type -> guild.DeleteCategoryRequest
-	15, // 15: guild.GuildService.CreateChannel:input_type -> guild.CreateChannelRequest
-	16, // 16: guild.GuildService.UpdateChannel:input_type -> guild.UpdateChannelRequest
-	17, // 17: guild.GuildService.DeleteChannel:input_type -> guild.DeleteChannelRequest
-	18, // 18: guild.GuildService.CheckChannelAccess:input_type -> guild.CheckChannelAccessRequest
-	19, // 19: guild.GuildService.CreateGuild:output_type -> guild.CreateGuildResponse
-	20, // 20: guild.GuildService.GetGuildOverview:output_type -> guild.GetGuildOverviewResponse
-	21, // 21: guild.GuildService.GetGuildByID:output_type -> guild.GetGuildByIDResponse
-	22, // 22: guild.GuildService.ListMyGuilds:output_type -> guild.ListMyGuildsResponse
-	23, // 23: guild.GuildService.UpdateGuild:output_type -> guild.UpdateGuildResponse
-	24, // 24: guild.GuildService.DeleteGuildMember:output_type -> guild.DeleteGuildMemberResponse
-	25, // 25: guild.GuildService.LeaveGuild:output_type -> guild.LeaveGuildResponse
-	26, // 26: guild.GuildService.GetGuildInvites:output_type -> guild.GetGuildInvitesResponse
-	27, // 27: guild.GuildService.GetGuildByInviteCode:output_type -> guild.GetGuildByInviteCodeResponse
-	28, // 28: guild.GuildService.CreateGuildInvite:output_type -> guild.CreateGuildInviteResponse
-	29, // 29: guild.GuildService.DeleteGuildInvite:output_type -> guild.DeleteGuildInviteResponse
-	30, // 30: guild.GuildService.JoinGuild:output_type -> guild.JoinGuildResponse
-	31, // 31: guild.GuildService.CreateCategory:output_type -> guild.CreateCategoryResponse
-	32, // 32: guild.GuildService.UpdateCategory:output_type -> guild.UpdateCategoryResponse
-	33, // 33: guild.GuildService.DeleteCategory:output_type -> guild.DeleteCategoryResponse
-	34, // 34: guild.GuildService.CreateChannel:output_type -> guild.CreateChannelResponse
-	35, // 35: guild.GuildService.UpdateChannel:output_type -> guild.UpdateChannelResponse
-	36, // 36: guild.GuildService.DeleteChannel:output_type -> guild.DeleteChannelResponse
-	37, // 37: guild.GuildService.CheckChannelAccess:output_type -> guild.CheckChannelAccessResponse
-	19, // [19:38] is the sub-list for method output_type
-	0,  // [0:19] is the sub-list for method input_type
+	5,  // 5: guild.GuildService.DeleteGuild:input_type -> guild.DeleteGuildRequest
+	6,  // 6: guild.GuildService.TransferGuildOwnership:input_type -> guild.TransferGuildOwnershipRequest
+	7,  // 7: guild.GuildService.ListAuditLog:input_type -> guild.ListAuditLogRequest
+	8,  // 8: guild.GuildService.DeleteGuildMember:input_type -> guild.DeleteGuildMemberRequest
+	9,  // 9: guild.GuildService.LeaveGuild:input_type -> guild.LeaveGuildRequest
+	10, // 10: guild.GuildService.TimeoutMember:input_type -> guild.TimeoutMemberRequest
+	11, // 11: guild.GuildService.BanMember:input_type -> guild.BanMemberRequest
+	12, // 12: guild.GuildService.UnbanMember:input_type -> guild.UnbanMemberRequest
+	13, // 13: guild.GuildService.ListBans:input_type -> guild.ListBansRequest
+	14, // 14: guild.GuildService.GetGuildInvites:input_type -> guild.GetGuildInvitesRequest
+	15, // 15: guild.GuildService.GetGuildByInviteCode:input_type -> guild.GetGuildByInviteCodeRequest
+	16, // 16: guild.GuildService.CreateGuildInvite:input_type -> guild.CreateGuildInviteRequest
+	17, // 17: guild.GuildService.DeleteGuildInvite:input_type -> guild.DeleteGuildInviteRequest
+	18, // 18: guild.GuildService.SetGuildVanityInvite:input_type -> guild.SetGuildVanityInviteRequest
+	19, // 19: guild.GuildService.ListGuildInviteUses:input_type -> guild.ListGuildInviteUsesRequest
+	20, // 20: guild.GuildService.JoinGuild:input_type -> guild.JoinGuildRequest
+	21, // 21: guild.GuildService.CreateCategory:input_type -> guild.CreateCategoryRequest
+	22, // 22: guild.GuildService.UpdateCategory:input_type -> guild.UpdateCategoryRequest
+	23, // 23: guild.GuildService.DeleteCategory:input_type -> guild.DeleteCategoryRequest
+	24, // 24: guild.GuildService.CreateChannel:input_type -> guild.CreateChannelRequest
+	25, // 25: guild.GuildService.UpdateChannel:input_type -> guild.UpdateChannelRequest
+	26, // 26: guild.GuildService.DeleteChannel:input_type -> guild.DeleteChannelRequest
+	27, // 27: guild.GuildService.ReorderChannels:input_type -> guild.ReorderChannelsRequest
+	28, // 28: guild.GuildService.ListRoles:input_type -> guild.ListRolesRequest
+	29, // 29: guild.GuildService.CreateRole:input_type -> guild.CreateRoleRequest
+	30, // 30: guild.GuildService.ReorderRoles:input_type -> guild.ReorderRolesRequest
+	31, // 31: guild.GuildService.UpdateRole:input_type -> guild.UpdateRoleRequest
+	32, // 32: guild.GuildService.DeleteRole:input_type -> guild.DeleteRoleRequest
+	33, // 33: guild.GuildService.AddMemberRole:input_type -> guild.AddMemberRoleRequest
+	34, // 34: guild.GuildService.RemoveMemberRole:input_type -> guild.RemoveMemberRoleRequest
+	35, // 35: guild.GuildService.ListChannelPermissionOverwrites:input_type -> guild.ListChannelPermissionOverwritesRequest
+	36, // 36: guild.GuildService.SetChannelPermissionOverwrite:input_type -> guild.SetChannelPermissionOverwriteRequest
+	37, // 37: guild.GuildService.DeleteChannelPermissionOverwrite:input_type -> guild.DeleteChannelPermissionOverwriteRequest
+	38, // 38: guild.GuildService.ListCategoryPermissionOverwrites:input_type -> guild.ListCategoryPermissionOverwritesRequest
+	39, // 39: guild.GuildService.SetCategoryPermissionOverwrite:input_type -> guild.SetCategoryPermissionOverwriteRequest
+	40, // 40: guild.GuildService.DeleteCategoryPermissionOverwrite:input_type -> guild.DeleteCategoryPermissionOverwriteRequest
+	41, // 41: guild.GuildService.CheckChannelAccess:input_type -> guild.CheckChannelAccessRequest
+	42, // 42: guild.GuildService.FilterMentionTargets:input_type -> guild.FilterMentionTargetsRequest
+	43, // 43: guild.GuildService.ListAccessibleChannelIDs:input_type -> guild.ListAccessibleChannelIDsRequest
+	44, // 44: guild.GuildService.BatchCheckChannelAccess:input_type -> guild.BatchCheckChannelAccessRequest
+	45, // 45: guild.GuildService.ListUserGuildIDs:input_type -> guild.ListUserGuildIDsRequest
+	46, // 46: guild.GuildService.CreateGuild:output_type -> guild.CreateGuildResponse
+	47, // 47: guild.GuildService.GetGuildOverview:output_type -> guild.GetGuildOverviewResponse
+	48, // 48: guild.GuildService.GetGuildByID:output_type -> guild.GetGuildByIDResponse
+	49, // 49: guild.GuildService.ListMyGuilds:output_type -> guild.ListMyGuildsResponse
+	50, // 50: guild.GuildService.UpdateGuild:output_type -> guild.UpdateGuildResponse
+	51, // 51: guild.GuildService.DeleteGuild:output_type -> guild.DeleteGuildResponse
+	52, // 52: guild.GuildService.TransferGuildOwnership:output_type -> guild.TransferGuildOwnershipResponse
+	53, // 53: guild.GuildService.ListAuditLog:output_type -> guild.ListAuditLogResponse
+	54, // 54: guild.GuildService.DeleteGuildMember:output_type -> guild.DeleteGuildMemberResponse
+	55, // 55: guild.GuildService.LeaveGuild:output_type -> guild.LeaveGuildResponse
+	56, // 56: guild.GuildService.TimeoutMember:output_type -> guild.TimeoutMemberResponse
+	57, // 57: guild.GuildService.BanMember:output_type -> guild.BanMemberResponse
+	58, // 58: guild.GuildService.UnbanMember:output_type -> guild.UnbanMemberResponse
+	59, // 59: guild.GuildService.ListBans:output_type -> guild.ListBansResponse
+	60, // 60: guild.GuildService.GetGuildInvites:output_type -> guild.GetGuildInvitesResponse
+	61, // 61: guild.GuildService.GetGuildByInviteCode:output_type -> guild.GetGuildByInviteCodeResponse
+	62, // 62: guild.GuildService.CreateGuildInvite:output_type -> guild.CreateGuildInviteResponse
+	63, // 63: guild.GuildService.DeleteGuildInvite:output_type -> guild.DeleteGuildInviteResponse
+	64, // 64: guild.GuildService.SetGuildVanityInvite:output_type -> guild.SetGuildVanityInviteResponse
+	65, // 65: guild.GuildService.ListGuildInviteUses:output_type -> guild.ListGuildInviteUsesResponse
+	66, // 66: guild.GuildService.JoinGuild:output_type -> guild.JoinGuildResponse
+	67, // 67: guild.GuildService.CreateCategory:output_type -> guild.CreateCategoryResponse
+	68, // 68: guild.GuildService.UpdateCategory:output_type -> guild.UpdateCategoryResponse
+	69, // 69: guild.GuildService.DeleteCategory:output_type -> guild.DeleteCategoryResponse
+	70, // 70: guild.GuildService.CreateChannel:output_type -> guild.CreateChannelResponse
+	71, // 71: guild.GuildService.UpdateChannel:output_type -> guild.UpdateChannelResponse
+	72, // 72: guild.GuildService.DeleteChannel:output_type -> guild.DeleteChannelResponse
+	73, // 73: guild.GuildService.ReorderChannels:output_type -> guild.ReorderChannelsResponse
+	74, // 74: guild.GuildService.ListRoles:output_type -> guild.ListRolesResponse
+	75, // 75: guild.GuildService.CreateRole:output_type -> guild.CreateRoleResponse
+	76, // 76: guild.GuildService.ReorderRoles:output_type -> guild.ReorderRolesResponse
+	77, // 77: guild.GuildService.UpdateRole:output_type -> guild.UpdateRoleResponse
+	78, // 78: guild.GuildService.DeleteRole:output_type -> guild.DeleteRoleResponse
+	79, // 79: guild.GuildService.AddMemberRole:output_type -> guild.AddMemberRoleResponse
+	80, // 80: guild.GuildService.RemoveMemberRole:output_type -> guild.RemoveMemberRoleResponse
+	81, // 81: guild.GuildService.ListChannelPermissionOverwrites:output_type -> guild.ListChannelPermissionOverwritesResponse
+	82, // 82: guild.GuildService.SetChannelPermissionOverwrite:output_type -> guild.SetChannelPermissionOverwriteResponse
+	83, // 83: guild.GuildService.DeleteChannelPermissionOverwrite:output_type -> guild.DeleteChannelPermissionOverwriteResponse
+	84, // 84: guild.GuildService.ListCategoryPermissionOverwrites:output_type -> guild.ListCategoryPermissionOverwritesResponse
+	85, // 85: guild.GuildService.SetCategoryPermissionOverwrite:output_type -> guild.SetCategoryPermissionOverwriteResponse
+	86, // 86: guild.GuildService.DeleteCategoryPermissionOverwrite:output_type -> guild.DeleteCategoryPermissionOverwriteResponse
+	87, // 87: guild.GuildService.CheckChannelAccess:output_type -> guild.CheckChannelAccessResponse
+	88, // 88: guild.GuildService.FilterMentionTargets:output_type -> guild.FilterMentionTargetsResponse
+	89, // 89: guild.GuildService.ListAccessibleChannelIDs:output_type -> guild.ListAccessibleChannelIDsResponse
+	90, // 90: guild.GuildService.BatchCheckChannelAccess:output_type -> guild.BatchCheckChannelAccessResponse
+	91, // 91: guild.GuildService.ListUserGuildIDs:output_type -> guild.ListUserGuildIDsResponse
+	46, // [46:92] is the sub-list for method output_type
+	0,  // [0:46] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -19,25 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GuildService_CreateGuild_FullMethodName          = "/guild.GuildService/CreateGuild"
-	GuildService_GetGuildOverview_FullMethodName     = "/guild.GuildService/GetGuildOverview"
-	GuildService_GetGuildByID_FullMethodName         = "/guild.GuildService/GetGuildByID"
-	GuildService_ListMyGuilds_FullMethodName         = "/guild.GuildService/ListMyGuilds"
-	GuildService_UpdateGuild_FullMethodName          = "/guild.GuildService/UpdateGuild"
-	GuildService_DeleteGuildMember_FullMethodName    = "/guild.GuildService/DeleteGuildMember"
-	GuildService_LeaveGuild_FullMethodName           = "/guild.GuildService/LeaveGuild"
-	GuildService_GetGuildInvites_FullMethodName      = "/guild.GuildService/GetGuildInvites"
-	GuildService_GetGuildByInviteCode_FullMethodName = "/guild.GuildService/GetGuildByInviteCode"
-	GuildService_CreateGuildInvite_FullMethodName    = "/guild.GuildService/CreateGuildInvite"
-	GuildService_DeleteGuildInvite_FullMethodName    = "/guild.GuildService/DeleteGuildInvite"
-	GuildService_JoinGuild_FullMethodName            = "/guild.GuildService/JoinGuild"
-	GuildService_CreateCategory_FullMethodName       = "/guild.GuildService/CreateCategory"
-	GuildService_UpdateCategory_FullMethodName       = "/guild.GuildService/UpdateCategory"
-	GuildService_DeleteCategory_FullMethodName       = "/guild.GuildService/DeleteCategory"
-	GuildService_CreateChannel_FullMethodName        = "/guild.GuildService/CreateChannel"
-	GuildService_UpdateChannel_FullMethodName        = "/guild.GuildService/UpdateChannel"
-	GuildService_DeleteChannel_FullMethodName        = "/guild.GuildService/DeleteChannel"
-	GuildService_CheckChannelAccess_FullMethodName   = "/guild.GuildService/CheckChannelAccess"
+	GuildService_CreateGuild_FullMethodName                       = "/guild.GuildService/CreateGuild"
+	GuildService_GetGuildOverview_FullMethodName                  = "/guild.GuildService/GetGuildOverview"
+	GuildService_GetGuildByID_FullMethodName                      = "/guild.GuildService/GetGuildByID"
+	GuildService_ListMyGuilds_FullMethodName                      = "/guild.GuildService/ListMyGuilds"
+	GuildService_UpdateGuild_FullMethodName                       = "/guild.GuildService/UpdateGuild"
+	GuildService_DeleteGuild_FullMethodName                       = "/guild.GuildService/DeleteGuild"
+	GuildService_TransferGuildOwnership_FullMethodName            = "/guild.GuildService/TransferGuildOwnership"
+	GuildService_ListAuditLog_FullMethodName                      = "/guild.GuildService/ListAuditLog"
+	GuildService_DeleteGuildMember_FullMethodName                 = "/guild.GuildService/DeleteGuildMember"
+	GuildService_LeaveGuild_FullMethodName                        = "/guild.GuildService/LeaveGuild"
+	GuildService_TimeoutMember_FullMethodName                     = "/guild.GuildService/TimeoutMember"
+	GuildService_BanMember_FullMethodName                         = "/guild.GuildService/BanMember"
+	GuildService_UnbanMember_FullMethodName                       = "/guild.GuildService/UnbanMember"
+	GuildService_ListBans_FullMethodName                          = "/guild.GuildService/ListBans"
+	GuildService_GetGuildInvites_FullMethodName                   = "/guild.GuildService/GetGuildInvites"
+	GuildService_GetGuildByInviteCode_FullMethodName              = "/guild.GuildService/GetGuildByInviteCode"
+	GuildService_CreateGuildInvite_FullMethodName                 = "/guild.GuildService/CreateGuildInvite"
+	GuildService_DeleteGuildInvite_FullMethodName                 = "/guild.GuildService/DeleteGuildInvite"
+	GuildService_SetGuildVanityInvite_FullMethodName              = "/guild.GuildService/SetGuildVanityInvite"
+	GuildService_ListGuildInviteUses_FullMethodName               = "/guild.GuildService/ListGuildInviteUses"
+	GuildService_JoinGuild_FullMethodName                         = "/guild.GuildService/JoinGuild"
+	GuildService_CreateCategory_FullMethodName                    = "/guild.GuildService/CreateCategory"
+	GuildService_UpdateCategory_FullMethodName                    = "/guild.GuildService/UpdateCategory"
+	GuildService_DeleteCategory_FullMethodName                    = "/guild.GuildService/DeleteCategory"
+	GuildService_CreateChannel_FullMethodName                     = "/guild.GuildService/CreateChannel"
+	GuildService_UpdateChannel_FullMethodName                     = "/guild.GuildService/UpdateChannel"
+	GuildService_DeleteChannel_FullMethodName                     = "/guild.GuildService/DeleteChannel"
+	GuildService_ReorderChannels_FullMethodName                   = "/guild.GuildService/ReorderChannels"
+	GuildService_ListRoles_FullMethodName                         = "/guild.GuildService/ListRoles"
+	GuildService_CreateRole_FullMethodName                        = "/guild.GuildService/CreateRole"
+	GuildService_ReorderRoles_FullMethodName                      = "/guild.GuildService/ReorderRoles"
+	GuildService_UpdateRole_FullMethodName                        = "/guild.GuildService/UpdateRole"
+	GuildService_DeleteRole_FullMethodName                        = "/guild.GuildService/DeleteRole"
+	GuildService_AddMemberRole_FullMethodName                     = "/guild.GuildService/AddMemberRole"
+	GuildService_RemoveMemberRole_FullMethodName                  = "/guild.GuildService/RemoveMemberRole"
+	GuildService_ListChannelPermissionOverwrites_FullMethodName   = "/guild.GuildService/ListChannelPermissionOverwrites"
+	GuildService_SetChannelPermissionOverwrite_FullMethodName     = "/guild.GuildService/SetChannelPermissionOverwrite"
+	GuildService_DeleteChannelPermissionOverwrite_FullMethodName  = "/guild.GuildService/DeleteChannelPermissionOverwrite"
+	GuildService_ListCategoryPermissionOverwrites_FullMethodName  = "/guild.GuildService/ListCategoryPermissionOverwrites"
+	GuildService_SetCategoryPermissionOverwrite_FullMethodName    = "/guild.GuildService/SetCategoryPermissionOverwrite"
+	GuildService_DeleteCategoryPermissionOverwrite_FullMethodName = "/guild.GuildService/DeleteCategoryPermissionOverwrite"
+	GuildService_CheckChannelAccess_FullMethodName                = "/guild.GuildService/CheckChannelAccess"
+	GuildService_FilterMentionTargets_FullMethodName              = "/guild.GuildService/FilterMentionTargets"
+	GuildService_ListAccessibleChannelIDs_FullMethodName          = "/guild.GuildService/ListAccessibleChannelIDs"
+	GuildService_BatchCheckChannelAccess_FullMethodName           = "/guild.GuildService/BatchCheckChannelAccess"
+	GuildService_ListUserGuildIDs_FullMethodName                  = "/guild.GuildService/ListUserGuildIDs"
 )
 
 // GuildServiceClient is the client API for GuildService service.
@@ -49,20 +76,52 @@ type GuildServiceClient interface {
 	GetGuildByID(ctx context.Context, in *GetGuildByIDRequest, opts ...grpc.CallOption) (*GetGuildByIDResponse, error)
 	ListMyGuilds(ctx context.Context, in *ListMyGuildsRequest, opts ...grpc.CallOption) (*ListMyGuildsResponse, error)
 	UpdateGuild(ctx context.Context, in *UpdateGuildRequest, opts ...grpc.CallOption) (*UpdateGuildResponse, error)
+	// オーナーのみ。チャンネルのメッセージと添付ファイルは非同期で削除される
+	DeleteGuild(ctx context.Context, in *DeleteGuildRequest, opts ...grpc.CallOption) (*DeleteGuildResponse, error)
+	// オーナーのみ。本人確認のためにパスワードの再入力が必要
+	TransferGuildOwnership(ctx context.Context, in *TransferGuildOwnershipRequest, opts ...grpc.CallOption) (*TransferGuildOwnershipResponse, error)
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
 	DeleteGuildMember(ctx context.Context, in *DeleteGuildMemberRequest, opts ...grpc.CallOption) (*DeleteGuildMemberResponse, error)
 	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error)
+	TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error)
+	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error)
+	UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
 	GetGuildInvites(ctx context.Context, in *GetGuildInvitesRequest, opts ...grpc.CallOption) (*GetGuildInvitesResponse, error)
 	GetGuildByInviteCode(ctx context.Context, in *GetGuildByInviteCodeRequest, opts ...grpc.CallOption) (*GetGuildByInviteCodeResponse, error)
 	CreateGuildInvite(ctx context.Context, in *CreateGuildInviteRequest, opts ...grpc.CallOption) (*CreateGuildInviteResponse, error)
 	DeleteGuildInvite(ctx context.Context, in *DeleteGuildInviteRequest, opts ...grpc.CallOption) (*DeleteGuildInviteResponse, error)
+	// 既にバニティURLがある場合は置き換える。削除はDeleteGuildInviteで行う
+	SetGuildVanityInvite(ctx context.Context, in *SetGuildVanityInviteRequest, opts ...grpc.CallOption) (*SetGuildVanityInviteResponse, error)
+	// 招待コードが削除された後も記録は残る
+	ListGuildInviteUses(ctx context.Context, in *ListGuildInviteUsesRequest, opts ...grpc.CallOption) (*ListGuildInviteUsesResponse, error)
 	JoinGuild(ctx context.Context, in *JoinGuildRequest, opts ...grpc.CallOption) (*JoinGuildResponse, error)
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	// 中のチャンネルは最も古いカテゴリーに移す。権限の上書きが残っている場合は削除できない
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
 	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
 	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error)
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ReorderChannelsResponse, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	ReorderRoles(ctx context.Context, in *ReorderRolesRequest, opts ...grpc.CallOption) (*ReorderRolesResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	AddMemberRole(ctx context.Context, in *AddMemberRoleRequest, opts ...grpc.CallOption) (*AddMemberRoleResponse, error)
+	RemoveMemberRole(ctx context.Context, in *RemoveMemberRoleRequest, opts ...grpc.CallOption) (*RemoveMemberRoleResponse, error)
+	ListChannelPermissionOverwrites(ctx context.Context, in *ListChannelPermissionOverwritesRequest, opts ...grpc.CallOption) (*ListChannelPermissionOverwritesResponse, error)
+	SetChannelPermissionOverwrite(ctx context.Context, in *SetChannelPermissionOverwriteRequest, opts ...grpc.CallOption) (*SetChannelPermissionOverwriteResponse, error)
+	DeleteChannelPermissionOverwrite(ctx context.Context, in *DeleteChannelPermissionOverwriteRequest, opts ...grpc.CallOption) (*DeleteChannelPermissionOverwriteResponse, error)
+	ListCategoryPermissionOverwrites(ctx context.Context, in *ListCategoryPermissionOverwritesRequest, opts ...grpc.CallOption) (*ListCategoryPermissionOverwritesResponse, error)
+	SetCategoryPermissionOverwrite(ctx context.Context, in *SetCategoryPermissionOverwriteRequest, opts ...grpc.CallOption) (*SetCategoryPermissionOverwriteResponse, error)
+	DeleteCategoryPermissionOverwrite(ctx context.Context, in *DeleteCategoryPermissionOverwriteRequest, opts ...grpc.CallOption) (*DeleteCategoryPermissionOverwriteResponse, error)
 	CheckChannelAccess(ctx context.Context, in *CheckChannelAccessRequest, opts ...grpc.CallOption) (*CheckChannelAccessResponse, error)
+	FilterMentionTargets(ctx context.Context, in *FilterMentionTargetsRequest, opts ...grpc.CallOption) (*FilterMentionTargetsResponse, error)
+	ListAccessibleChannelIDs(ctx context.Context, in *ListAccessibleChannelIDsRequest, opts ...grpc.CallOption) (*ListAccessibleChannelIDsResponse, error)
+	BatchCheckChannelAccess(ctx context.Context, in *BatchCheckChannelAccessRequest, opts ...grpc.CallOption) (*BatchCheckChannelAccessResponse, error)
+	ListUserGuildIDs(ctx context.Context, in *ListUserGuildIDsRequest, opts ...grpc.CallOption) (*ListUserGuildIDsResponse, error)
 }
 
 type guildServiceClient struct {
@@ -123,6 +182,36 @@ func (c *guildServiceClient) UpdateGuild(ctx context.Context, in *UpdateGuildReq
 	return out, nil
 }
 
+func (c *guildServiceClient) DeleteGuild(ctx context.Context, in *DeleteGuildRequest, opts ...grpc.CallOption) (*DeleteGuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGuildResponse)
+	err := c.cc.Invoke(ctx, GuildService_DeleteGuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) TransferGuildOwnership(ctx context.Context, in *TransferGuildOwnershipRequest, opts ...grpc.CallOption) (*TransferGuildOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferGuildOwnershipResponse)
+	err := c.cc.Invoke(ctx, GuildService_TransferGuildOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) DeleteGuildMember(ctx context.Context, in *DeleteGuildMemberRequest, opts ...grpc.CallOption) (*DeleteGuildMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteGuildMemberResponse)
@@ -143,6 +232,46 @@ func (c *guildServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildReque
 	return out, nil
 }
 
+func (c *guildServiceClient) TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeoutMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_TimeoutMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_BanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbanMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_UnbanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) GetGuildInvites(ctx context.Context, in *GetGuildInvitesRequest, opts ...grpc.CallOption) (*GetGuildInvitesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetGuildInvitesResponse)
@@ -183,6 +312,26 @@ func (c *guildServiceClient) DeleteGuildInvite(ctx context.Context, in *DeleteGu
 	return out, nil
 }
 
+func (c *guildServiceClient) SetGuildVanityInvite(ctx context.Context, in *SetGuildVanityInviteRequest, opts ...grpc.CallOption) (*SetGuildVanityInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGuildVanityInviteResponse)
+	err := c.cc.Invoke(ctx, GuildService_SetGuildVanityInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListGuildInviteUses(ctx context.Context, in *ListGuildInviteUsesRequest, opts ...grpc.CallOption) (*ListGuildInviteUsesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuildInviteUsesResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListGuildInviteUses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) JoinGuild(ctx context.Context, in *JoinGuildRequest, opts ...grpc.CallOption) (*JoinGuildResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JoinGuildResponse)
@@ -253,6 +402,146 @@ func (c *guildServiceClient) DeleteChannel(ctx context.Context, in *DeleteChanne
 	return out, nil
 }
 
+func (c *guildServiceClient) ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ReorderChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderChannelsResponse)
+	err := c.cc.Invoke(ctx, GuildService_ReorderChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, GuildService_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ReorderRoles(ctx context.Context, in *ReorderRolesRequest, opts ...grpc.CallOption) (*ReorderRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderRolesResponse)
+	err := c.cc.Invoke(ctx, GuildService_ReorderRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, GuildService_UpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, GuildService_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) AddMemberRole(ctx context.Context, in *AddMemberRoleRequest, opts ...grpc.CallOption) (*AddMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMemberRoleResponse)
+	err := c.cc.Invoke(ctx, GuildService_AddMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) RemoveMemberRole(ctx context.Context, in *RemoveMemberRoleRequest, opts ...grpc.CallOption) (*RemoveMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberRoleResponse)
+	err := c.cc.Invoke(ctx, GuildService_RemoveMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListChannelPermissionOverwrites(ctx context.Context, in *ListChannelPermissionOverwritesRequest, opts ...grpc.CallOption) (*ListChannelPermissionOverwritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelPermissionOverwritesResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListChannelPermissionOverwrites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) SetChannelPermissionOverwrite(ctx context.Context, in *SetChannelPermissionOverwriteRequest, opts ...grpc.CallOption) (*SetChannelPermissionOverwriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelPermissionOverwriteResponse)
+	err := c.cc.Invoke(ctx, GuildService_SetChannelPermissionOverwrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) DeleteChannelPermissionOverwrite(ctx context.Context, in *DeleteChannelPermissionOverwriteRequest, opts ...grpc.CallOption) (*DeleteChannelPermissionOverwriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelPermissionOverwriteResponse)
+	err := c.cc.Invoke(ctx, GuildService_DeleteChannelPermissionOverwrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListCategoryPermissionOverwrites(ctx context.Context, in *ListCategoryPermissionOverwritesRequest, opts ...grpc.CallOption) (*ListCategoryPermissionOverwritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryPermissionOverwritesResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListCategoryPermissionOverwrites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) SetCategoryPermissionOverwrite(ctx context.Context, in *SetCategoryPermissionOverwriteRequest, opts ...grpc.CallOption) (*SetCategoryPermissionOverwriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCategoryPermissionOverwriteResponse)
+	err := c.cc.Invoke(ctx, GuildService_SetCategoryPermissionOverwrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) DeleteCategoryPermissionOverwrite(ctx context.Context, in *DeleteCategoryPermissionOverwriteRequest, opts ...grpc.CallOption) (*DeleteCategoryPermissionOverwriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryPermissionOverwriteResponse)
+	err := c.cc.Invoke(ctx, GuildService_DeleteCategoryPermissionOverwrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) CheckChannelAccess(ctx context.Context, in *CheckChannelAccessRequest, opts ...grpc.CallOption) (*CheckChannelAccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckChannelAccessResponse)
@@ -263,6 +552,46 @@ func (c *guildServiceClient) CheckChannelAccess(ctx context.Context, in *CheckCh
 	return out, nil
 }
 
+func (c *guildServiceClient) FilterMentionTargets(ctx context.Context, in *FilterMentionTargetsRequest, opts ...grpc.CallOption) (*FilterMentionTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterMentionTargetsResponse)
+	err := c.cc.Invoke(ctx, GuildService_FilterMentionTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListAccessibleChannelIDs(ctx context.Context, in *ListAccessibleChannelIDsRequest, opts ...grpc.CallOption) (*ListAccessibleChannelIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessibleChannelIDsResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListAccessibleChannelIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) BatchCheckChannelAccess(ctx context.Context, in *BatchCheckChannelAccessRequest, opts ...grpc.CallOption) (*BatchCheckChannelAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCheckChannelAccessResponse)
+	err := c.cc.Invoke(ctx, GuildService_BatchCheckChannelAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) ListUserGuildIDs(ctx context.Context, in *ListUserGuildIDsRequest, opts ...grpc.CallOption) (*ListUserGuildIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserGuildIDsResponse)
+	err := c.cc.Invoke(ctx, GuildService_ListUserGuildIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuildServiceServer is the server API for GuildService service.
 // All implementations must embed UnimplementedGuildServiceServer
 // for forward compatibility.
@@ -272,20 +601,52 @@ type GuildServiceServer interface {
 	GetGuildByID(context.Context, *GetGuildByIDRequest) (*GetGuildByIDResponse, error)
 	ListMyGuilds(context.Context, *ListMyGuildsRequest) (*ListMyGuildsResponse, error)
 	UpdateGuild(context.Context, *UpdateGuildRequest) (*UpdateGuildResponse, error)
+	// オーナーのみ。チャンネルのメッセージと添付ファイルは非同期で削除される
+	DeleteGuild(context.Context, *DeleteGuildRequest) (*DeleteGuildResponse, error)
+	// オーナーのみ。本人確認のためにパスワードの再入力が必要
+	TransferGuildOwnership(context.Context, *TransferGuildOwnershipRequest) (*TransferGuildOwnershipResponse, error)
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
 	DeleteGuildMember(context.Context, *DeleteGuildMemberRequest) (*DeleteGuildMemberResponse, error)
 	LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error)
+	TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error)
+	BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error)
+	UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
 	GetGuildInvites(context.Context, *GetGuildInvitesRequest) (*GetGuildInvitesResponse, error)
 	GetGuildByInviteCode(context.Context, *GetGuildByInviteCodeRequest) (*GetGuildByInviteCodeResponse, error)
 	CreateGuildInvite(context.Context, *CreateGuildInviteRequest) (*CreateGuildInviteResponse, error)
 	DeleteGuildInvite(context.Context, *DeleteGuildInviteRequest) (*DeleteGuildInviteResponse, error)
+	// 既にバニティURLがある場合は置き換える。削除はDeleteGuildInviteで行う
+	SetGuildVanityInvite(context.Context, *SetGuildVanityInviteRequest) (*SetGuildVanityInviteResponse, error)
+	// 招待コードが削除された後も記録は残る
+	ListGuildInviteUses(context.Context, *ListGuildInviteUsesRequest) (*ListGuildInviteUsesResponse, error)
 	JoinGuild(context.Context, *JoinGuildRequest) (*JoinGuildResponse, error)
 	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
 	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	// 中のチャンネルは最も古いカテゴリーに移す。権限の上書きが残っている場合は削除できない
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
 	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
 	UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error)
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	ReorderChannels(context.Context, *ReorderChannelsRequest) (*ReorderChannelsResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	ReorderRoles(context.Context, *ReorderRolesRequest) (*ReorderRolesResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	AddMemberRole(context.Context, *AddMemberRoleRequest) (*AddMemberRoleResponse, error)
+	RemoveMemberRole(context.Context, *RemoveMemberRoleRequest) (*RemoveMemberRoleResponse, error)
+	ListChannelPermissionOverwrites(context.Context, *ListChannelPermissionOverwritesRequest) (*ListChannelPermissionOverwritesResponse, error)
+	SetChannelPermissionOverwrite(context.Context, *SetChannelPermissionOverwriteRequest) (*SetChannelPermissionOverwriteResponse, error)
+	DeleteChannelPermissionOverwrite(context.Context, *DeleteChannelPermissionOverwriteRequest) (*DeleteChannelPermissionOverwriteResponse, error)
+	ListCategoryPermissionOverwrites(context.Context, *ListCategoryPermissionOverwritesRequest) (*ListCategoryPermissionOverwritesResponse, error)
+	SetCategoryPermissionOverwrite(context.Context, *SetCategoryPermissionOverwriteRequest) (*SetCategoryPermissionOverwriteResponse, error)
+	DeleteCategoryPermissionOverwrite(context.Context, *DeleteCategoryPermissionOverwriteRequest) (*DeleteCategoryPermissionOverwriteResponse, error)
 	CheckChannelAccess(context.Context, *CheckChannelAccessRequest) (*CheckChannelAccessResponse, error)
+	FilterMentionTargets(context.Context, *FilterMentionTargetsRequest) (*FilterMentionTargetsResponse, error)
+	ListAccessibleChannelIDs(context.Context, *ListAccessibleChannelIDsRequest) (*ListAccessibleChannelIDsResponse, error)
+	BatchCheckChannelAccess(context.Context, *BatchCheckChannelAccessRequest) (*BatchCheckChannelAccessResponse, error)
+	ListUserGuildIDs(context.Context, *ListUserGuildIDsRequest) (*ListUserGuildIDsResponse, error)
 	mustEmbedUnimplementedGuildServiceServer()
 }
 
@@ -311,12 +672,33 @@ func (UnimplementedGuildServiceServer) ListMyGuilds(context.Context, *ListMyGuil
 func (UnimplementedGuildServiceServer) UpdateGuild(context.Context, *UpdateGuildRequest) (*UpdateGuildResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuild not implemented")
 }
+func (UnimplementedGuildServiceServer) DeleteGuild(context.Context, *DeleteGuildRequest) (*DeleteGuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuild not implemented")
+}
+func (UnimplementedGuildServiceServer) TransferGuildOwnership(context.Context, *TransferGuildOwnershipRequest) (*TransferGuildOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGuildOwnership not implemented")
+}
+func (UnimplementedGuildServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
 func (UnimplementedGuildServiceServer) DeleteGuildMember(context.Context, *DeleteGuildMemberRequest) (*DeleteGuildMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuildMember not implemented")
 }
 func (UnimplementedGuildServiceServer) LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGuild not implemented")
 }
+func (UnimplementedGuildServiceServer) TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutMember not implemented")
+}
+func (UnimplementedGuildServiceServer) BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedGuildServiceServer) UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedGuildServiceServer) ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
 func (UnimplementedGuildServiceServer) GetGuildInvites(context.Context, *GetGuildInvitesRequest) (*GetGuildInvitesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGuildInvites not implemented")
 }
@@ -329,6 +711,12 @@ func (UnimplementedGuildServiceServer) CreateGuildInvite(context.Context, *Creat
 func (UnimplementedGuildServiceServer) DeleteGuildInvite(context.Context, *DeleteGuildInviteRequest) (*DeleteGuildInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuildInvite not implemented")
 }
+func (UnimplementedGuildServiceServer) SetGuildVanityInvite(context.Context, *SetGuildVanityInviteRequest) (*SetGuildVanityInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuildVanityInvite not implemented")
+}
+func (UnimplementedGuildServiceServer) ListGuildInviteUses(context.Context, *ListGuildInviteUsesRequest) (*ListGuildInviteUsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuildInviteUses not implemented")
+}
 func (UnimplementedGuildServiceServer) JoinGuild(context.Context, *JoinGuildRequest) (*JoinGuildResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinGuild not implemented")
 }
@@ -350,9 +738,63 @@ func (UnimplementedGuildServiceServer) UpdateChannel(context.Context, *UpdateCha
 func (UnimplementedGuildServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
 }
+func (UnimplementedGuildServiceServer) ReorderChannels(context.Context, *ReorderChannelsRequest) (*ReorderChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChannels not implemented")
+}
+func (UnimplementedGuildServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedGuildServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedGuildServiceServer) ReorderRoles(context.Context, *ReorderRolesRequest) (*ReorderRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderRoles not implemented")
+}
+func (UnimplementedGuildServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (UnimplementedGuildServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedGuildServiceServer) AddMemberRole(context.Context, *AddMemberRoleRequest) (*AddMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMemberRole not implemented")
+}
+func (UnimplementedGuildServiceServer) RemoveMemberRole(context.Context, *RemoveMemberRoleRequest) (*RemoveMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMemberRole not implemented")
+}
+func (UnimplementedGuildServiceServer) ListChannelPermissionOverwrites(context.Context, *ListChannelPermissionOverwritesRequest) (*ListChannelPermissionOverwritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelPermissionOverwrites not implemented")
+}
+func (UnimplementedGuildServiceServer) SetChannelPermissionOverwrite(context.Context, *SetChannelPermissionOverwriteRequest) (*SetChannelPermissionOverwriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelPermissionOverwrite not implemented")
+}
+func (UnimplementedGuildServiceServer) DeleteChannelPermissionOverwrite(context.Context, *DeleteChannelPermissionOverwriteRequest) (*DeleteChannelPermissionOverwriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannelPermissionOverwrite not implemented")
+}
+func (UnimplementedGuildServiceServer) ListCategoryPermissionOverwrites(context.Context, *ListCategoryPermissionOverwritesRequest) (*ListCategoryPermissionOverwritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryPermissionOverwrites not implemented")
+}
+func (UnimplementedGuildServiceServer) SetCategoryPermissionOverwrite(context.Context, *SetCategoryPermissionOverwriteRequest) (*SetCategoryPermissionOverwriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryPermissionOverwrite not implemented")
+}
+func (UnimplementedGuildServiceServer) DeleteCategoryPermissionOverwrite(context.Context, *DeleteCategoryPermissionOverwriteRequest) (*DeleteCategoryPermissionOverwriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryPermissionOverwrite not implemented")
+}
 func (UnimplementedGuildServiceServer) CheckChannelAccess(context.Context, *CheckChannelAccessRequest) (*CheckChannelAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckChannelAccess not implemented")
 }
+func (UnimplementedGuildServiceServer) FilterMentionTargets(context.Context, *FilterMentionTargetsRequest) (*FilterMentionTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterMentionTargets not implemented")
+}
+func (UnimplementedGuildServiceServer) ListAccessibleChannelIDs(context.Context, *ListAccessibleChannelIDsRequest) (*ListAccessibleChannelIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessibleChannelIDs not implemented")
+}
+func (UnimplementedGuildServiceServer) BatchCheckChannelAccess(context.Context, *BatchCheckChannelAccessRequest) (*BatchCheckChannelAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheckChannelAccess not implemented")
+}
+func (UnimplementedGuildServiceServer) ListUserGuildIDs(context.Context, *ListUserGuildIDsRequest) (*ListUserGuildIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserGuildIDs not implemented")
+}
 func (UnimplementedGuildServiceServer) mustEmbedUnimplementedGuildServiceServer() {}
 func (UnimplementedGuildServiceServer) testEmbeddedByValue()                      {}
 
@@ -464,110 +906,272 @@ func _GuildService_UpdateGuild_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_DeleteGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGuildMemberRequest)
+func _GuildService_DeleteGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuildRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).DeleteGuildMember(ctx, in)
+		return srv.(GuildServiceServer).DeleteGuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_DeleteGuildMember_FullMethodName,
+		FullMethod: GuildService_DeleteGuild_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).DeleteGuildMember(ctx, req.(*DeleteGuildMemberRequest))
+		return srv.(GuildServiceServer).DeleteGuild(ctx, req.(*DeleteGuildRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveGuildRequest)
+func _GuildService_TransferGuildOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGuildOwnershipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).LeaveGuild(ctx, in)
+		return srv.(GuildServiceServer).TransferGuildOwnership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_LeaveGuild_FullMethodName,
+		FullMethod: GuildService_TransferGuildOwnership_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
+		return srv.(GuildServiceServer).TransferGuildOwnership(ctx, req.(*TransferGuildOwnershipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_GetGuildInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildInvitesRequest)
+func _GuildService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).GetGuildInvites(ctx, in)
+		return srv.(GuildServiceServer).ListAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_GetGuildInvites_FullMethodName,
+		FullMethod: GuildService_ListAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).GetGuildInvites(ctx, req.(*GetGuildInvitesRequest))
+		return srv.(GuildServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_GetGuildByInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGuildByInviteCodeRequest)
+func _GuildService_DeleteGuildMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuildMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).GetGuildByInviteCode(ctx, in)
+		return srv.(GuildServiceServer).DeleteGuildMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_GetGuildByInviteCode_FullMethodName,
+		FullMethod: GuildService_DeleteGuildMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).GetGuildByInviteCode(ctx, req.(*GetGuildByInviteCodeRequest))
+		return srv.(GuildServiceServer).DeleteGuildMember(ctx, req.(*DeleteGuildMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_CreateGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGuildInviteRequest)
+func _GuildService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGuildRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).CreateGuildInvite(ctx, in)
+		return srv.(GuildServiceServer).LeaveGuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_CreateGuildInvite_FullMethodName,
+		FullMethod: GuildService_LeaveGuild_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).CreateGuildInvite(ctx, req.(*CreateGuildInviteRequest))
+		return srv.(GuildServiceServer).LeaveGuild(ctx, req.(*LeaveGuildRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GuildService_DeleteGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGuildInviteRequest)
+func _GuildService_TimeoutMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeoutMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GuildServiceServer).DeleteGuildInvite(ctx, in)
+		return srv.(GuildServiceServer).TimeoutMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GuildService_DeleteGuildInvite_FullMethodName,
+		FullMethod: GuildService_TimeoutMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GuildServiceServer).DeleteGuildInvite(ctx, req.(*DeleteGuildInviteRequest))
+		return srv.(GuildServiceServer).TimeoutMember(ctx, req.(*TimeoutMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_BanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).BanMember(ctx, req.(*BanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).UnbanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_UnbanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).UnbanMember(ctx, req.(*UnbanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_GetGuildInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).GetGuildInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_GetGuildInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).GetGuildInvites(ctx, req.(*GetGuildInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_GetGuildByInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuildByInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).GetGuildByInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_GetGuildByInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).GetGuildByInviteCode(ctx, req.(*GetGuildByInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_CreateGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuildInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).CreateGuildInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_CreateGuildInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).CreateGuildInvite(ctx, req.(*CreateGuildInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_DeleteGuildInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuildInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).DeleteGuildInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_DeleteGuildInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).DeleteGuildInvite(ctx, req.(*DeleteGuildInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_SetGuildVanityInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuildVanityInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).SetGuildVanityInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_SetGuildVanityInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).SetGuildVanityInvite(ctx, req.(*SetGuildVanityInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListGuildInviteUses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuildInviteUsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListGuildInviteUses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListGuildInviteUses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListGuildInviteUses(ctx, req.(*ListGuildInviteUsesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -698,6 +1302,258 @@ func _GuildService_DeleteChannel_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuildService_ReorderChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ReorderChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ReorderChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ReorderChannels(ctx, req.(*ReorderChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ReorderRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ReorderRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ReorderRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ReorderRoles(ctx, req.(*ReorderRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_UpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_AddMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).AddMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_AddMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).AddMemberRole(ctx, req.(*AddMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_RemoveMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).RemoveMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_RemoveMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).RemoveMemberRole(ctx, req.(*RemoveMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListChannelPermissionOverwrites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelPermissionOverwritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListChannelPermissionOverwrites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListChannelPermissionOverwrites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListChannelPermissionOverwrites(ctx, req.(*ListChannelPermissionOverwritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_SetChannelPermissionOverwrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelPermissionOverwriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).SetChannelPermissionOverwrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_SetChannelPermissionOverwrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).SetChannelPermissionOverwrite(ctx, req.(*SetChannelPermissionOverwriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_DeleteChannelPermissionOverwrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelPermissionOverwriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).DeleteChannelPermissionOverwrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_DeleteChannelPermissionOverwrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).DeleteChannelPermissionOverwrite(ctx, req.(*DeleteChannelPermissionOverwriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListCategoryPermissionOverwrites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryPermissionOverwritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListCategoryPermissionOverwrites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListCategoryPermissionOverwrites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListCategoryPermissionOverwrites(ctx, req.(*ListCategoryPermissionOverwritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_SetCategoryPermissionOverwrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryPermissionOverwriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).SetCategoryPermissionOverwrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_SetCategoryPermissionOverwrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).SetCategoryPermissionOverwrite(ctx, req.(*SetCategoryPermissionOverwriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_DeleteCategoryPermissionOverwrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryPermissionOverwriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).DeleteCategoryPermissionOverwrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_DeleteCategoryPermissionOverwrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).DeleteCategoryPermissionOverwrite(ctx, req.(*DeleteCategoryPermissionOverwriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GuildService_CheckChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckChannelAccessRequest)
 	if err := dec(in); err != nil {
@@ -716,6 +1572,78 @@ func _GuildService_CheckChannelAccess_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuildService_FilterMentionTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMentionTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).FilterMentionTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_FilterMentionTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).FilterMentionTargets(ctx, req.(*FilterMentionTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListAccessibleChannelIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessibleChannelIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListAccessibleChannelIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListAccessibleChannelIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListAccessibleChannelIDs(ctx, req.(*ListAccessibleChannelIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_BatchCheckChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).BatchCheckChannelAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_BatchCheckChannelAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).BatchCheckChannelAccess(ctx, req.(*BatchCheckChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_ListUserGuildIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGuildIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).ListUserGuildIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_ListUserGuildIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).ListUserGuildIDs(ctx, req.(*ListUserGuildIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GuildService_ServiceDesc is the grpc.ServiceDesc for GuildService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -743,6 +1671,18 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGuild",
 			Handler:    _GuildService_UpdateGuild_Handler,
 		},
+		{
+			MethodName: "DeleteGuild",
+			Handler:    _GuildService_DeleteGuild_Handler,
+		},
+		{
+			MethodName: "TransferGuildOwnership",
+			Handler:    _GuildService_TransferGuildOwnership_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _GuildService_ListAuditLog_Handler,
+		},
 		{
 			MethodName: "DeleteGuildMember",
 			Handler:    _GuildService_DeleteGuildMember_Handler,
@@ -751,6 +1691,22 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGuild",
 			Handler:    _GuildService_LeaveGuild_Handler,
 		},
+		{
+			MethodName: "TimeoutMember",
+			Handler:    _GuildService_TimeoutMember_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _GuildService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _GuildService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _GuildService_ListBans_Handler,
+		},
 		{
 			MethodName: "GetGuildInvites",
 			Handler:    _GuildService_GetGuildInvites_Handler,
@@ -767,6 +1723,14 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGuildInvite",
 			Handler:    _GuildService_DeleteGuildInvite_Handler,
 		},
+		{
+			MethodName: "SetGuildVanityInvite",
+			Handler:    _GuildService_SetGuildVanityInvite_Handler,
+		},
+		{
+			MethodName: "ListGuildInviteUses",
+			Handler:    _GuildService_ListGuildInviteUses_Handler,
+		},
 		{
 			MethodName: "JoinGuild",
 			Handler:    _GuildService_JoinGuild_Handler,
@@ -795,10 +1759,82 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChannel",
 			Handler:    _GuildService_DeleteChannel_Handler,
 		},
+		{
+			MethodName: "ReorderChannels",
+			Handler:    _GuildService_ReorderChannels_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _GuildService_ListRoles_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _GuildService_CreateRole_Handler,
+		},
+		{
+			MethodName: "ReorderRoles",
+			Handler:    _GuildService_ReorderRoles_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _GuildService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _GuildService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "AddMemberRole",
+			Handler:    _GuildService_AddMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveMemberRole",
+			Handler:    _GuildService_RemoveMemberRole_Handler,
+		},
+		{
+			MethodName: "ListChannelPermissionOverwrites",
+			Handler:    _GuildService_ListChannelPermissionOverwrites_Handler,
+		},
+		{
+			MethodName: "SetChannelPermissionOverwrite",
+			Handler:    _GuildService_SetChannelPermissionOverwrite_Handler,
+		},
+		{
+			MethodName: "DeleteChannelPermissionOverwrite",
+			Handler:    _GuildService_DeleteChannelPermissionOverwrite_Handler,
+		},
+		{
+			MethodName: "ListCategoryPermissionOverwrites",
+			Handler:    _GuildService_ListCategoryPermissionOverwrites_Handler,
+		},
+		{
+			MethodName: "SetCategoryPermissionOverwrite",
+			Handler:    _GuildService_SetCategoryPermissionOverwrite_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryPermissionOverwrite",
+			Handler:    _GuildService_DeleteCategoryPermissionOverwrite_Handler,
+		},
 		{
 			MethodName: "CheckChannelAccess",
 			Handler:    _GuildService_CheckChannelAccess_Handler,
 		},
+		{
+			MethodName: "FilterMentionTargets",
+			Handler:    _GuildService_FilterMentionTargets_Handler,
+		},
+		{
+			MethodName: "ListAccessibleChannelIDs",
+			Handler:    _GuildService_ListAccessibleChannelIDs_Handler,
+		},
+		{
+			MethodName: "BatchCheckChannelAccess",
+			Handler:    _GuildService_BatchCheckChannelAccess_Handler,
+		},
+		{
+			MethodName: "ListUserGuildIDs",
+			Handler:    _GuildService_ListUserGuildIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guild_service.proto",
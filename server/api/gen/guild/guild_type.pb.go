@@ -23,11 +23,116 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PermissionOverwriteTargetType int32
+
+const (
+	PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED PermissionOverwriteTargetType = 0
+	PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_ROLE        PermissionOverwriteTargetType = 1
+	PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER      PermissionOverwriteTargetType = 2
+)
+
+// Enum value maps for PermissionOverwriteTargetType.
+var (
+	PermissionOverwriteTargetType_name = map[int32]string{
+		0: "PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED",
+		1: "PERMISSION_OVERWRITE_TARGET_TYPE_ROLE",
+		2: "PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER",
+	}
+	PermissionOverwriteTargetType_value = map[string]int32{
+		"PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED": 0,
+		"PERMISSION_OVERWRITE_TARGET_TYPE_ROLE":        1,
+		"PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER":      2,
+	}
+)
+
+func (x PermissionOverwriteTargetType) Enum() *PermissionOverwriteTargetType {
+	p := new(PermissionOverwriteTargetType)
+	*p = x
+	return p
+}
+
+func (x PermissionOverwriteTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionOverwriteTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_guild_type_proto_enumTypes[0].Descriptor()
+}
+
+func (PermissionOverwriteTargetType) Type() protoreflect.EnumType {
+	return &file_guild_type_proto_enumTypes[0]
+}
+
+func (x PermissionOverwriteTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionOverwriteTargetType.Descriptor instead.
+func (PermissionOverwriteTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{0}
+}
+
+type PresenceStatus int32
+
+const (
+	PresenceStatus_PRESENCE_STATUS_UNSPECIFIED PresenceStatus = 0
+	PresenceStatus_PRESENCE_STATUS_ONLINE      PresenceStatus = 1
+	PresenceStatus_PRESENCE_STATUS_IDLE        PresenceStatus = 2
+	PresenceStatus_PRESENCE_STATUS_DND         PresenceStatus = 3
+	PresenceStatus_PRESENCE_STATUS_OFFLINE     PresenceStatus = 4
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "PRESENCE_STATUS_UNSPECIFIED",
+		1: "PRESENCE_STATUS_ONLINE",
+		2: "PRESENCE_STATUS_IDLE",
+		3: "PRESENCE_STATUS_DND",
+		4: "PRESENCE_STATUS_OFFLINE",
+	}
+	PresenceStatus_value = map[string]int32{
+		"PRESENCE_STATUS_UNSPECIFIED": 0,
+		"PRESENCE_STATUS_ONLINE":      1,
+		"PRESENCE_STATUS_IDLE":        2,
+		"PRESENCE_STATUS_DND":         3,
+		"PRESENCE_STATUS_OFFLINE":     4,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_guild_type_proto_enumTypes[1].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_guild_type_proto_enumTypes[1]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{1}
+}
+
 type Guild struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// オーナーが削除された場合は含まれない
+	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
 	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	IconUrl          string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
 	DefaultChannelId string                 `protobuf:"bytes,7,opt,name=default_channel_id,json=defaultChannelId,proto3" json:"default_channel_id,omitempty"`
@@ -82,8 +187,8 @@ func (x *Guild) GetName() string {
 }
 
 func (x *Guild) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
 	}
 	return ""
 }
@@ -124,10 +229,11 @@ func (x *Guild) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type GuildDetail struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// オーナーが削除された場合は含まれない
+	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
 	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	IconUrl          string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
 	DefaultChannelId string                 `protobuf:"bytes,8,opt,name=default_channel_id,json=defaultChannelId,proto3" json:"default_channel_id,omitempty"`
@@ -182,8 +288,8 @@ func (x *GuildDetail) GetName() string {
 }
 
 func (x *GuildDetail) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
 	}
 	return ""
 }
@@ -224,10 +330,11 @@ func (x *GuildDetail) GetCategories() []*CategoryDetail {
 }
 
 type GuildWithMembers struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// オーナーが削除された場合は含まれない
+	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
 	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	IconUrl          string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
 	DefaultChannelId string                 `protobuf:"bytes,7,opt,name=default_channel_id,json=defaultChannelId,proto3" json:"default_channel_id,omitempty"`
@@ -283,8 +390,8 @@ func (x *GuildWithMembers) GetName() string {
 }
 
 func (x *GuildWithMembers) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
 	}
 	return ""
 }
@@ -332,10 +439,11 @@ func (x *GuildWithMembers) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type GuildWithMemberCount struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// オーナーが削除された場合は含まれない
+	OwnerId          *string                `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
 	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	IconUrl          string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
 	DefaultChannelId string                 `protobuf:"bytes,7,opt,name=default_channel_id,json=defaultChannelId,proto3" json:"default_channel_id,omitempty"`
@@ -390,8 +498,8 @@ func (x *GuildWithMemberCount) GetName() string {
 }
 
 func (x *GuildWithMemberCount) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
 	}
 	return ""
 }
@@ -432,12 +540,14 @@ func (x *GuildWithMemberCount) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type CategoryDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Channels      []*Channel             `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId   string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Channels  []*ChannelDetail       `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	// ギルド内での並び順。小さいほど上
+	Position      int32 `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -500,31 +610,144 @@ func (x *CategoryDetail) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *CategoryDetail) GetChannels() []*Channel {
+func (x *CategoryDetail) GetChannels() []*ChannelDetail {
 	if x != nil {
 		return x.Channels
 	}
 	return nil
 }
 
+func (x *CategoryDetail) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ChannelDetail struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 一度も既読にしていない場合は省略される
+	LastReadMessageId *string `protobuf:"bytes,5,opt,name=last_read_message_id,json=lastReadMessageId,proto3,oneof" json:"last_read_message_id,omitempty"`
+	// 自分以外が送った未読メッセージの件数。100件で打ち切る
+	UnreadCount int32 `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	// 未読メッセージのうち自分がメンションされた件数
+	MentionCount int32 `protobuf:"varint,7,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	// カテゴリー内での並び順。小さいほど上
+	Position      int32 `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelDetail) Reset() {
+	*x = ChannelDetail{}
+	mi := &file_guild_type_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDetail) ProtoMessage() {}
+
+func (x *ChannelDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDetail.ProtoReflect.Descriptor instead.
+func (*ChannelDetail) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChannelDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelDetail) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ChannelDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelDetail) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChannelDetail) GetLastReadMessageId() string {
+	if x != nil && x.LastReadMessageId != nil {
+		return *x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *ChannelDetail) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ChannelDetail) GetMentionCount() int32 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+func (x *ChannelDetail) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type Invite struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Guild         *Guild                 `protobuf:"bytes,2,opt,name=guild,proto3,oneof" json:"guild,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Creator       *User                  `protobuf:"bytes,4,opt,name=creator,proto3,oneof" json:"creator,omitempty"`
-	MaxUses       *int32                 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
-	CurrentUses   int32                  `protobuf:"varint,6,opt,name=current_uses,json=currentUses,proto3" json:"current_uses,omitempty"`
-	InviteCode    string                 `protobuf:"bytes,7,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GuildId     string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Guild       *Guild                 `protobuf:"bytes,2,opt,name=guild,proto3,oneof" json:"guild,omitempty"`
+	CreatorId   string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Creator     *User                  `protobuf:"bytes,4,opt,name=creator,proto3,oneof" json:"creator,omitempty"`
+	MaxUses     *int32                 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`
+	CurrentUses int32                  `protobuf:"varint,6,opt,name=current_uses,json=currentUses,proto3" json:"current_uses,omitempty"`
+	InviteCode  string                 `protobuf:"bytes,7,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ギルドに1つだけ設定できる任意の招待コード。期限と使用回数の上限はない
+	Vanity        bool `protobuf:"varint,10,opt,name=vanity,proto3" json:"vanity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Invite) Reset() {
 	*x = Invite{}
-	mi := &file_guild_type_proto_msgTypes[5]
+	mi := &file_guild_type_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +759,7 @@ func (x *Invite) String() string {
 func (*Invite) ProtoMessage() {}
 
 func (x *Invite) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_type_proto_msgTypes[5]
+	mi := &file_guild_type_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +772,7 @@ func (x *Invite) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Invite.ProtoReflect.Descriptor instead.
 func (*Invite) Descriptor() ([]byte, []int) {
-	return file_guild_type_proto_rawDescGZIP(), []int{5}
+	return file_guild_type_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Invite) GetGuildId() string {
@@ -615,19 +838,101 @@ func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Member struct {
+func (x *Invite) GetVanity() bool {
+	if x != nil {
+		return x.Vanity
+	}
+	return false
+}
+
+// 招待コードでギルドに参加した記録
+type InviteUse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	UsedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *InviteUse) Reset() {
+	*x = InviteUse{}
+	mi := &file_guild_type_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUse) ProtoMessage() {}
+
+func (x *InviteUse) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUse.ProtoReflect.Descriptor instead.
+func (*InviteUse) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InviteUse) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *InviteUse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteUse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *InviteUse) GetUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedAt
+	}
+	return nil
+}
+
+type Member struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuildId  string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	User     *User                  `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// with_presenceを指定しなかった場合はUNSPECIFIED
+	Status PresenceStatus `protobuf:"varint,5,opt,name=status,proto3,enum=guild.PresenceStatus" json:"status,omitempty"`
+	// 持っているロールのID。@everyoneは含まない。GetGuildByIDでのみ設定される
+	RoleIds []string `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	// タイムアウト中の場合のみ設定される
+	CommunicationDisabledUntil *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=communication_disabled_until,json=communicationDisabledUntil,proto3,oneof" json:"communication_disabled_until,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
 func (x *Member) Reset() {
 	*x = Member{}
-	mi := &file_guild_type_proto_msgTypes[6]
+	mi := &file_guild_type_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +944,7 @@ func (x *Member) String() string {
 func (*Member) ProtoMessage() {}
 
 func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_type_proto_msgTypes[6]
+	mi := &file_guild_type_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +957,7 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Member.ProtoReflect.Descriptor instead.
 func (*Member) Descriptor() ([]byte, []int) {
-	return file_guild_type_proto_rawDescGZIP(), []int{6}
+	return file_guild_type_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Member) GetUserId() string {
@@ -683,19 +988,42 @@ func (x *Member) GetJoinedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Member) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+}
+
+func (x *Member) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *Member) GetCommunicationDisabledUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommunicationDisabledUntil
+	}
+	return nil
+}
+
 type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId   string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ギルド内での並び順。小さいほど上
+	Position      int32 `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_guild_type_proto_msgTypes[7]
+	mi := &file_guild_type_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -707,7 +1035,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_type_proto_msgTypes[7]
+	mi := &file_guild_type_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +1048,7 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Category.ProtoReflect.Descriptor instead.
 func (*Category) Descriptor() ([]byte, []int) {
-	return file_guild_type_proto_rawDescGZIP(), []int{7}
+	return file_guild_type_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Category) GetId() string {
@@ -751,19 +1079,28 @@ func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Category) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type Channel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// カテゴリー内での並び順。小さいほど上
+	Position      int32 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_guild_type_proto_msgTypes[8]
+	mi := &file_guild_type_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +1112,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_type_proto_msgTypes[8]
+	mi := &file_guild_type_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1125,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_guild_type_proto_rawDescGZIP(), []int{8}
+	return file_guild_type_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Channel) GetId() string {
@@ -819,33 +1156,38 @@ func (x *Channel) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// TODO: あとからProtoをリファクタするときにuser protoのものをimportして使うようにする
-type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayId     string                 `protobuf:"bytes,2,opt,name=display_id,json=displayId,proto3" json:"display_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *Channel) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// サイドバーでの、1つのカテゴリーとその中のチャンネルの並び
+type CategoryLayout struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// 上から順
+	ChannelIds    []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_guild_type_proto_msgTypes[9]
+func (x *CategoryLayout) Reset() {
+	*x = CategoryLayout{}
+	mi := &file_guild_type_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *CategoryLayout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*CategoryLayout) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_guild_type_proto_msgTypes[9]
+func (x *CategoryLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,23 +1198,166 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_guild_type_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CategoryLayout.ProtoReflect.Descriptor instead.
+func (*CategoryLayout) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *User) GetId() string {
+func (x *CategoryLayout) GetCategoryId() string {
 	if x != nil {
-		return x.Id
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *User) GetDisplayId() string {
+func (x *CategoryLayout) GetChannelIds() []string {
 	if x != nil {
-		return x.DisplayId
+		return x.ChannelIds
 	}
-	return ""
+	return nil
+}
+
+type Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// @everyoneはギルドと同じID
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId string `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 権限のビット集合
+	Permissions int64 `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	// 大きいほど上。@everyoneは0
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_guild_type_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() int64 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+func (x *Role) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Role) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// TODO: あとからProtoをリファクタするときにuser protoのものをimportして使うようにする
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayId     string                 `protobuf:"bytes,2,opt,name=display_id,json=displayId,proto3" json:"display_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,5,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_guild_type_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetDisplayId() string {
+	if x != nil {
+		return x.DisplayId
+	}
+	return ""
 }
 
 func (x *User) GetName() string {
@@ -896,28 +1381,360 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type Ban struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	User    *User                  `protobuf:"bytes,3,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	// BANしたユーザーが削除された場合は含まれない
+	ModeratorId *string `protobuf:"bytes,4,opt,name=moderator_id,json=moderatorId,proto3,oneof" json:"moderator_id,omitempty"`
+	Reason      string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 省略された場合は無期限
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_guild_type_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Ban) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Ban) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Ban) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Ban) GetModeratorId() string {
+	if x != nil && x.ModeratorId != nil {
+		return *x.ModeratorId
+	}
+	return ""
+}
+
+func (x *Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Ban) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Ban) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// チャンネルかカテゴリーに設定する、ロールかメンバーごとの権限の上書き
+type PermissionOverwrite struct {
+	state      protoimpl.MessageState        `protogen:"open.v1"`
+	TargetType PermissionOverwriteTargetType `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=guild.PermissionOverwriteTargetType" json:"target_type,omitempty"`
+	// ロールのIDかメンバーのユーザーID。@everyoneはギルドのID
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// 許可する権限のビット集合
+	Allow int64 `protobuf:"varint,3,opt,name=allow,proto3" json:"allow,omitempty"`
+	// 拒否する権限のビット集合
+	Deny          int64 `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionOverwrite) Reset() {
+	*x = PermissionOverwrite{}
+	mi := &file_guild_type_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionOverwrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionOverwrite) ProtoMessage() {}
+
+func (x *PermissionOverwrite) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionOverwrite.ProtoReflect.Descriptor instead.
+func (*PermissionOverwrite) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PermissionOverwrite) GetTargetType() PermissionOverwriteTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *PermissionOverwrite) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *PermissionOverwrite) GetAllow() int64 {
+	if x != nil {
+		return x.Allow
+	}
+	return 0
+}
+
+func (x *PermissionOverwrite) GetDeny() int64 {
+	if x != nil {
+		return x.Deny
+	}
+	return 0
+}
+
+// ギルドの設定やメンバーを変更した操作の記録
+type AuditLogEntry struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// 操作したユーザーが削除された場合は含まれない
+	ActorId    *string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	ActionType string  `protobuf:"bytes,4,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	// 対象のない操作では省略される
+	TargetId      *string                `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Changes       []*AuditLogChange      `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_guild_type_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetChanges() []*AuditLogChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AuditLogChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 変更前の値をJSONにしたもの。作成時は省略される
+	OldValue *string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	// 変更後の値をJSONにしたもの。削除時は省略される
+	NewValue      *string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogChange) Reset() {
+	*x = AuditLogChange{}
+	mi := &file_guild_type_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogChange) ProtoMessage() {}
+
+func (x *AuditLogChange) ProtoReflect() protoreflect.Message {
+	mi := &file_guild_type_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogChange.ProtoReflect.Descriptor instead.
+func (*AuditLogChange) Descriptor() ([]byte, []int) {
+	return file_guild_type_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditLogChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AuditLogChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *AuditLogChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
 var File_guild_type_proto protoreflect.FileDescriptor
 
 const file_guild_type_proto_rawDesc = "" +
 	"\n" +
-	"\x10guild_type.proto\x12\x05guild\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xfe\x02\n" +
+	"\x10guild_type.proto\x12\x05guild\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x85\x03\n" +
 	"\x05Guild\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x00R\aownerId\x88\x01\x01\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
 	"\bicon_url\x18\x05 \x01(\tR\aiconUrl\x12,\n" +
 	"\x12default_channel_id\x18\a \x01(\tR\x10defaultChannelId\x12&\n" +
-	"\fmember_count\x18\b \x01(\x05H\x00R\vmemberCount\x88\x01\x01\x129\n" +
+	"\fmember_count\x18\b \x01(\x05H\x01R\vmemberCount\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:W\x92AT\n" +
-	"R\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\bowner_id\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\n" +
-	"created_atB\x0f\n" +
-	"\r_member_count\"\x8f\x03\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:L\x92AI\n" +
+	"G\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\n" +
+	"created_atB\v\n" +
+	"\t_owner_idB\x0f\n" +
+	"\r_member_count\"\x96\x03\n" +
 	"\vGuildDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x00R\aownerId\x88\x01\x01\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
 	"\bicon_url\x18\x05 \x01(\tR\aiconUrl\x12,\n" +
 	"\x12default_channel_id\x18\b \x01(\tR\x10defaultChannelId\x129\n" +
@@ -925,44 +1742,62 @@ const file_guild_type_proto_rawDesc = "" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
 	"\n" +
 	"categories\x18\a \x03(\v2\x15.guild.CategoryDetailR\n" +
-	"categories:d\x92Aa\n" +
-	"_\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\bowner_id\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\n" +
+	"categories:Y\x92AV\n" +
+	"T\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\n" +
 	"created_at\xd2\x01\n" +
-	"categories\"\xb5\x03\n" +
+	"categoriesB\v\n" +
+	"\t_owner_id\"\xbc\x03\n" +
 	"\x10GuildWithMembers\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x00R\aownerId\x88\x01\x01\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
 	"\bicon_url\x18\x05 \x01(\tR\aiconUrl\x12,\n" +
 	"\x12default_channel_id\x18\a \x01(\tR\x10defaultChannelId\x12!\n" +
 	"\fmember_count\x18\b \x01(\x05R\vmemberCount\x12'\n" +
 	"\amembers\x18\t \x03(\v2\r.guild.MemberR\amembers\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:p\x92Am\n" +
-	"k\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\bowner_id\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\fmember_count\xd2\x01\amembers\xd2\x01\n" +
-	"created_at\"\x86\x03\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:e\x92Ab\n" +
+	"`\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\fmember_count\xd2\x01\amembers\xd2\x01\n" +
+	"created_atB\v\n" +
+	"\t_owner_id\"\x8d\x03\n" +
 	"\x14GuildWithMemberCount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\bowner_id\x18\x03 \x01(\tH\x00R\aownerId\x88\x01\x01\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
 	"\bicon_url\x18\x05 \x01(\tR\aiconUrl\x12,\n" +
 	"\x12default_channel_id\x18\a \x01(\tR\x10defaultChannelId\x12!\n" +
 	"\fmember_count\x18\b \x01(\x05R\vmemberCount\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:f\x92Ac\n" +
-	"a\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\bowner_id\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\fmember_count\xd2\x01\n" +
-	"created_at\"\xec\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:[\x92AX\n" +
+	"V\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vdescription\xd2\x01\x12default_channel_id\xd2\x01\bicon_url\xd2\x01\fmember_count\xd2\x01\n" +
+	"created_atB\v\n" +
+	"\t_owner_id\"\x99\x02\n" +
 	"\x0eCategoryDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
-	"\bchannels\x18\x05 \x03(\v2\x0e.guild.ChannelR\bchannels:4\x92A1\n" +
-	"/\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\n" +
-	"created_at\xd2\x01\bchannels\"\xf1\x03\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x120\n" +
+	"\bchannels\x18\x05 \x03(\v2\x14.guild.ChannelDetailR\bchannels\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition:?\x92A<\n" +
+	":\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\n" +
+	"created_at\xd2\x01\bchannels\xd2\x01\bposition\"\x9a\x03\n" +
+	"\rChannelDetail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\x14last_read_message_id\x18\x05 \x01(\tH\x00R\x11lastReadMessageId\x88\x01\x01\x12!\n" +
+	"\funread_count\x18\x06 \x01(\x05R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\a \x01(\x05R\fmentionCount\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition:V\x92AS\n" +
+	"Q\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vcategory_id\xd2\x01\n" +
+	"created_at\xd2\x01\funread_count\xd2\x01\rmention_count\xd2\x01\bpositionB\x17\n" +
+	"\x15_last_read_message_id\"\x92\x04\n" +
 	"\x06Invite\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12'\n" +
 	"\x05guild\x18\x02 \x01(\v2\f.guild.GuildH\x00R\x05guild\x88\x01\x01\x12\x1d\n" +
@@ -976,38 +1811,70 @@ const file_guild_type_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x03R\texpiresAt\x88\x01\x01\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:G\x92AD\n" +
-	"B\xd2\x01\bguild_id\xd2\x01\n" +
-	"creator_id\xd2\x01\fcurrent_uses\xd2\x01\vinvite_code\xd2\x01\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06vanity\x18\n" +
+	" \x01(\bR\x06vanity:P\x92AM\n" +
+	"K\xd2\x01\bguild_id\xd2\x01\n" +
+	"creator_id\xd2\x01\fcurrent_uses\xd2\x01\vinvite_code\xd2\x01\x06vanity\xd2\x01\n" +
 	"created_atB\b\n" +
 	"\x06_guildB\n" +
 	"\n" +
 	"\b_creatorB\v\n" +
 	"\t_max_usesB\r\n" +
-	"\v_expires_at\"\xcc\x01\n" +
+	"\v_expires_at\"\xd2\x01\n" +
+	"\tInviteUse\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04user\x18\x03 \x01(\v2\v.guild.UserH\x00R\x04user\x88\x01\x01\x123\n" +
+	"\aused_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt:'\x92A$\n" +
+	"\"\xd2\x01\vinvite_code\xd2\x01\auser_id\xd2\x01\aused_atB\a\n" +
+	"\x05_user\"\x9a\x03\n" +
 	"\x06Member\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12$\n" +
 	"\x04user\x18\x03 \x01(\v2\v.guild.UserH\x00R\x04user\x88\x01\x01\x127\n" +
-	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt:&\x92A#\n" +
+	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.guild.PresenceStatusR\x06status\x12\x19\n" +
+	"\brole_ids\x18\x06 \x03(\tR\aroleIds\x12a\n" +
+	"\x1ccommunication_disabled_until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x1acommunicationDisabledUntil\x88\x01\x01:&\x92A#\n" +
 	"!\xd2\x01\auser_id\xd2\x01\bguild_id\xd2\x01\tjoined_atB\a\n" +
-	"\x05_user\"\xaf\x01\n" +
+	"\x05_userB\x1f\n" +
+	"\x1d_communication_disabled_until\"\xd6\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:)\x92A&\n" +
-	"$\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\n" +
-	"created_at\"\xb7\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition:4\x92A1\n" +
+	"/\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\bposition\xd2\x01\n" +
+	"created_at\"\xde\x01\n" +
 	"\aChannel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\tR\n" +
 	"categoryId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:,\x92A)\n" +
-	"'\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vcategory_id\xd2\x01\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition:7\x92A4\n" +
+	"2\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\vcategory_id\xd2\x01\bposition\xd2\x01\n" +
+	"created_at\"u\n" +
+	"\x0eCategoryLayout\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds:!\x92A\x1e\n" +
+	"\x1c\xd2\x01\vcategory_id\xd2\x01\vchannel_ids\"\x82\x02\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x04 \x01(\x03R\vpermissions\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:B\x92A?\n" +
+	"=\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\x04name\xd2\x01\vpermissions\xd2\x01\bposition\xd2\x01\n" +
 	"created_at\"\xd7\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
@@ -1019,7 +1886,64 @@ const file_guild_type_proto_rawDesc = "" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:6\x92A3\n" +
 	"1\xd2\x01\x02id\xd2\x01\n" +
 	"display_id\xd2\x01\x04name\xd2\x01\bicon_url\xd2\x01\n" +
-	"created_atB`\n" +
+	"created_at\"\xf5\x02\n" +
+	"\x03Ban\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04user\x18\x03 \x01(\v2\v.guild.UserH\x00R\x04user\x88\x01\x01\x12&\n" +
+	"\fmoderator_id\x18\x04 \x01(\tH\x01R\vmoderatorId\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\texpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:0\x92A-\n" +
+	"+\xd2\x01\bguild_id\xd2\x01\auser_id\xd2\x01\x06reason\xd2\x01\n" +
+	"created_atB\a\n" +
+	"\x05_userB\x0f\n" +
+	"\r_moderator_idB\r\n" +
+	"\v_expires_at\"\xd3\x01\n" +
+	"\x13PermissionOverwrite\x12E\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2$.guild.PermissionOverwriteTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05allow\x18\x03 \x01(\x03R\x05allow\x12\x12\n" +
+	"\x04deny\x18\x04 \x01(\x03R\x04deny:.\x92A+\n" +
+	")\xd2\x01\vtarget_type\xd2\x01\ttarget_id\xd2\x01\x05allow\xd2\x01\x04deny\"\x81\x03\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x00R\aactorId\x88\x01\x01\x12\x1f\n" +
+	"\vaction_type\x18\x04 \x01(\tR\n" +
+	"actionType\x12 \n" +
+	"\ttarget_id\x18\x05 \x01(\tH\x01R\btargetId\x88\x01\x01\x12/\n" +
+	"\achanges\x18\x06 \x03(\v2\x15.guild.AuditLogChangeR\achanges\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:C\x92A@\n" +
+	">\xd2\x01\x02id\xd2\x01\bguild_id\xd2\x01\vaction_type\xd2\x01\achanges\xd2\x01\x06reason\xd2\x01\n" +
+	"created_atB\v\n" +
+	"\t_actor_idB\f\n" +
+	"\n" +
+	"_target_id\"\x8f\x01\n" +
+	"\x0eAuditLogChange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12 \n" +
+	"\told_value\x18\x02 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x03 \x01(\tH\x01R\bnewValue\x88\x01\x01:\v\x92A\b\n" +
+	"\x06\xd2\x01\x03keyB\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value*\xa9\x01\n" +
+	"\x1dPermissionOverwriteTargetType\x120\n" +
+	",PERMISSION_OVERWRITE_TARGET_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
+	"%PERMISSION_OVERWRITE_TARGET_TYPE_ROLE\x10\x01\x12+\n" +
+	"'PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER\x10\x02*\x9d\x01\n" +
+	"\x0ePresenceStatus\x12\x1f\n" +
+	"\x1bPRESENCE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PRESENCE_STATUS_ONLINE\x10\x01\x12\x18\n" +
+	"\x14PRESENCE_STATUS_IDLE\x10\x02\x12\x17\n" +
+	"\x13PRESENCE_STATUS_DND\x10\x03\x12\x1b\n" +
+	"\x17PRESENCE_STATUS_OFFLINE\x10\x04B`\n" +
 	"\tcom.guildB\x0eGuildTypeProtoP\x01Z\x0f./guild;guildpb\xa2\x02\x03GXX\xaa\x02\x05Guild\xca\x02\x05Guild\xe2\x02\x11Guild\\GPBMetadata\xea\x02\x05Guildb\x06proto3"
 
 var (
@@ -1034,43 +1958,66 @@ func file_guild_type_proto_rawDescGZIP() []byte {
 	return file_guild_type_proto_rawDescData
 }
 
-var file_guild_type_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_guild_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_guild_type_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_guild_type_proto_goTypes = []any{
-	(*Guild)(nil),                 // 0: guild.Guild
-	(*GuildDetail)(nil),           // 1: guild.GuildDetail
-	(*GuildWithMembers)(nil),      // 2: guild.GuildWithMembers
-	(*GuildWithMemberCount)(nil),  // 3: guild.GuildWithMemberCount
-	(*CategoryDetail)(nil),        // 4: guild.CategoryDetail
-	(*Invite)(nil),                // 5: guild.Invite
-	(*Member)(nil),                // 6: guild.Member
-	(*Category)(nil),              // 7: guild.Category
-	(*Channel)(nil),               // 8: guild.Channel
-	(*User)(nil),                  // 9: guild.User
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(PermissionOverwriteTargetType)(0), // 0: guild.PermissionOverwriteTargetType
+	(PresenceStatus)(0),                // 1: guild.PresenceStatus
+	(*Guild)(nil),                      // 2: guild.Guild
+	(*GuildDetail)(nil),                // 3: guild.GuildDetail
+	(*GuildWithMembers)(nil),           // 4: guild.GuildWithMembers
+	(*GuildWithMemberCount)(nil),       // 5: guild.GuildWithMemberCount
+	(*CategoryDetail)(nil),             // 6: guild.CategoryDetail
+	(*ChannelDetail)(nil),              // 7: guild.ChannelDetail
+	(*Invite)(nil),                     // 8: guild.Invite
+	(*InviteUse)(nil),                  // 9: guild.InviteUse
+	(*Member)(nil),                     // 10: guild.Member
+	(*Category)(nil),                   // 11: guild.Category
+	(*Channel)(nil),                    // 12: guild.Channel
+	(*CategoryLayout)(nil),             // 13: guild.CategoryLayout
+	(*Role)(nil),                       // 14: guild.Role
+	(*User)(nil),                       // 15: guild.User
+	(*Ban)(nil),                        // 16: guild.Ban
+	(*PermissionOverwrite)(nil),        // 17: guild.PermissionOverwrite
+	(*AuditLogEntry)(nil),              // 18: guild.AuditLogEntry
+	(*AuditLogChange)(nil),             // 19: guild.AuditLogChange
+	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
 }
 var file_guild_type_proto_depIdxs = []int32{
-	10, // 0: guild.Guild.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: guild.GuildDetail.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 2: guild.GuildDetail.categories:type_name -> guild.CategoryDetail
-	6,  // 3: guild.GuildWithMembers.members:type_name -> guild.Member
-	10, // 4: guild.GuildWithMembers.created_at:type_name -> google.protobuf.Timestamp
-	10, // 5: guild.GuildWithMemberCount.created_at:type_name -> google.protobuf.Timestamp
-	10, // 6: guild.CategoryDetail.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: guild.CategoryDetail.channels:type_name -> guild.Channel
-	0,  // 8: guild.Invite.guild:type_name -> guild.Guild
-	9,  // 9: guild.Invite.creator:type_name -> guild.User
-	10, // 10: guild.Invite.expires_at:type_name -> google.protobuf.Timestamp
-	10, // 11: guild.Invite.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 12: guild.Member.user:type_name -> guild.User
-	10, // 13: guild.Member.joined_at:type_name -> google.protobuf.Timestamp
-	10, // 14: guild.Category.created_at:type_name -> google.protobuf.Timestamp
-	10, // 15: guild.Channel.created_at:type_name -> google.protobuf.Timestamp
-	10, // 16: guild.User.created_at:type_name -> google.protobuf.Timestamp
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	20, // 0: guild.Guild.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: guild.GuildDetail.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: guild.GuildDetail.categories:type_name -> guild.CategoryDetail
+	10, // 3: guild.GuildWithMembers.members:type_name -> guild.Member
+	20, // 4: guild.GuildWithMembers.created_at:type_name -> google.protobuf.Timestamp
+	20, // 5: guild.GuildWithMemberCount.created_at:type_name -> google.protobuf.Timestamp
+	20, // 6: guild.CategoryDetail.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: guild.CategoryDetail.channels:type_name -> guild.ChannelDetail
+	20, // 8: guild.ChannelDetail.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: guild.Invite.guild:type_name -> guild.Guild
+	15, // 10: guild.Invite.creator:type_name -> guild.User
+	20, // 11: guild.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 12: guild.Invite.created_at:type_name -> google.protobuf.Timestamp
+	15, // 13: guild.InviteUse.user:type_name -> guild.User
+	20, // 14: guild.InviteUse.used_at:type_name -> google.protobuf.Timestamp
+	15, // 15: guild.Member.user:type_name -> guild.User
+	20, // 16: guild.Member.joined_at:type_name -> google.protobuf.Timestamp
+	1,  // 17: guild.Member.status:type_name -> guild.PresenceStatus
+	20, // 18: guild.Member.communication_disabled_until:type_name -> google.protobuf.Timestamp
+	20, // 19: guild.Category.created_at:type_name -> google.protobuf.Timestamp
+	20, // 20: guild.Channel.created_at:type_name -> google.protobuf.Timestamp
+	20, // 21: guild.Role.created_at:type_name -> google.protobuf.Timestamp
+	20, // 22: guild.User.created_at:type_name -> google.protobuf.Timestamp
+	15, // 23: guild.Ban.user:type_name -> guild.User
+	20, // 24: guild.Ban.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 25: guild.Ban.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 26: guild.PermissionOverwrite.target_type:type_name -> guild.PermissionOverwriteTargetType
+	19, // 27: guild.AuditLogEntry.changes:type_name -> guild.AuditLogChange
+	20, // 28: guild.AuditLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_guild_type_proto_init() }
@@ -1079,20 +2026,29 @@ func file_guild_type_proto_init() {
 		return
 	}
 	file_guild_type_proto_msgTypes[0].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[1].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[2].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[3].OneofWrappers = []any{}
 	file_guild_type_proto_msgTypes[5].OneofWrappers = []any{}
 	file_guild_type_proto_msgTypes[6].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[7].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[8].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[14].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[16].OneofWrappers = []any{}
+	file_guild_type_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guild_type_proto_rawDesc), len(file_guild_type_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_guild_type_proto_goTypes,
 		DependencyIndexes: file_guild_type_proto_depIdxs,
+		EnumInfos:         file_guild_type_proto_enumTypes,
 		MessageInfos:      file_guild_type_proto_msgTypes,
 	}.Build()
 	File_guild_type_proto = out.File
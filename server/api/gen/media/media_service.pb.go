@@ -29,6 +29,7 @@ const (
 	MediaType_MEDIA_TYPE_UNSPECIFIED MediaType = 0
 	MediaType_MEDIA_TYPE_GUILD_ICON  MediaType = 1
 	MediaType_MEDIA_TYPE_USER_ICON   MediaType = 2
+	MediaType_MEDIA_TYPE_ATTACHMENT  MediaType = 3
 )
 
 // Enum value maps for MediaType.
@@ -37,11 +38,13 @@ var (
 		0: "MEDIA_TYPE_UNSPECIFIED",
 		1: "MEDIA_TYPE_GUILD_ICON",
 		2: "MEDIA_TYPE_USER_ICON",
+		3: "MEDIA_TYPE_ATTACHMENT",
 	}
 	MediaType_value = map[string]int32{
 		"MEDIA_TYPE_UNSPECIFIED": 0,
 		"MEDIA_TYPE_GUILD_ICON":  1,
 		"MEDIA_TYPE_USER_ICON":   2,
+		"MEDIA_TYPE_ATTACHMENT":  3,
 	}
 )
 
@@ -73,9 +76,11 @@ func (MediaType) EnumDescriptor() ([]byte, []int) {
 }
 
 type GetPresignedUploadURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MediaType     MediaType              `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=media.MediaType" json:"media_type,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MediaType MediaType              `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=media.MediaType" json:"media_type,omitempty"`
+	Filename  string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// MEDIA_TYPE_ATTACHMENTの場合は必須で、アップロード先をチャンネルごとに分ける
+	ChannelId     *string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -124,9 +129,18 @@ func (x *GetPresignedUploadURLRequest) GetFilename() string {
 	return ""
 }
 
+func (x *GetPresignedUploadURLRequest) GetChannelId() string {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return ""
+}
+
 type GetPresignedUploadURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// メッセージに添付するときに指定するキー
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -168,28 +182,306 @@ func (x *GetPresignedUploadURLResponse) GetUploadUrl() string {
 	return ""
 }
 
+func (x *GetPresignedUploadURLResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+type StatObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectKeys    []string               `protobuf:"bytes,1,rep,name=object_keys,json=objectKeys,proto3" json:"object_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatObjectsRequest) Reset() {
+	*x = StatObjectsRequest{}
+	mi := &file_media_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatObjectsRequest) ProtoMessage() {}
+
+func (x *StatObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatObjectsRequest.ProtoReflect.Descriptor instead.
+func (*StatObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_media_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatObjectsRequest) GetObjectKeys() []string {
+	if x != nil {
+		return x.ObjectKeys
+	}
+	return nil
+}
+
+type ObjectStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectKey     string                 `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	Exists        bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectStat) Reset() {
+	*x = ObjectStat{}
+	mi := &file_media_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStat) ProtoMessage() {}
+
+func (x *ObjectStat) ProtoReflect() protoreflect.Message {
+	mi := &file_media_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStat.ProtoReflect.Descriptor instead.
+func (*ObjectStat) Descriptor() ([]byte, []int) {
+	return file_media_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ObjectStat) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ObjectStat) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *ObjectStat) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ObjectStat) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ObjectStat) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type StatObjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// object_keysと同じ順序で返す
+	Objects       []*ObjectStat `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatObjectsResponse) Reset() {
+	*x = StatObjectsResponse{}
+	mi := &file_media_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatObjectsResponse) ProtoMessage() {}
+
+func (x *StatObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatObjectsResponse.ProtoReflect.Descriptor instead.
+func (*StatObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_media_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StatObjectsResponse) GetObjects() []*ObjectStat {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type PurgeChannelAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelIds    []string               `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeChannelAttachmentsRequest) Reset() {
+	*x = PurgeChannelAttachmentsRequest{}
+	mi := &file_media_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeChannelAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChannelAttachmentsRequest) ProtoMessage() {}
+
+func (x *PurgeChannelAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChannelAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeChannelAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_media_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PurgeChannelAttachmentsRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type PurgeChannelAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeChannelAttachmentsResponse) Reset() {
+	*x = PurgeChannelAttachmentsResponse{}
+	mi := &file_media_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeChannelAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChannelAttachmentsResponse) ProtoMessage() {}
+
+func (x *PurgeChannelAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChannelAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeChannelAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_media_service_proto_rawDescGZIP(), []int{6}
+}
+
 var File_media_service_proto protoreflect.FileDescriptor
 
 const file_media_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13media_service.proto\x12\x05media\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8a\x01\n" +
+	"\x13media_service.proto\x12\x05media\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xbd\x01\n" +
 	"\x1cGetPresignedUploadURLRequest\x12/\n" +
 	"\n" +
 	"media_type\x18\x01 \x01(\x0e2\x10.media.MediaTypeR\tmediaType\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename:\x1d\x92A\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\"\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tH\x00R\tchannelId\x88\x01\x01:\x1d\x92A\x1a\n" +
 	"\x18\xd2\x01\n" +
-	"media_type\xd2\x01\bfilename\"R\n" +
+	"media_type\xd2\x01\bfilenameB\r\n" +
+	"\v_channel_id\"~\n" +
 	"\x1dGetPresignedUploadURLResponse\x12\x1d\n" +
 	"\n" +
-	"upload_url\x18\x01 \x01(\tR\tuploadUrl:\x12\x92A\x0f\n" +
-	"\r\xd2\x01\n" +
-	"upload_url*\\\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tR\tobjectKey:\x1f\x92A\x1c\n" +
+	"\x1a\xd2\x01\n" +
+	"upload_url\xd2\x01\n" +
+	"object_key\"5\n" +
+	"\x12StatObjectsRequest\x12\x1f\n" +
+	"\vobject_keys\x18\x01 \x03(\tR\n" +
+	"objectKeys\"\x8c\x01\n" +
+	"\n" +
+	"ObjectStat\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"B\n" +
+	"\x13StatObjectsResponse\x12+\n" +
+	"\aobjects\x18\x01 \x03(\v2\x11.media.ObjectStatR\aobjects\"A\n" +
+	"\x1ePurgeChannelAttachmentsRequest\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\"!\n" +
+	"\x1fPurgeChannelAttachmentsResponse*w\n" +
 	"\tMediaType\x12\x1a\n" +
 	"\x16MEDIA_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15MEDIA_TYPE_GUILD_ICON\x10\x01\x12\x18\n" +
-	"\x14MEDIA_TYPE_USER_ICON\x10\x022\xd6\x01\n" +
+	"\x14MEDIA_TYPE_USER_ICON\x10\x02\x12\x19\n" +
+	"\x15MEDIA_TYPE_ATTACHMENT\x10\x032\x86\x03\n" +
 	"\fMediaService\x12\x84\x01\n" +
-	"\x15GetPresignedUploadURL\x12#.media.GetPresignedUploadURLRequest\x1a$.media.GetPresignedUploadURLResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/media/upload-url\x1a?\x92A<\n" +
+	"\x15GetPresignedUploadURL\x12#.media.GetPresignedUploadURLRequest\x1a$.media.GetPresignedUploadURLResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/media/upload-url\x12D\n" +
+	"\vStatObjects\x12\x19.media.StatObjectsRequest\x1a\x1a.media.StatObjectsResponse\x12h\n" +
+	"\x17PurgeChannelAttachments\x12%.media.PurgeChannelAttachmentsRequest\x1a&.media.PurgeChannelAttachmentsResponse\x1a?\x92A<\n" +
 	"\x05Media\x123Media service for handling media-related operationsBc\n" +
 	"\tcom.mediaB\x11MediaServiceProtoP\x01Z\x0f./media;mediapb\xa2\x02\x03MXX\xaa\x02\x05Media\xca\x02\x05Media\xe2\x02\x11Media\\GPBMetadata\xea\x02\x05Mediab\x06proto3"
 
@@ -206,21 +498,31 @@ func file_media_service_proto_rawDescGZIP() []byte {
 }
 
 var file_media_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_media_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_media_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_media_service_proto_goTypes = []any{
-	(MediaType)(0),                        // 0: media.MediaType
-	(*GetPresignedUploadURLRequest)(nil),  // 1: media.GetPresignedUploadURLRequest
-	(*GetPresignedUploadURLResponse)(nil), // 2: media.GetPresignedUploadURLResponse
+	(MediaType)(0),                          // 0: media.MediaType
+	(*GetPresignedUploadURLRequest)(nil),    // 1: media.GetPresignedUploadURLRequest
+	(*GetPresignedUploadURLResponse)(nil),   // 2: media.GetPresignedUploadURLResponse
+	(*StatObjectsRequest)(nil),              // 3: media.StatObjectsRequest
+	(*ObjectStat)(nil),                      // 4: media.ObjectStat
+	(*StatObjectsResponse)(nil),             // 5: media.StatObjectsResponse
+	(*PurgeChannelAttachmentsRequest)(nil),  // 6: media.PurgeChannelAttachmentsRequest
+	(*PurgeChannelAttachmentsResponse)(nil), // 7: media.PurgeChannelAttachmentsResponse
 }
 var file_media_service_proto_depIdxs = []int32{
 	0, // 0: media.GetPresignedUploadURLRequest.media_type:type_name -> media.MediaType
-	1, // 1: media.MediaService.GetPresignedUploadURL:input_type -> media.GetPresignedUploadURLRequest
-	2, // 2: media.MediaService.GetPresignedUploadURL:output_type -> media.GetPresignedUploadURLResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 1: media.StatObjectsResponse.objects:type_name -> media.ObjectStat
+	1, // 2: media.MediaService.GetPresignedUploadURL:input_type -> media.GetPresignedUploadURLRequest
+	3, // 3: media.MediaService.StatObjects:input_type -> media.StatObjectsRequest
+	6, // 4: media.MediaService.PurgeChannelAttachments:input_type -> media.PurgeChannelAttachmentsRequest
+	2, // 5: media.MediaService.GetPresignedUploadURL:output_type -> media.GetPresignedUploadURLResponse
+	5, // 6: media.MediaService.StatObjects:output_type -> media.StatObjectsResponse
+	7, // 7: media.MediaService.PurgeChannelAttachments:output_type -> media.PurgeChannelAttachmentsResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_media_service_proto_init() }
@@ -228,13 +530,14 @@ func file_media_service_proto_init() {
 	if File_media_service_proto != nil {
 		return
 	}
+	file_media_service_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_service_proto_rawDesc), len(file_media_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
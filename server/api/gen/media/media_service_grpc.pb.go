@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MediaService_GetPresignedUploadURL_FullMethodName = "/media.MediaService/GetPresignedUploadURL"
+	MediaService_GetPresignedUploadURL_FullMethodName   = "/media.MediaService/GetPresignedUploadURL"
+	MediaService_StatObjects_FullMethodName             = "/media.MediaService/StatObjects"
+	MediaService_PurgeChannelAttachments_FullMethodName = "/media.MediaService/PurgeChannelAttachments"
 )
 
 // MediaServiceClient is the client API for MediaService service.
@@ -27,6 +29,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MediaServiceClient interface {
 	GetPresignedUploadURL(ctx context.Context, in *GetPresignedUploadURLRequest, opts ...grpc.CallOption) (*GetPresignedUploadURLResponse, error)
+	// オブジェクトが存在するかとそのメタデータを返す。サービス間でのみ使用する
+	StatObjects(ctx context.Context, in *StatObjectsRequest, opts ...grpc.CallOption) (*StatObjectsResponse, error)
+	// 削除されたチャンネルの添付ファイルを消し、完了してから返す。冪等なので再試行してよい。サービス間でのみ使用する
+	PurgeChannelAttachments(ctx context.Context, in *PurgeChannelAttachmentsRequest, opts ...grpc.CallOption) (*PurgeChannelAttachmentsResponse, error)
 }
 
 type mediaServiceClient struct {
@@ -47,11 +53,35 @@ func (c *mediaServiceClient) GetPresignedUploadURL(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *mediaServiceClient) StatObjects(ctx context.Context, in *StatObjectsRequest, opts ...grpc.CallOption) (*StatObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatObjectsResponse)
+	err := c.cc.Invoke(ctx, MediaService_StatObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) PurgeChannelAttachments(ctx context.Context, in *PurgeChannelAttachmentsRequest, opts ...grpc.CallOption) (*PurgeChannelAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeChannelAttachmentsResponse)
+	err := c.cc.Invoke(ctx, MediaService_PurgeChannelAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MediaServiceServer is the server API for MediaService service.
 // All implementations must embed UnimplementedMediaServiceServer
 // for forward compatibility.
 type MediaServiceServer interface {
 	GetPresignedUploadURL(context.Context, *GetPresignedUploadURLRequest) (*GetPresignedUploadURLResponse, error)
+	// オブジェクトが存在するかとそのメタデータを返す。サービス間でのみ使用する
+	StatObjects(context.Context, *StatObjectsRequest) (*StatObjectsResponse, error)
+	// 削除されたチャンネルの添付ファイルを消し、完了してから返す。冪等なので再試行してよい。サービス間でのみ使用する
+	PurgeChannelAttachments(context.Context, *PurgeChannelAttachmentsRequest) (*PurgeChannelAttachmentsResponse, error)
 	mustEmbedUnimplementedMediaServiceServer()
 }
 
@@ -65,6 +95,12 @@ type UnimplementedMediaServiceServer struct{}
 func (UnimplementedMediaServiceServer) GetPresignedUploadURL(context.Context, *GetPresignedUploadURLRequest) (*GetPresignedUploadURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPresignedUploadURL not implemented")
 }
+func (UnimplementedMediaServiceServer) StatObjects(context.Context, *StatObjectsRequest) (*StatObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatObjects not implemented")
+}
+func (UnimplementedMediaServiceServer) PurgeChannelAttachments(context.Context, *PurgeChannelAttachmentsRequest) (*PurgeChannelAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeChannelAttachments not implemented")
+}
 func (UnimplementedMediaServiceServer) mustEmbedUnimplementedMediaServiceServer() {}
 func (UnimplementedMediaServiceServer) testEmbeddedByValue()                      {}
 
@@ -104,6 +140,42 @@ func _MediaService_GetPresignedUploadURL_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_StatObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).StatObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_StatObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).StatObjects(ctx, req.(*StatObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_PurgeChannelAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeChannelAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).PurgeChannelAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_PurgeChannelAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).PurgeChannelAttachments(ctx, req.(*PurgeChannelAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MediaService_ServiceDesc is the grpc.ServiceDesc for MediaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +187,14 @@ var MediaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPresignedUploadURL",
 			Handler:    _MediaService_GetPresignedUploadURL_Handler,
 		},
+		{
+			MethodName: "StatObjects",
+			Handler:    _MediaService_StatObjects_Handler,
+		},
+		{
+			MethodName: "PurgeChannelAttachments",
+			Handler:    _MediaService_PurgeChannelAttachments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "media_service.proto",
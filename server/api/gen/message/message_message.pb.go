@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,10 +25,12 @@ const (
 )
 
 type CreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	ReplyId       *string                `protobuf:"bytes,3,opt,name=reply_id,json=replyId,proto3,oneof" json:"reply_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// attachmentsがある場合は空にできる
+	Content       string              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ReplyId       *string             `protobuf:"bytes,3,opt,name=reply_id,json=replyId,proto3,oneof" json:"reply_id,omitempty"`
+	Attachments   []*CreateAttachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,6 +86,82 @@ func (x *CreateRequest) GetReplyId() string {
 	return ""
 }
 
+func (x *CreateRequest) GetAttachments() []*CreateAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// MediaService.GetPresignedUploadURLでMEDIA_TYPE_ATTACHMENTとしてアップロードしたファイル
+type CreateAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectKey     string                 `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachment) Reset() {
+	*x = CreateAttachment{}
+	mi := &file_message_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachment) ProtoMessage() {}
+
+func (x *CreateAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachment.ProtoReflect.Descriptor instead.
+func (*CreateAttachment) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAttachment) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *CreateAttachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateAttachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateAttachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -92,7 +171,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_message_message_proto_msgTypes[1]
+	mi := &file_message_message_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +183,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[1]
+	mi := &file_message_message_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +196,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{1}
+	return file_message_message_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateResponse) GetMessage() *Message {
@@ -128,15 +207,21 @@ func (x *CreateResponse) GetMessage() *Message {
 }
 
 type GetByChannelIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// before / after / around はメッセージIDのカーソルで、同時に指定できるのは1つまで
+	// どれも指定しない場合は最新のメッセージを返す
+	Before        *string `protobuf:"bytes,2,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	After         *string `protobuf:"bytes,3,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	Around        *string `protobuf:"bytes,4,opt,name=around,proto3,oneof" json:"around,omitempty"`
+	Limit         *int32  `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByChannelIDRequest) Reset() {
 	*x = GetByChannelIDRequest{}
-	mi := &file_message_message_proto_msgTypes[2]
+	mi := &file_message_message_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +233,7 @@ func (x *GetByChannelIDRequest) String() string {
 func (*GetByChannelIDRequest) ProtoMessage() {}
 
 func (x *GetByChannelIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[2]
+	mi := &file_message_message_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +246,7 @@ func (x *GetByChannelIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByChannelIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByChannelIDRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{2}
+	return file_message_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetByChannelIDRequest) GetChannelId() string {
@@ -171,16 +256,50 @@ func (x *GetByChannelIDRequest) GetChannelId() string {
 	return ""
 }
 
+func (x *GetByChannelIDRequest) GetBefore() string {
+	if x != nil && x.Before != nil {
+		return *x.Before
+	}
+	return ""
+}
+
+func (x *GetByChannelIDRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *GetByChannelIDRequest) GetAround() string {
+	if x != nil && x.Around != nil {
+		return *x.Around
+	}
+	return ""
+}
+
+func (x *GetByChannelIDRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
 type GetByChannelIDResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 常にcreated_atの昇順で返す
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	HasMore  bool       `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// まだ古いメッセージが残っている場合に、次のbeforeとして使うカーソル
+	NextBefore *string `protobuf:"bytes,3,opt,name=next_before,json=nextBefore,proto3,oneof" json:"next_before,omitempty"`
+	// まだ新しいメッセージが残っている場合に、次のafterとして使うカーソル
+	NextAfter     *string `protobuf:"bytes,4,opt,name=next_after,json=nextAfter,proto3,oneof" json:"next_after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetByChannelIDResponse) Reset() {
 	*x = GetByChannelIDResponse{}
-	mi := &file_message_message_proto_msgTypes[3]
+	mi := &file_message_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -192,7 +311,7 @@ func (x *GetByChannelIDResponse) String() string {
 func (*GetByChannelIDResponse) ProtoMessage() {}
 
 func (x *GetByChannelIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[3]
+	mi := &file_message_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +324,7 @@ func (x *GetByChannelIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByChannelIDResponse.ProtoReflect.Descriptor instead.
 func (*GetByChannelIDResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{3}
+	return file_message_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetByChannelIDResponse) GetMessages() []*Message {
@@ -215,18 +334,38 @@ func (x *GetByChannelIDResponse) GetMessages() []*Message {
 	return nil
 }
 
+func (x *GetByChannelIDResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetByChannelIDResponse) GetNextBefore() string {
+	if x != nil && x.NextBefore != nil {
+		return *x.NextBefore
+	}
+	return ""
+}
+
+func (x *GetByChannelIDResponse) GetNextAfter() string {
+	if x != nil && x.NextAfter != nil {
+		return *x.NextAfter
+	}
+	return ""
+}
+
 type UpdateByMessageIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	ReplyId       *string                `protobuf:"bytes,3,opt,name=reply_id,json=replyId,proto3,oneof" json:"reply_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateByMessageIDRequest) Reset() {
 	*x = UpdateByMessageIDRequest{}
-	mi := &file_message_message_proto_msgTypes[4]
+	mi := &file_message_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +377,7 @@ func (x *UpdateByMessageIDRequest) String() string {
 func (*UpdateByMessageIDRequest) ProtoMessage() {}
 
 func (x *UpdateByMessageIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[4]
+	mi := &file_message_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +390,7 @@ func (x *UpdateByMessageIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateByMessageIDRequest.ProtoReflect.Descriptor instead.
 func (*UpdateByMessageIDRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{4}
+	return file_message_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateByMessageIDRequest) GetMessageId() string {
@@ -268,13 +407,6 @@ func (x *UpdateByMessageIDRequest) GetContent() string {
 	return ""
 }
 
-func (x *UpdateByMessageIDRequest) GetReplyId() string {
-	if x != nil && x.ReplyId != nil {
-		return *x.ReplyId
-	}
-	return ""
-}
-
 type UpdateByMessageIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -284,7 +416,7 @@ type UpdateByMessageIDResponse struct {
 
 func (x *UpdateByMessageIDResponse) Reset() {
 	*x = UpdateByMessageIDResponse{}
-	mi := &file_message_message_proto_msgTypes[5]
+	mi := &file_message_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +428,7 @@ func (x *UpdateByMessageIDResponse) String() string {
 func (*UpdateByMessageIDResponse) ProtoMessage() {}
 
 func (x *UpdateByMessageIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[5]
+	mi := &file_message_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +441,7 @@ func (x *UpdateByMessageIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateByMessageIDResponse.ProtoReflect.Descriptor instead.
 func (*UpdateByMessageIDResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{5}
+	return file_message_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateByMessageIDResponse) GetMessage() *Message {
@@ -328,7 +460,7 @@ type DeleteByMessageIDRequest struct {
 
 func (x *DeleteByMessageIDRequest) Reset() {
 	*x = DeleteByMessageIDRequest{}
-	mi := &file_message_message_proto_msgTypes[6]
+	mi := &file_message_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +472,7 @@ func (x *DeleteByMessageIDRequest) String() string {
 func (*DeleteByMessageIDRequest) ProtoMessage() {}
 
 func (x *DeleteByMessageIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[6]
+	mi := &file_message_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +485,7 @@ func (x *DeleteByMessageIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByMessageIDRequest.ProtoReflect.Descriptor instead.
 func (*DeleteByMessageIDRequest) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{6}
+	return file_message_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteByMessageIDRequest) GetMessageId() string {
@@ -372,7 +504,7 @@ type DeleteByMessageIDResponse struct {
 
 func (x *DeleteByMessageIDResponse) Reset() {
 	*x = DeleteByMessageIDResponse{}
-	mi := &file_message_message_proto_msgTypes[7]
+	mi := &file_message_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +516,7 @@ func (x *DeleteByMessageIDResponse) String() string {
 func (*DeleteByMessageIDResponse) ProtoMessage() {}
 
 func (x *DeleteByMessageIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_message_proto_msgTypes[7]
+	mi := &file_message_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +529,7 @@ func (x *DeleteByMessageIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteByMessageIDResponse.ProtoReflect.Descriptor instead.
 func (*DeleteByMessageIDResponse) Descriptor() ([]byte, []int) {
-	return file_message_message_proto_rawDescGZIP(), []int{7}
+	return file_message_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteByMessageIDResponse) GetEmpty() *emptypb.Empty {
@@ -407,52 +539,1311 @@ func (x *DeleteByMessageIDResponse) GetEmpty() *emptypb.Empty {
 	return nil
 }
 
-var File_message_message_proto protoreflect.FileDescriptor
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_message_message_proto_rawDesc = "" +
-	"\n" +
-	"\x15message_message.proto\x12\x03msg\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12message_type.proto\"\x93\x01\n" +
-	"\rCreateRequest\x12\x1d\n" +
-	"\n" +
-	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
-	"\breply_id\x18\x03 \x01(\tH\x00R\areplyId\x88\x01\x01:\x1c\x92A\x19\n" +
-	"\x17\xd2\x01\n" +
-	"channel_id\xd2\x01\acontentB\v\n" +
-	"\t_reply_id\"I\n" +
-	"\x0eCreateResponse\x12&\n" +
-	"\amessage\x18\x01 \x01(\v2\f.msg.MessageR\amessage:\x0f\x92A\f\n" +
-	"\n" +
-	"\xd2\x01\amessage\"J\n" +
-	"\x15GetByChannelIDRequest\x12\x1d\n" +
-	"\n" +
-	"channel_id\x18\x01 \x01(\tR\tchannelId:\x12\x92A\x0f\n" +
-	"\r\xd2\x01\n" +
-	"channel_id\"T\n" +
-	"\x16GetByChannelIDResponse\x12(\n" +
-	"\bmessages\x18\x01 \x03(\v2\f.msg.MessageR\bmessages:\x10\x92A\r\n" +
-	"\v\xd2\x01\bmessages\"\x9e\x01\n" +
-	"\x18UpdateByMessageIDRequest\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
-	"\breply_id\x18\x03 \x01(\tH\x00R\areplyId\x88\x01\x01:\x1c\x92A\x19\n" +
-	"\x17\xd2\x01\n" +
-	"message_id\xd2\x01\acontentB\v\n" +
-	"\t_reply_id\"T\n" +
-	"\x19UpdateByMessageIDResponse\x12&\n" +
-	"\amessage\x18\x01 \x01(\v2\f.msg.MessageR\amessage:\x0f\x92A\f\n" +
-	"\n" +
-	"\xd2\x01\amessage\"M\n" +
-	"\x18DeleteByMessageIDRequest\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\x01 \x01(\tR\tmessageId:\x12\x92A\x0f\n" +
-	"\r\xd2\x01\n" +
-	"message_id\"X\n" +
-	"\x19DeleteByMessageIDResponse\x12,\n" +
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_message_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_message_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddReactionResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_message_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_message_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveReactionResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ListReactorsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji     string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// ユーザーIDのカーソルで、これより後のユーザーを返す
+	After         *string `protobuf:"bytes,3,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	Limit         *int32  `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsRequest) Reset() {
+	*x = ListReactorsRequest{}
+	mi := &file_message_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsRequest) ProtoMessage() {}
+
+func (x *ListReactorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactorsRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReactorsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListReactorsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ユーザーIDの昇順で返す
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsResponse) Reset() {
+	*x = ListReactorsResponse{}
+	mi := &file_message_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsResponse) ProtoMessage() {}
+
+func (x *ListReactorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactorsResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListReactorsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_message_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_message_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PinMessageResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_message_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnpinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type UnpinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_message_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnpinMessageResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type ListPinnedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesRequest) Reset() {
+	*x = ListPinnedMessagesRequest{}
+	mi := &file_message_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *ListPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPinnedMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListPinnedMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pinned_atの降順で返す
+	Messages      []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesResponse) Reset() {
+	*x = ListPinnedMessagesResponse{}
+	mi := &file_message_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *ListPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPinnedMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SearchMessagesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// websearch_to_tsquery の構文 ("フレーズ", OR, -除外) が使える
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	AuthorId      *string                `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	ChannelId     *string                `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	HasAttachment *bool                  `protobuf:"varint,5,opt,name=has_attachment,json=hasAttachment,proto3,oneof" json:"has_attachment,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	After         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	// 前回のレスポンスのnext_cursor
+	Cursor        *string `protobuf:"bytes,8,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         *int32  `protobuf:"varint,9,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_message_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchMessagesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetAuthorId() string {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetChannelId() string {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetHasAttachment() bool {
+	if x != nil && x.HasAttachment != nil {
+		return *x.HasAttachment
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// created_atの降順で返す
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	HasMore       bool            `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    *string         `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_message_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchMessagesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SearchMessagesResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type AckChannelRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// このメッセージまでを既読にする
+	MessageId     string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckChannelRequest) Reset() {
+	*x = AckChannelRequest{}
+	mi := &file_message_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckChannelRequest) ProtoMessage() {}
+
+func (x *AckChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckChannelRequest.ProtoReflect.Descriptor instead.
+func (*AckChannelRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AckChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *AckChannelRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type AckChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Empty         *emptypb.Empty         `protobuf:"bytes,1,opt,name=empty,proto3" json:"empty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckChannelResponse) Reset() {
+	*x = AckChannelResponse{}
+	mi := &file_message_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckChannelResponse) ProtoMessage() {}
+
+func (x *AckChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckChannelResponse.ProtoReflect.Descriptor instead.
+func (*AckChannelResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AckChannelResponse) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+type GetUnreadCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelIds    []string               `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountsRequest) Reset() {
+	*x = GetUnreadCountsRequest{}
+	mi := &file_message_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUnreadCountsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUnreadCountsRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type GetUnreadCountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// channel_idsと同じ順で返す
+	Unreads       []*ChannelUnread `protobuf:"bytes,1,rep,name=unreads,proto3" json:"unreads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountsResponse) Reset() {
+	*x = GetUnreadCountsResponse{}
+	mi := &file_message_message_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetUnreadCountsResponse) GetUnreads() []*ChannelUnread {
+	if x != nil {
+		return x.Unreads
+	}
+	return nil
+}
+
+type DeleteUserMessagesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChannelIds []string               `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// この時刻以降に送信されたメッセージを削除する
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserMessagesRequest) Reset() {
+	*x = DeleteUserMessagesRequest{}
+	mi := &file_message_message_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMessagesRequest) ProtoMessage() {}
+
+func (x *DeleteUserMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteUserMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserMessagesRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *DeleteUserMessagesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type DeleteUserMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedCount  int32                  `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserMessagesResponse) Reset() {
+	*x = DeleteUserMessagesResponse{}
+	mi := &file_message_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMessagesResponse) ProtoMessage() {}
+
+func (x *DeleteUserMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteUserMessagesResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+type PurgeChannelMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelIds    []string               `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeChannelMessagesRequest) Reset() {
+	*x = PurgeChannelMessagesRequest{}
+	mi := &file_message_message_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeChannelMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChannelMessagesRequest) ProtoMessage() {}
+
+func (x *PurgeChannelMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChannelMessagesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeChannelMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PurgeChannelMessagesRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type PurgeChannelMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeChannelMessagesResponse) Reset() {
+	*x = PurgeChannelMessagesResponse{}
+	mi := &file_message_message_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeChannelMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChannelMessagesResponse) ProtoMessage() {}
+
+func (x *PurgeChannelMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_message_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChannelMessagesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeChannelMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_message_proto_rawDescGZIP(), []int{30}
+}
+
+var File_message_message_proto protoreflect.FileDescriptor
+
+const file_message_message_proto_rawDesc = "" +
+	"\n" +
+	"\x15message_message.proto\x12\x03msg\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12message_type.proto\"\xc2\x01\n" +
+	"\rCreateRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
+	"\breply_id\x18\x03 \x01(\tH\x00R\areplyId\x88\x01\x01\x127\n" +
+	"\vattachments\x18\x04 \x03(\v2\x15.msg.CreateAttachmentR\vattachments:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"channel_idB\v\n" +
+	"\t_reply_id\"\xb9\x01\n" +
+	"\x10CreateAttachment\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType:3\x92A0\n" +
+	".\xd2\x01\n" +
+	"object_key\xd2\x01\bfilename\xd2\x01\x04size\xd2\x01\fcontent_type\"I\n" +
+	"\x0eCreateResponse\x12&\n" +
+	"\amessage\x18\x01 \x01(\v2\f.msg.MessageR\amessage:\x0f\x92A\f\n" +
+	"\n" +
+	"\xd2\x01\amessage\"\xe4\x01\n" +
+	"\x15GetByChannelIDRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\x06before\x18\x02 \x01(\tH\x00R\x06before\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\x03 \x01(\tH\x01R\x05after\x88\x01\x01\x12\x1b\n" +
+	"\x06around\x18\x04 \x01(\tH\x02R\x06around\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x05 \x01(\x05H\x03R\x05limit\x88\x01\x01:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"channel_idB\t\n" +
+	"\a_beforeB\b\n" +
+	"\x06_afterB\t\n" +
+	"\a_aroundB\b\n" +
+	"\x06_limit\"\xe3\x01\n" +
+	"\x16GetByChannelIDResponse\x12(\n" +
+	"\bmessages\x18\x01 \x03(\v2\f.msg.MessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12$\n" +
+	"\vnext_before\x18\x03 \x01(\tH\x00R\n" +
+	"nextBefore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"next_after\x18\x04 \x01(\tH\x01R\tnextAfter\x88\x01\x01:\x1b\x92A\x18\n" +
+	"\x16\xd2\x01\bmessages\xd2\x01\bhas_moreB\x0e\n" +
+	"\f_next_beforeB\r\n" +
+	"\v_next_after\"\x81\x01\n" +
+	"\x18UpdateByMessageIDRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent:\x1c\x92A\x19\n" +
+	"\x17\xd2\x01\n" +
+	"message_id\xd2\x01\acontentJ\x04\b\x03\x10\x04R\breply_id\"T\n" +
+	"\x19UpdateByMessageIDResponse\x12&\n" +
+	"\amessage\x18\x01 \x01(\v2\f.msg.MessageR\amessage:\x0f\x92A\f\n" +
+	"\n" +
+	"\xd2\x01\amessage\"M\n" +
+	"\x18DeleteByMessageIDRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"message_id\"X\n" +
+	"\x19DeleteByMessageIDResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"e\n" +
+	"\x12AddReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\n" +
+	"message_id\xd2\x01\x05emoji\"R\n" +
+	"\x13AddReactionResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"h\n" +
+	"\x15RemoveReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\n" +
+	"message_id\xd2\x01\x05emoji\"U\n" +
+	"\x16RemoveReactionResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"\xb0\x01\n" +
+	"\x13ListReactorsRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x19\n" +
+	"\x05after\x18\x03 \x01(\tH\x00R\x05after\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x01R\x05limit\x88\x01\x01:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\n" +
+	"message_id\xd2\x01\x05emojiB\b\n" +
+	"\x06_afterB\b\n" +
+	"\x06_limit\"F\n" +
+	"\x14ListReactorsResponse\x12\x1f\n" +
+	"\x05users\x18\x01 \x03(\v2\t.msg.UserR\x05users:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05users\"F\n" +
+	"\x11PinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"message_id\"Q\n" +
+	"\x12PinMessageResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"H\n" +
+	"\x13UnpinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"message_id\"S\n" +
+	"\x14UnpinMessageResponse\x12,\n" +
+	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05empty\"N\n" +
+	"\x19ListPinnedMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"channel_id\"X\n" +
+	"\x1aListPinnedMessagesResponse\x12(\n" +
+	"\bmessages\x18\x01 \x03(\v2\f.msg.MessageR\bmessages:\x10\x92A\r\n" +
+	"\v\xd2\x01\bmessages\"\xd6\x03\n" +
+	"\x15SearchMessagesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12 \n" +
+	"\tauthor_id\x18\x03 \x01(\tH\x00R\bauthorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"channel_id\x18\x04 \x01(\tH\x01R\tchannelId\x88\x01\x01\x12*\n" +
+	"\x0ehas_attachment\x18\x05 \x01(\bH\x02R\rhasAttachment\x88\x01\x01\x127\n" +
+	"\x06before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x06before\x88\x01\x01\x125\n" +
+	"\x05after\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x05after\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\b \x01(\tH\x05R\x06cursor\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\t \x01(\x05H\x06R\x05limit\x88\x01\x01:\x18\x92A\x15\n" +
+	"\x13\xd2\x01\bguild_id\xd2\x01\x05queryB\f\n" +
+	"\n" +
+	"_author_idB\r\n" +
+	"\v_channel_idB\x11\n" +
+	"\x0f_has_attachmentB\t\n" +
+	"\a_beforeB\b\n" +
+	"\x06_afterB\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"\xb2\x01\n" +
+	"\x16SearchMessagesResponse\x12+\n" +
+	"\aresults\x18\x01 \x03(\v2\x11.msg.SearchResultR\aresults\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\aresults\xd2\x01\bhas_moreB\x0e\n" +
+	"\f_next_cursor\"r\n" +
+	"\x11AckChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId:\x1f\x92A\x1c\n" +
+	"\x1a\xd2\x01\n" +
+	"channel_id\xd2\x01\n" +
+	"message_id\"Q\n" +
+	"\x12AckChannelResponse\x12,\n" +
 	"\x05empty\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\x05empty:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05emptyB_\n" +
+	"\b\xd2\x01\x05empty\"R\n" +
+	"\x16GetUnreadCountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds\"G\n" +
+	"\x17GetUnreadCountsResponse\x12,\n" +
+	"\aunreads\x18\x01 \x03(\v2\x12.msg.ChannelUnreadR\aunreads\"\x87\x01\n" +
+	"\x19DeleteUserMessagesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"A\n" +
+	"\x1aDeleteUserMessagesResponse\x12#\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\">\n" +
+	"\x1bPurgeChannelMessagesRequest\x12\x1f\n" +
+	"\vchannel_ids\x18\x01 \x03(\tR\n" +
+	"channelIds\"\x1e\n" +
+	"\x1cPurgeChannelMessagesResponseB_\n" +
 	"\acom.msgB\x13MessageMessageProtoP\x01Z\x13./message;messagepb\xa2\x02\x03MXX\xaa\x02\x03Msg\xca\x02\x03Msg\xe2\x02\x0fMsg\\GPBMetadata\xea\x02\x03Msgb\x06proto3"
 
 var (
@@ -467,29 +1858,69 @@ func file_message_message_proto_rawDescGZIP() []byte {
 	return file_message_message_proto_rawDescData
 }
 
-var file_message_message_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_message_message_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_message_message_proto_goTypes = []any{
-	(*CreateRequest)(nil),             // 0: msg.CreateRequest
-	(*CreateResponse)(nil),            // 1: msg.CreateResponse
-	(*GetByChannelIDRequest)(nil),     // 2: msg.GetByChannelIDRequest
-	(*GetByChannelIDResponse)(nil),    // 3: msg.GetByChannelIDResponse
-	(*UpdateByMessageIDRequest)(nil),  // 4: msg.UpdateByMessageIDRequest
-	(*UpdateByMessageIDResponse)(nil), // 5: msg.UpdateByMessageIDResponse
-	(*DeleteByMessageIDRequest)(nil),  // 6: msg.DeleteByMessageIDRequest
-	(*DeleteByMessageIDResponse)(nil), // 7: msg.DeleteByMessageIDResponse
-	(*Message)(nil),                   // 8: msg.Message
-	(*emptypb.Empty)(nil),             // 9: google.protobuf.Empty
+	(*CreateRequest)(nil),                // 0: msg.CreateRequest
+	(*CreateAttachment)(nil),             // 1: msg.CreateAttachment
+	(*CreateResponse)(nil),               // 2: msg.CreateResponse
+	(*GetByChannelIDRequest)(nil),        // 3: msg.GetByChannelIDRequest
+	(*GetByChannelIDResponse)(nil),       // 4: msg.GetByChannelIDResponse
+	(*UpdateByMessageIDRequest)(nil),     // 5: msg.UpdateByMessageIDRequest
+	(*UpdateByMessageIDResponse)(nil),    // 6: msg.UpdateByMessageIDResponse
+	(*DeleteByMessageIDRequest)(nil),     // 7: msg.DeleteByMessageIDRequest
+	(*DeleteByMessageIDResponse)(nil),    // 8: msg.DeleteByMessageIDResponse
+	(*AddReactionRequest)(nil),           // 9: msg.AddReactionRequest
+	(*AddReactionResponse)(nil),          // 10: msg.AddReactionResponse
+	(*RemoveReactionRequest)(nil),        // 11: msg.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),       // 12: msg.RemoveReactionResponse
+	(*ListReactorsRequest)(nil),          // 13: msg.ListReactorsRequest
+	(*ListReactorsResponse)(nil),         // 14: msg.ListReactorsResponse
+	(*PinMessageRequest)(nil),            // 15: msg.PinMessageRequest
+	(*PinMessageResponse)(nil),           // 16: msg.PinMessageResponse
+	(*UnpinMessageRequest)(nil),          // 17: msg.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),         // 18: msg.UnpinMessageResponse
+	(*ListPinnedMessagesRequest)(nil),    // 19: msg.ListPinnedMessagesRequest
+	(*ListPinnedMessagesResponse)(nil),   // 20: msg.ListPinnedMessagesResponse
+	(*SearchMessagesRequest)(nil),        // 21: msg.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),       // 22: msg.SearchMessagesResponse
+	(*AckChannelRequest)(nil),            // 23: msg.AckChannelRequest
+	(*AckChannelResponse)(nil),           // 24: msg.AckChannelResponse
+	(*GetUnreadCountsRequest)(nil),       // 25: msg.GetUnreadCountsRequest
+	(*GetUnreadCountsResponse)(nil),      // 26: msg.GetUnreadCountsResponse
+	(*DeleteUserMessagesRequest)(nil),    // 27: msg.DeleteUserMessagesRequest
+	(*DeleteUserMessagesResponse)(nil),   // 28: msg.DeleteUserMessagesResponse
+	(*PurgeChannelMessagesRequest)(nil),  // 29: msg.PurgeChannelMessagesRequest
+	(*PurgeChannelMessagesResponse)(nil), // 30: msg.PurgeChannelMessagesResponse
+	(*Message)(nil),                      // 31: msg.Message
+	(*emptypb.Empty)(nil),                // 32: google.protobuf.Empty
+	(*User)(nil),                         // 33: msg.User
+	(*timestamppb.Timestamp)(nil),        // 34: google.protobuf.Timestamp
+	(*SearchResult)(nil),                 // 35: msg.SearchResult
+	(*ChannelUnread)(nil),                // 36: msg.ChannelUnread
 }
 var file_message_message_proto_depIdxs = []int32{
-	8, // 0: msg.CreateResponse.message:type_name -> msg.Message
-	8, // 1: msg.GetByChannelIDResponse.messages:type_name -> msg.Message
-	8, // 2: msg.UpdateByMessageIDResponse.message:type_name -> msg.Message
-	9, // 3: msg.DeleteByMessageIDResponse.empty:type_name -> google.protobuf.Empty
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: msg.CreateRequest.attachments:type_name -> msg.CreateAttachment
+	31, // 1: msg.CreateResponse.message:type_name -> msg.Message
+	31, // 2: msg.GetByChannelIDResponse.messages:type_name -> msg.Message
+	31, // 3: msg.UpdateByMessageIDResponse.message:type_name -> msg.Message
+	32, // 4: msg.DeleteByMessageIDResponse.empty:type_name -> google.protobuf.Empty
+	32, // 5: msg.AddReactionResponse.empty:type_name -> google.protobuf.Empty
+	32, // 6: msg.RemoveReactionResponse.empty:type_name -> google.protobuf.Empty
+	33, // 7: msg.ListReactorsResponse.users:type_name -> msg.User
+	32, // 8: msg.PinMessageResponse.empty:type_name -> google.protobuf.Empty
+	32, // 9: msg.UnpinMessageResponse.empty:type_name -> google.protobuf.Empty
+	31, // 10: msg.ListPinnedMessagesResponse.messages:type_name -> msg.Message
+	34, // 11: msg.SearchMessagesRequest.before:type_name -> google.protobuf.Timestamp
+	34, // 12: msg.SearchMessagesRequest.after:type_name -> google.protobuf.Timestamp
+	35, // 13: msg.SearchMessagesResponse.results:type_name -> msg.SearchResult
+	32, // 14: msg.AckChannelResponse.empty:type_name -> google.protobuf.Empty
+	36, // 15: msg.GetUnreadCountsResponse.unreads:type_name -> msg.ChannelUnread
+	34, // 16: msg.DeleteUserMessagesRequest.since:type_name -> google.protobuf.Timestamp
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_message_message_proto_init() }
@@ -499,14 +1930,18 @@ func file_message_message_proto_init() {
 	}
 	file_message_type_proto_init()
 	file_message_message_proto_msgTypes[0].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[3].OneofWrappers = []any{}
 	file_message_message_proto_msgTypes[4].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[13].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[21].OneofWrappers = []any{}
+	file_message_message_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_message_proto_rawDesc), len(file_message_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,7 @@ var File_message_service_proto protoreflect.FileDescriptor
 
 const file_message_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15message_service.proto\x12\x03msg\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x15message_message.proto2\xbe\x04\n" +
+	"\x15message_service.proto\x12\x03msg\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x15message_message.proto2\xd2\x0e\n" +
 	"\x0eMessageService\x12m\n" +
 	"\x06Create\x12\x12.msg.CreateRequest\x1a\x13.msg.CreateResponse\":\x92A\t\n" +
 	"\aMessage\x82\xd3\xe4\x93\x02(:\x01*\"#/api/channels/{channel_id}/messages\x12\x82\x01\n" +
@@ -35,34 +35,99 @@ const file_message_service_proto_rawDesc = "" +
 	"\x11UpdateByMessageID\x12\x1d.msg.UpdateByMessageIDRequest\x1a\x1e.msg.UpdateByMessageIDResponse\"1\x92A\t\n" +
 	"\aMessage\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/messages/{message_id}\x12\x82\x01\n" +
 	"\x11DeleteByMessageID\x12\x1d.msg.DeleteByMessageIDRequest\x1a\x1e.msg.DeleteByMessageIDResponse\".\x92A\t\n" +
-	"\aMessage\x82\xd3\xe4\x93\x02\x1c*\x1a/api/messages/{message_id}\x1a+\x92A(\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02\x1c*\x1a/api/messages/{message_id}\x12\x82\x01\n" +
+	"\vAddReaction\x12\x17.msg.AddReactionRequest\x1a\x18.msg.AddReactionResponse\"@\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02.\x1a,/api/messages/{message_id}/reactions/{emoji}\x12\x8b\x01\n" +
+	"\x0eRemoveReaction\x12\x1a.msg.RemoveReactionRequest\x1a\x1b.msg.RemoveReactionResponse\"@\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02.*,/api/messages/{message_id}/reactions/{emoji}\x12\x85\x01\n" +
+	"\fListReactors\x12\x18.msg.ListReactorsRequest\x1a\x19.msg.ListReactorsResponse\"@\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02.\x12,/api/messages/{message_id}/reactions/{emoji}\x12q\n" +
+	"\n" +
+	"PinMessage\x12\x16.msg.PinMessageRequest\x1a\x17.msg.PinMessageResponse\"2\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02 \x1a\x1e/api/messages/{message_id}/pin\x12w\n" +
+	"\fUnpinMessage\x12\x18.msg.UnpinMessageRequest\x1a\x19.msg.UnpinMessageResponse\"2\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02 *\x1e/api/messages/{message_id}/pin\x12\x8a\x01\n" +
+	"\x12ListPinnedMessages\x12\x1e.msg.ListPinnedMessagesRequest\x1a\x1f.msg.ListPinnedMessagesResponse\"3\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02!\x12\x1f/api/channels/{channel_id}/pins\x12\x85\x01\n" +
+	"\x0eSearchMessages\x12\x1a.msg.SearchMessagesRequest\x1a\x1b.msg.SearchMessagesResponse\":\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02(\x12&/api/guilds/{guild_id}/messages/search\x12t\n" +
+	"\n" +
+	"AckChannel\x12\x16.msg.AckChannelRequest\x1a\x17.msg.AckChannelResponse\"5\x92A\t\n" +
+	"\aMessage\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/channels/{channel_id}/ack\x12L\n" +
+	"\x0fGetUnreadCounts\x12\x1b.msg.GetUnreadCountsRequest\x1a\x1c.msg.GetUnreadCountsResponse\x12U\n" +
+	"\x12DeleteUserMessages\x12\x1e.msg.DeleteUserMessagesRequest\x1a\x1f.msg.DeleteUserMessagesResponse\x12[\n" +
+	"\x14PurgeChannelMessages\x12 .msg.PurgeChannelMessagesRequest\x1a!.msg.PurgeChannelMessagesResponse\x1a+\x92A(\n" +
 	"\aMessage\x12\x1dMessage management operationsB_\n" +
 	"\acom.msgB\x13MessageServiceProtoP\x01Z\x13./message;messagepb\xa2\x02\x03MXX\xaa\x02\x03Msg\xca\x02\x03Msg\xe2\x02\x0fMsg\\GPBMetadata\xea\x02\x03Msgb\x06proto3"
 
 var file_message_service_proto_goTypes = []any{
-	(*CreateRequest)(nil),             // 0: msg.CreateRequest
-	(*GetByChannelIDRequest)(nil),     // 1: msg.GetByChannelIDRequest
-	(*UpdateByMessageIDRequest)(nil),  // 2: msg.UpdateByMessageIDRequest
-	(*DeleteByMessageIDRequest)(nil),  // 3: msg.DeleteByMessageIDRequest
-	(*CreateResponse)(nil),            // 4: msg.CreateResponse
-	(*GetByChannelIDResponse)(nil),    // 5: msg.GetByChannelIDResponse
-	(*UpdateByMessageIDResponse)(nil), // 6: msg.UpdateByMessageIDResponse
-	(*DeleteByMessageIDResponse)(nil), // 7: msg.DeleteByMessageIDResponse
+	(*CreateRequest)(nil),                // 0: msg.CreateRequest
+	(*GetByChannelIDRequest)(nil),        // 1: msg.GetByChannelIDRequest
+	(*UpdateByMessageIDRequest)(nil),     // 2: msg.UpdateByMessageIDRequest
+	(*DeleteByMessageIDRequest)(nil),     // 3: msg.DeleteByMessageIDRequest
+	(*AddReactionRequest)(nil),           // 4: msg.AddReactionRequest
+	(*RemoveReactionRequest)(nil),        // 5: msg.RemoveReactionRequest
+	(*ListReactorsRequest)(nil),          // 6: msg.ListReactorsRequest
+	(*PinMessageRequest)(nil),            // 7: msg.PinMessageRequest
+	(*UnpinMessageRequest)(nil),          // 8: msg.UnpinMessageRequest
+	(*ListPinnedMessagesRequest)(nil),    // 9: msg.ListPinnedMessagesRequest
+	(*SearchMessagesRequest)(nil),        // 10: msg.SearchMessagesRequest
+	(*AckChannelRequest)(nil),            // 11: msg.AckChannelRequest
+	(*GetUnreadCountsRequest)(nil),       // 12: msg.GetUnreadCountsRequest
+	(*DeleteUserMessagesRequest)(nil),    // 13: msg.DeleteUserMessagesRequest
+	(*PurgeChannelMessagesRequest)(nil),  // 14: msg.PurgeChannelMessagesRequest
+	(*CreateResponse)(nil),               // 15: msg.CreateResponse
+	(*GetByChannelIDResponse)(nil),       // 16: msg.GetByChannelIDResponse
+	(*UpdateByMessageIDResponse)(nil),    // 17: msg.UpdateByMessageIDResponse
+	(*DeleteByMessageIDResponse)(nil),    // 18: msg.DeleteByMessageIDResponse
+	(*AddReactionResponse)(nil),          // 19: msg.AddReactionResponse
+	(*RemoveReactionResponse)(nil),       // 20: msg.RemoveReactionResponse
+	(*ListReactorsResponse)(nil),         // 21: msg.ListReactorsResponse
+	(*PinMessageResponse)(nil),           // 22: msg.PinMessageResponse
+	(*UnpinMessageResponse)(nil),         // 23: msg.UnpinMessageResponse
+	(*ListPinnedMessagesResponse)(nil),   // 24: msg.ListPinnedMessagesResponse
+	(*SearchMessagesResponse)(nil),       // 25: msg.SearchMessagesResponse
+	(*AckChannelResponse)(nil),           // 26: msg.AckChannelResponse
+	(*GetUnreadCountsResponse)(nil),      // 27: msg.GetUnreadCountsResponse
+	(*DeleteUserMessagesResponse)(nil),   // 28: msg.DeleteUserMessagesResponse
+	(*PurgeChannelMessagesResponse)(nil), // 29: msg.PurgeChannelMessagesResponse
 }
 var file_message_service_proto_depIdxs = []int32{
-	0, // 0: msg.MessageService.Create:input_type -> msg.CreateRequest
-	1, // 1: msg.MessageService.GetByChannelID:input_type -> msg.GetByChannelIDRequest
-	2, // 2: msg.MessageService.UpdateByMessageID:input_type -> msg.UpdateByMessageIDRequest
-	3, // 3: msg.MessageService.DeleteByMessageID:input_type -> msg.DeleteByMessageIDRequest
-	4, // 4: msg.MessageService.Create:output_type -> msg.CreateResponse
-	5, // 5: msg.MessageService.GetByChannelID:output_type -> msg.GetByChannelIDResponse
-	6, // 6: msg.MessageService.UpdateByMessageID:output_type -> msg.UpdateByMessageIDResponse
-	7, // 7: msg.MessageService.DeleteByMessageID:output_type -> msg.DeleteByMessageIDResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: msg.MessageService.Create:input_type -> msg.CreateRequest
+	1,  // 1: msg.MessageService.GetByChannelID:input_type -> msg.GetByChannelIDRequest
+	2,  // 2: msg.MessageService.UpdateByMessageID:input_type -> msg.UpdateByMessageIDRequest
+	3,  // 3: msg.MessageService.DeleteByMessageID:input_type -> msg.DeleteByMessageIDRequest
+	4,  // 4: msg.MessageService.AddReaction:input_type -> msg.AddReactionRequest
+	5,  // 5: msg.MessageService.RemoveReaction:input_type -> msg.RemoveReactionRequest
+	6,  // 6: msg.MessageService.ListReactors:input_type -> msg.ListReactorsRequest
+	7,  // 7: msg.MessageService.PinMessage:input_type -> msg.PinMessageRequest
+	8,  // 8: msg.MessageService.UnpinMessage:input_type -> msg.UnpinMessageRequest
+	9,  // 9: msg.MessageService.ListPinnedMessages:input_type -> msg.ListPinnedMessagesRequest
+	10, // 10: msg.MessageService.SearchMessages:input_type -> msg.SearchMessagesRequest
+	11, // 11: msg.MessageService.AckChannel:input_type -> msg.AckChannelRequest
+	12, // 12: msg.MessageService.GetUnreadCounts:input_type -> msg.GetUnreadCountsRequest
+	13, // 13: msg.MessageService.DeleteUserMessages:input_type -> msg.DeleteUserMessagesRequest
+	14, // 14: msg.MessageService.PurgeChannelMessages:input_type -> msg.PurgeChannelMessagesRequest
+	15, // 15: msg.MessageService.Create:output_type -> msg.CreateResponse
+	16, // 16: msg.MessageService.GetByChannelID:output_type -> msg.GetByChannelIDResponse
+	17, // 17: msg.MessageService.UpdateByMessageID:output_type -> msg.UpdateByMessageIDResponse
+	18, // 18: msg.MessageService.DeleteByMessageID:output_type -> msg.DeleteByMessageIDResponse
+	19, // 19: msg.MessageService.AddReaction:output_type -> msg.AddReactionResponse
+	20, // 20: msg.MessageService.RemoveReaction:output_type -> msg.RemoveReactionResponse
+	21, // 21: msg.MessageService.ListReactors:output_type -> msg.ListReactorsResponse
+	22, // 22: msg.MessageService.PinMessage:output_type -> msg.PinMessageResponse
+	23, // 23: msg.MessageService.UnpinMessage:output_type -> msg.UnpinMessageResponse
+	24, // 24: msg.MessageService.ListPinnedMessages:output_type -> msg.ListPinnedMessagesResponse
+	25, // 25: msg.MessageService.SearchMessages:output_type -> msg.SearchMessagesResponse
+	26, // 26: msg.MessageService.AckChannel:output_type -> msg.AckChannelResponse
+	27, // 27: msg.MessageService.GetUnreadCounts:output_type -> msg.GetUnreadCountsResponse
+	28, // 28: msg.MessageService.DeleteUserMessages:output_type -> msg.DeleteUserMessagesResponse
+	29, // 29: msg.MessageService.PurgeChannelMessages:output_type -> msg.PurgeChannelMessagesResponse
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_message_service_proto_init() }
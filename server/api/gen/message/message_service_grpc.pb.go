@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_Create_FullMethodName            = "/msg.MessageService/Create"
-	MessageService_GetByChannelID_FullMethodName    = "/msg.MessageService/GetByChannelID"
-	MessageService_UpdateByMessageID_FullMethodName = "/msg.MessageService/UpdateByMessageID"
-	MessageService_DeleteByMessageID_FullMethodName = "/msg.MessageService/DeleteByMessageID"
+	MessageService_Create_FullMethodName               = "/msg.MessageService/Create"
+	MessageService_GetByChannelID_FullMethodName       = "/msg.MessageService/GetByChannelID"
+	MessageService_UpdateByMessageID_FullMethodName    = "/msg.MessageService/UpdateByMessageID"
+	MessageService_DeleteByMessageID_FullMethodName    = "/msg.MessageService/DeleteByMessageID"
+	MessageService_AddReaction_FullMethodName          = "/msg.MessageService/AddReaction"
+	MessageService_RemoveReaction_FullMethodName       = "/msg.MessageService/RemoveReaction"
+	MessageService_ListReactors_FullMethodName         = "/msg.MessageService/ListReactors"
+	MessageService_PinMessage_FullMethodName           = "/msg.MessageService/PinMessage"
+	MessageService_UnpinMessage_FullMethodName         = "/msg.MessageService/UnpinMessage"
+	MessageService_ListPinnedMessages_FullMethodName   = "/msg.MessageService/ListPinnedMessages"
+	MessageService_SearchMessages_FullMethodName       = "/msg.MessageService/SearchMessages"
+	MessageService_AckChannel_FullMethodName           = "/msg.MessageService/AckChannel"
+	MessageService_GetUnreadCounts_FullMethodName      = "/msg.MessageService/GetUnreadCounts"
+	MessageService_DeleteUserMessages_FullMethodName   = "/msg.MessageService/DeleteUserMessages"
+	MessageService_PurgeChannelMessages_FullMethodName = "/msg.MessageService/PurgeChannelMessages"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -33,6 +44,19 @@ type MessageServiceClient interface {
 	GetByChannelID(ctx context.Context, in *GetByChannelIDRequest, opts ...grpc.CallOption) (*GetByChannelIDResponse, error)
 	UpdateByMessageID(ctx context.Context, in *UpdateByMessageIDRequest, opts ...grpc.CallOption) (*UpdateByMessageIDResponse, error)
 	DeleteByMessageID(ctx context.Context, in *DeleteByMessageIDRequest, opts ...grpc.CallOption) (*DeleteByMessageIDResponse, error)
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error)
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	AckChannel(ctx context.Context, in *AckChannelRequest, opts ...grpc.CallOption) (*AckChannelResponse, error)
+	// guild-serviceから呼ばれる内部用RPC
+	GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error)
+	DeleteUserMessages(ctx context.Context, in *DeleteUserMessagesRequest, opts ...grpc.CallOption) (*DeleteUserMessagesResponse, error)
+	// 削除されたチャンネルのメッセージを消し、完了してから返す。冪等なので再試行してよい
+	PurgeChannelMessages(ctx context.Context, in *PurgeChannelMessagesRequest, opts ...grpc.CallOption) (*PurgeChannelMessagesResponse, error)
 }
 
 type messageServiceClient struct {
@@ -83,6 +107,116 @@ func (c *messageServiceClient) DeleteByMessageID(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *messageServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, MessageService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, MessageService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactorsResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) AckChannel(ctx context.Context, in *AckChannelRequest, opts ...grpc.CallOption) (*AckChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckChannelResponse)
+	err := c.cc.Invoke(ctx, MessageService_AckChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadCountsResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetUnreadCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) DeleteUserMessages(ctx context.Context, in *DeleteUserMessagesRequest, opts ...grpc.CallOption) (*DeleteUserMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_DeleteUserMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) PurgeChannelMessages(ctx context.Context, in *PurgeChannelMessagesRequest, opts ...grpc.CallOption) (*PurgeChannelMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_PurgeChannelMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -91,6 +225,19 @@ type MessageServiceServer interface {
 	GetByChannelID(context.Context, *GetByChannelIDRequest) (*GetByChannelIDResponse, error)
 	UpdateByMessageID(context.Context, *UpdateByMessageIDRequest) (*UpdateByMessageIDResponse, error)
 	DeleteByMessageID(context.Context, *DeleteByMessageIDRequest) (*DeleteByMessageIDResponse, error)
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error)
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	AckChannel(context.Context, *AckChannelRequest) (*AckChannelResponse, error)
+	// guild-serviceから呼ばれる内部用RPC
+	GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error)
+	DeleteUserMessages(context.Context, *DeleteUserMessagesRequest) (*DeleteUserMessagesResponse, error)
+	// 削除されたチャンネルのメッセージを消し、完了してから返す。冪等なので再試行してよい
+	PurgeChannelMessages(context.Context, *PurgeChannelMessagesRequest) (*PurgeChannelMessagesResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -113,6 +260,39 @@ func (UnimplementedMessageServiceServer) UpdateByMessageID(context.Context, *Upd
 func (UnimplementedMessageServiceServer) DeleteByMessageID(context.Context, *DeleteByMessageIDRequest) (*DeleteByMessageIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteByMessageID not implemented")
 }
+func (UnimplementedMessageServiceServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedMessageServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedMessageServiceServer) ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedMessageServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) AckChannel(context.Context, *AckChannelRequest) (*AckChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckChannel not implemented")
+}
+func (UnimplementedMessageServiceServer) GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCounts not implemented")
+}
+func (UnimplementedMessageServiceServer) DeleteUserMessages(context.Context, *DeleteUserMessagesRequest) (*DeleteUserMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) PurgeChannelMessages(context.Context, *PurgeChannelMessagesRequest) (*PurgeChannelMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeChannelMessages not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +386,204 @@ func _MessageService_DeleteByMessageID_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListReactors(ctx, req.(*ListReactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_AckChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).AckChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_AckChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).AckChannel(ctx, req.(*AckChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetUnreadCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetUnreadCounts(ctx, req.(*GetUnreadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_DeleteUserMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).DeleteUserMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_DeleteUserMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).DeleteUserMessages(ctx, req.(*DeleteUserMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_PurgeChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PurgeChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_PurgeChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PurgeChannelMessages(ctx, req.(*PurgeChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +607,50 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteByMessageID",
 			Handler:    _MessageService_DeleteByMessageID_Handler,
 		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _MessageService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _MessageService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _MessageService_ListReactors_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _MessageService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _MessageService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _MessageService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _MessageService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "AckChannel",
+			Handler:    _MessageService_AckChannel_Handler,
+		},
+		{
+			MethodName: "GetUnreadCounts",
+			Handler:    _MessageService_GetUnreadCounts_Handler,
+		},
+		{
+			MethodName: "DeleteUserMessages",
+			Handler:    _MessageService_DeleteUserMessages_Handler,
+		},
+		{
+			MethodName: "PurgeChannelMessages",
+			Handler:    _MessageService_PurgeChannelMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message_service.proto",
@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MentionType int32
+
+const (
+	MentionType_MENTION_TYPE_UNSPECIFIED MentionType = 0
+	MentionType_MENTION_TYPE_USER        MentionType = 1
+	MentionType_MENTION_TYPE_CHANNEL     MentionType = 2
+)
+
+// Enum value maps for MentionType.
+var (
+	MentionType_name = map[int32]string{
+		0: "MENTION_TYPE_UNSPECIFIED",
+		1: "MENTION_TYPE_USER",
+		2: "MENTION_TYPE_CHANNEL",
+	}
+	MentionType_value = map[string]int32{
+		"MENTION_TYPE_UNSPECIFIED": 0,
+		"MENTION_TYPE_USER":        1,
+		"MENTION_TYPE_CHANNEL":     2,
+	}
+)
+
+func (x MentionType) Enum() *MentionType {
+	p := new(MentionType)
+	*p = x
+	return p
+}
+
+func (x MentionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MentionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_type_proto_enumTypes[0].Descriptor()
+}
+
+func (MentionType) Type() protoreflect.EnumType {
+	return &file_message_type_proto_enumTypes[0]
+}
+
+func (x MentionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MentionType.Descriptor instead.
+func (MentionType) EnumDescriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -100,14 +149,21 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 }
 
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	Sender        *User                  `protobuf:"bytes,3,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	ReplyId       *string                `protobuf:"bytes,5,opt,name=reply_id,json=replyId,proto3,oneof" json:"reply_id,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId          string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Sender            *User                  `protobuf:"bytes,3,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	ChannelId         string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ReplyId           *string                `protobuf:"bytes,5,opt,name=reply_id,json=replyId,proto3,oneof" json:"reply_id,omitempty"`
+	Content           string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3,oneof" json:"edited_at,omitempty"`
+	ReferencedMessage *ReferencedMessage     `protobuf:"bytes,9,opt,name=referenced_message,json=referencedMessage,proto3,oneof" json:"referenced_message,omitempty"`
+	Reactions         []*Reaction            `protobuf:"bytes,10,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// ピン留めされていない場合は空
+	PinnedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=pinned_at,json=pinnedAt,proto3,oneof" json:"pinned_at,omitempty"`
+	Mentions      []*Mention             `protobuf:"bytes,12,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,13,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -191,6 +247,439 @@ func (x *Message) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Message) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Message) GetReferencedMessage() *ReferencedMessage {
+	if x != nil {
+		return x.ReferencedMessage
+	}
+	return nil
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetPinnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return nil
+}
+
+func (x *Message) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Message) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// リプライ先のメッセージ。contentは省略されたものが入る
+type ReferencedMessage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId *string                `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3,oneof" json:"sender_id,omitempty"`
+	Sender   *User                  `protobuf:"bytes,3,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Content  string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// リプライ先が削除されている場合はtrueになり、sender_id, sender, contentは空になる
+	Deleted       bool `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferencedMessage) Reset() {
+	*x = ReferencedMessage{}
+	mi := &file_message_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferencedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencedMessage) ProtoMessage() {}
+
+func (x *ReferencedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencedMessage.ProtoReflect.Descriptor instead.
+func (*ReferencedMessage) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReferencedMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReferencedMessage) GetSenderId() string {
+	if x != nil && x.SenderId != nil {
+		return *x.SenderId
+	}
+	return ""
+}
+
+func (x *ReferencedMessage) GetSender() *User {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ReferencedMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReferencedMessage) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// 絵文字ごとに集計したリアクション
+type Reaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Emoji string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// リクエストしたユーザーがこの絵文字でリアクション済みかどうか
+	Me            bool `protobuf:"varint,3,opt,name=me,proto3" json:"me,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_message_type_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Reaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Reaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Reaction) GetMe() bool {
+	if x != nil {
+		return x.Me
+	}
+	return false
+}
+
+// contentに含まれる <@userID> / <#channelID> 形式のメンション
+type Mention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  MentionType            `protobuf:"varint,1,opt,name=type,proto3,enum=msg.MentionType" json:"type,omitempty"`
+	// typeに応じてユーザーIDまたはチャンネルIDが入る
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_message_type_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Mention) GetType() MentionType {
+	if x != nil {
+		return x.Type
+	}
+	return MentionType_MENTION_TYPE_UNSPECIFIED
+}
+
+func (x *Mention) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_message_type_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// HTMLエスケープ済みで、一致箇所は<mark>で囲まれている
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_message_type_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResult) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type ChannelUnread struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId         string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LastReadMessageId *string                `protobuf:"bytes,2,opt,name=last_read_message_id,json=lastReadMessageId,proto3,oneof" json:"last_read_message_id,omitempty"`
+	UnreadCount       int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount      int32                  `protobuf:"varint,4,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChannelUnread) Reset() {
+	*x = ChannelUnread{}
+	mi := &file_message_type_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUnread) ProtoMessage() {}
+
+func (x *ChannelUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_message_type_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUnread.ProtoReflect.Descriptor instead.
+func (*ChannelUnread) Descriptor() ([]byte, []int) {
+	return file_message_type_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChannelUnread) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelUnread) GetLastReadMessageId() string {
+	if x != nil && x.LastReadMessageId != nil {
+		return *x.LastReadMessageId
+	}
+	return ""
+}
+
+func (x *ChannelUnread) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ChannelUnread) GetMentionCount() int32 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
 var File_message_type_proto protoreflect.FileDescriptor
 
 const file_message_type_proto_rawDesc = "" +
@@ -206,7 +695,7 @@ const file_message_type_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:6\x92A3\n" +
 	"1\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\n" +
 	"display_id\xd2\x01\bicon_url\xd2\x01\n" +
-	"created_at\"\xc6\x02\n" +
+	"created_at\"\xcb\x05\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12&\n" +
@@ -216,12 +705,66 @@ const file_message_type_proto_rawDesc = "" +
 	"\breply_id\x18\x05 \x01(\tH\x01R\areplyId\x88\x01\x01\x12\x18\n" +
 	"\acontent\x18\x06 \x01(\tR\acontent\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt::\x92A7\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x02R\beditedAt\x88\x01\x01\x12J\n" +
+	"\x12referenced_message\x18\t \x01(\v2\x16.msg.ReferencedMessageH\x03R\x11referencedMessage\x88\x01\x01\x12+\n" +
+	"\treactions\x18\n" +
+	" \x03(\v2\r.msg.ReactionR\treactions\x12<\n" +
+	"\tpinned_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x04R\bpinnedAt\x88\x01\x01\x12(\n" +
+	"\bmentions\x18\f \x03(\v2\f.msg.MentionR\bmentions\x121\n" +
+	"\vattachments\x18\r \x03(\v2\x0f.msg.AttachmentR\vattachments::\x92A7\n" +
 	"5\xd2\x01\x02id\xd2\x01\tsender_id\xd2\x01\n" +
 	"channel_id\xd2\x01\acontent\xd2\x01\n" +
 	"created_atB\t\n" +
 	"\a_senderB\v\n" +
-	"\t_reply_idB\\\n" +
+	"\t_reply_idB\f\n" +
+	"\n" +
+	"_edited_atB\x15\n" +
+	"\x13_referenced_messageB\f\n" +
+	"\n" +
+	"_pinned_at\"\xda\x01\n" +
+	"\x11ReferencedMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\tsender_id\x18\x02 \x01(\tH\x00R\bsenderId\x88\x01\x01\x12&\n" +
+	"\x06sender\x18\x03 \x01(\v2\t.msg.UserH\x01R\x06sender\x88\x01\x01\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted:\x1e\x92A\x1b\n" +
+	"\x19\xd2\x01\x02id\xd2\x01\acontent\xd2\x01\adeletedB\f\n" +
+	"\n" +
+	"_sender_idB\t\n" +
+	"\a_sender\"b\n" +
+	"\bReaction\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x0e\n" +
+	"\x02me\x18\x03 \x01(\bR\x02me:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\x05emoji\xd2\x01\x05count\xd2\x01\x02me\"R\n" +
+	"\aMention\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.msg.MentionTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\x04type\xd2\x01\x02id\"\xb4\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url:1\x92A.\n" +
+	",\xd2\x01\x02id\xd2\x01\bfilename\xd2\x01\x04size\xd2\x01\fcontent_type\xd2\x01\x03url\"k\n" +
+	"\fSearchResult\x12&\n" +
+	"\amessage\x18\x01 \x01(\v2\f.msg.MessageR\amessage\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet:\x19\x92A\x16\n" +
+	"\x14\xd2\x01\amessage\xd2\x01\asnippet\"\xc5\x01\n" +
+	"\rChannelUnread\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x124\n" +
+	"\x14last_read_message_id\x18\x02 \x01(\tH\x00R\x11lastReadMessageId\x88\x01\x01\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x04 \x01(\x05R\fmentionCountB\x17\n" +
+	"\x15_last_read_message_id*\\\n" +
+	"\vMentionType\x12\x1c\n" +
+	"\x18MENTION_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MENTION_TYPE_USER\x10\x01\x12\x18\n" +
+	"\x14MENTION_TYPE_CHANNEL\x10\x02B\\\n" +
 	"\acom.msgB\x10MessageTypeProtoP\x01Z\x13./message;messagepb\xa2\x02\x03MXX\xaa\x02\x03Msg\xca\x02\x03Msg\xe2\x02\x0fMsg\\GPBMetadata\xea\x02\x03Msgb\x06proto3"
 
 var (
@@ -236,21 +779,38 @@ func file_message_type_proto_rawDescGZIP() []byte {
 	return file_message_type_proto_rawDescData
 }
 
-var file_message_type_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_message_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_message_type_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_message_type_proto_goTypes = []any{
-	(*User)(nil),                  // 0: msg.User
-	(*Message)(nil),               // 1: msg.Message
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(MentionType)(0),              // 0: msg.MentionType
+	(*User)(nil),                  // 1: msg.User
+	(*Message)(nil),               // 2: msg.Message
+	(*ReferencedMessage)(nil),     // 3: msg.ReferencedMessage
+	(*Reaction)(nil),              // 4: msg.Reaction
+	(*Mention)(nil),               // 5: msg.Mention
+	(*Attachment)(nil),            // 6: msg.Attachment
+	(*SearchResult)(nil),          // 7: msg.SearchResult
+	(*ChannelUnread)(nil),         // 8: msg.ChannelUnread
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_message_type_proto_depIdxs = []int32{
-	2, // 0: msg.User.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: msg.Message.sender:type_name -> msg.User
-	2, // 2: msg.Message.created_at:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: msg.User.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: msg.Message.sender:type_name -> msg.User
+	9,  // 2: msg.Message.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: msg.Message.edited_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: msg.Message.referenced_message:type_name -> msg.ReferencedMessage
+	4,  // 5: msg.Message.reactions:type_name -> msg.Reaction
+	9,  // 6: msg.Message.pinned_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: msg.Message.mentions:type_name -> msg.Mention
+	6,  // 8: msg.Message.attachments:type_name -> msg.Attachment
+	1,  // 9: msg.ReferencedMessage.sender:type_name -> msg.User
+	0,  // 10: msg.Mention.type:type_name -> msg.MentionType
+	2,  // 11: msg.SearchResult.message:type_name -> msg.Message
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_message_type_proto_init() }
@@ -259,18 +819,21 @@ func file_message_type_proto_init() {
 		return
 	}
 	file_message_type_proto_msgTypes[1].OneofWrappers = []any{}
+	file_message_type_proto_msgTypes[2].OneofWrappers = []any{}
+	file_message_type_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_type_proto_rawDesc), len(file_message_type_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_message_type_proto_goTypes,
 		DependencyIndexes: file_message_type_proto_depIdxs,
+		EnumInfos:         file_message_type_proto_enumTypes,
 		MessageInfos:      file_message_type_proto_msgTypes,
 	}.Build()
 	File_message_type_proto = out.File
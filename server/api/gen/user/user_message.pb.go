@@ -798,6 +798,103 @@ func (x *GetUsersByIDsResponse) GetUsers() []*User {
 	return nil
 }
 
+type VerifyPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordRequest) Reset() {
+	*x = VerifyPasswordRequest{}
+	mi := &file_user_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordRequest) ProtoMessage() {}
+
+func (x *VerifyPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyPasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type VerifyPasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ユーザーが存在しない場合もfalse
+	Valid         bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordResponse) Reset() {
+	*x = VerifyPasswordResponse{}
+	mi := &file_user_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordResponse) ProtoMessage() {}
+
+func (x *VerifyPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyPasswordResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
 var File_user_message_proto protoreflect.FileDescriptor
 
 const file_user_message_proto_rawDesc = "" +
@@ -866,7 +963,12 @@ const file_user_message_proto_rawDesc = "" +
 	"\buser_ids\x18\x01 \x03(\tR\auserIds\"9\n" +
 	"\x15GetUsersByIDsResponse\x12 \n" +
 	"\x05users\x18\x01 \x03(\v2\n" +
-	".user.UserR\x05usersB[\n" +
+	".user.UserR\x05users\"L\n" +
+	"\x15VerifyPasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\".\n" +
+	"\x16VerifyPasswordResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05validB[\n" +
 	"\bcom.userB\x10UserMessageProtoP\x01Z\r./user;userpb\xa2\x02\x03UXX\xaa\x02\x04User\xca\x02\x04User\xe2\x02\x10User\\GPBMetadata\xea\x02\x04Userb\x06proto3"
 
 var (
@@ -881,7 +983,7 @@ func file_user_message_proto_rawDescGZIP() []byte {
 	return file_user_message_proto_rawDescData
 }
 
-var file_user_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_user_message_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_user_message_proto_goTypes = []any{
 	(*RegisterRequest)(nil),        // 0: user.RegisterRequest
 	(*RegisterResponse)(nil),       // 1: user.RegisterResponse
@@ -899,14 +1001,16 @@ var file_user_message_proto_goTypes = []any{
 	(*ExistsResponse)(nil),         // 13: user.ExistsResponse
 	(*GetUsersByIDsRequest)(nil),   // 14: user.GetUsersByIDsRequest
 	(*GetUsersByIDsResponse)(nil),  // 15: user.GetUsersByIDsResponse
-	(*User)(nil),                   // 16: user.User
+	(*VerifyPasswordRequest)(nil),  // 16: user.VerifyPasswordRequest
+	(*VerifyPasswordResponse)(nil), // 17: user.VerifyPasswordResponse
+	(*User)(nil),                   // 18: user.User
 }
 var file_user_message_proto_depIdxs = []int32{
-	16, // 0: user.RegisterResponse.user:type_name -> user.User
-	16, // 1: user.GetCurrentUserResponse.user:type_name -> user.User
-	16, // 2: user.GetUserByIDResponse.user:type_name -> user.User
-	16, // 3: user.UpdateResponse.user:type_name -> user.User
-	16, // 4: user.GetUsersByIDsResponse.users:type_name -> user.User
+	18, // 0: user.RegisterResponse.user:type_name -> user.User
+	18, // 1: user.GetCurrentUserResponse.user:type_name -> user.User
+	18, // 2: user.GetUserByIDResponse.user:type_name -> user.User
+	18, // 3: user.UpdateResponse.user:type_name -> user.User
+	18, // 4: user.GetUsersByIDsResponse.users:type_name -> user.User
 	5,  // [5:5] is the sub-list for method output_type
 	5,  // [5:5] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
@@ -926,7 +1030,7 @@ func file_user_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_message_proto_rawDesc), len(file_user_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,7 @@ var File_user_service_proto protoreflect.FileDescriptor
 
 const file_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12user_service.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x12user_message.proto2\xeb\x05\n" +
+	"\x12user_service.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x12user_message.proto2\xb8\x06\n" +
 	"\vUserService\x12a\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\"&\x92A\x06\n" +
 	"\x04Auth\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/auth/register\x12U\n" +
@@ -41,7 +41,8 @@ const file_user_service_proto_rawDesc = "" +
 	"\x06Update\x12\x13.user.UpdateRequest\x1a\x14.user.UpdateResponse\"!\x92A\x06\n" +
 	"\x04User\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/api/users/me\x123\n" +
 	"\x06Exists\x12\x13.user.ExistsRequest\x1a\x14.user.ExistsResponse\x12H\n" +
-	"\rGetUsersByIDs\x12\x1a.user.GetUsersByIDsRequest\x1a\x1b.user.GetUsersByIDsResponse\x1a%\x92A\"\n" +
+	"\rGetUsersByIDs\x12\x1a.user.GetUsersByIDsRequest\x1a\x1b.user.GetUsersByIDsResponse\x12K\n" +
+	"\x0eVerifyPassword\x12\x1b.user.VerifyPasswordRequest\x1a\x1c.user.VerifyPasswordResponse\x1a%\x92A\"\n" +
 	"\x04User\x12\x1aUser management operationsB[\n" +
 	"\bcom.userB\x10UserServiceProtoP\x01Z\r./user;userpb\xa2\x02\x03UXX\xaa\x02\x04User\xca\x02\x04User\xe2\x02\x10User\\GPBMetadata\xea\x02\x04Userb\x06proto3"
 
@@ -54,14 +55,16 @@ var file_user_service_proto_goTypes = []any{
 	(*UpdateRequest)(nil),          // 5: user.UpdateRequest
 	(*ExistsRequest)(nil),          // 6: user.ExistsRequest
 	(*GetUsersByIDsRequest)(nil),   // 7: user.GetUsersByIDsRequest
-	(*RegisterResponse)(nil),       // 8: user.RegisterResponse
-	(*LoginResponse)(nil),          // 9: user.LoginResponse
-	(*AuthMeResponse)(nil),         // 10: user.AuthMeResponse
-	(*GetCurrentUserResponse)(nil), // 11: user.GetCurrentUserResponse
-	(*GetUserByIDResponse)(nil),    // 12: user.GetUserByIDResponse
-	(*UpdateResponse)(nil),         // 13: user.UpdateResponse
-	(*ExistsResponse)(nil),         // 14: user.ExistsResponse
-	(*GetUsersByIDsResponse)(nil),  // 15: user.GetUsersByIDsResponse
+	(*VerifyPasswordRequest)(nil),  // 8: user.VerifyPasswordRequest
+	(*RegisterResponse)(nil),       // 9: user.RegisterResponse
+	(*LoginResponse)(nil),          // 10: user.LoginResponse
+	(*AuthMeResponse)(nil),         // 11: user.AuthMeResponse
+	(*GetCurrentUserResponse)(nil), // 12: user.GetCurrentUserResponse
+	(*GetUserByIDResponse)(nil),    // 13: user.GetUserByIDResponse
+	(*UpdateResponse)(nil),         // 14: user.UpdateResponse
+	(*ExistsResponse)(nil),         // 15: user.ExistsResponse
+	(*GetUsersByIDsResponse)(nil),  // 16: user.GetUsersByIDsResponse
+	(*VerifyPasswordResponse)(nil), // 17: user.VerifyPasswordResponse
 }
 var file_user_service_proto_depIdxs = []int32{
 	0,  // 0: user.UserService.Register:input_type -> user.RegisterRequest
@@ -72,16 +75,18 @@ var file_user_service_proto_depIdxs = []int32{
 	5,  // 5: user.UserService.Update:input_type -> user.UpdateRequest
 	6,  // 6: user.UserService.Exists:input_type -> user.ExistsRequest
 	7,  // 7: user.UserService.GetUsersByIDs:input_type -> user.GetUsersByIDsRequest
-	8,  // 8: user.UserService.Register:output_type -> user.RegisterResponse
-	9,  // 9: user.UserService.Login:output_type -> user.LoginResponse
-	10, // 10: user.UserService.AuthMe:output_type -> user.AuthMeResponse
-	11, // 11: user.UserService.GetCurrentUser:output_type -> user.GetCurrentUserResponse
-	12, // 12: user.UserService.GetUserByID:output_type -> user.GetUserByIDResponse
-	13, // 13: user.UserService.Update:output_type -> user.UpdateResponse
-	14, // 14: user.UserService.Exists:output_type -> user.ExistsResponse
-	15, // 15: user.UserService.GetUsersByIDs:output_type -> user.GetUsersByIDsResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	8,  // 8: user.UserService.VerifyPassword:input_type -> user.VerifyPasswordRequest
+	9,  // 9: user.UserService.Register:output_type -> user.RegisterResponse
+	10, // 10: user.UserService.Login:output_type -> user.LoginResponse
+	11, // 11: user.UserService.AuthMe:output_type -> user.AuthMeResponse
+	12, // 12: user.UserService.GetCurrentUser:output_type -> user.GetCurrentUserResponse
+	13, // 13: user.UserService.GetUserByID:output_type -> user.GetUserByIDResponse
+	14, // 14: user.UserService.Update:output_type -> user.UpdateResponse
+	15, // 15: user.UserService.Exists:output_type -> user.ExistsResponse
+	16, // 16: user.UserService.GetUsersByIDs:output_type -> user.GetUsersByIDsResponse
+	17, // 17: user.UserService.VerifyPassword:output_type -> user.VerifyPasswordResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
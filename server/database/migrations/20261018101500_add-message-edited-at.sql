@@ -0,0 +1,2 @@
+-- Modify "messages" table
+ALTER TABLE "public"."messages" ADD COLUMN "edited_at" timestamp NULL;
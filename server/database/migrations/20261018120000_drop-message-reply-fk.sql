@@ -0,0 +1,2 @@
+-- Modify "messages" table
+ALTER TABLE "public"."messages" DROP CONSTRAINT "reply";
@@ -0,0 +1,10 @@
+-- Create "message_reactions" table
+CREATE TABLE "public"."message_reactions" (
+  "message_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "emoji" character varying(32) NOT NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("message_id", "emoji", "user_id"),
+  CONSTRAINT "message" FOREIGN KEY ("message_id") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
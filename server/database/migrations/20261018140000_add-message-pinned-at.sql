@@ -0,0 +1,4 @@
+-- Modify "messages" table
+ALTER TABLE "public"."messages" ADD COLUMN "pinned_at" timestamp NULL;
+-- Create index "idx_channel_pinned_at" to table: "messages"
+CREATE INDEX "idx_channel_pinned_at" ON "public"."messages" ("channel_id", "pinned_at") WHERE (pinned_at IS NOT NULL);
@@ -0,0 +1,10 @@
+-- Create "message_mentions" table
+CREATE TABLE "public"."message_mentions" (
+  "message_id" uuid NOT NULL,
+  "type" character varying(16) NOT NULL,
+  "target_id" uuid NOT NULL,
+  PRIMARY KEY ("message_id", "type", "target_id"),
+  CONSTRAINT "message" FOREIGN KEY ("message_id") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_mention_target" to table: "message_mentions"
+CREATE INDEX "idx_mention_target" ON "public"."message_mentions" ("target_id", "type");
@@ -0,0 +1,18 @@
+-- Create "message_attachments" table
+CREATE TABLE "public"."message_attachments" (
+  "id" uuid NOT NULL,
+  "message_id" uuid NOT NULL,
+  "object_key" character varying(512) NOT NULL,
+  "filename" character varying(255) NOT NULL,
+  "size" bigint NOT NULL,
+  "content_type" character varying(127) NOT NULL,
+  "url" character varying(1024) NOT NULL,
+  "position" integer NOT NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "message" FOREIGN KEY ("message_id") REFERENCES "public"."messages" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_attachment_message_id" to table: "message_attachments"
+CREATE INDEX "idx_attachment_message_id" ON "public"."message_attachments" ("message_id", "position");
+-- Create index "idx_attachment_object_key" to table: "message_attachments"
+CREATE UNIQUE INDEX "idx_attachment_object_key" ON "public"."message_attachments" ("object_key");
@@ -0,0 +1,4 @@
+-- Modify "messages" table
+ALTER TABLE "public"."messages" ADD COLUMN "content_tsv" tsvector NOT NULL GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, content)) STORED;
+-- Create index "idx_messages_content_tsv" to table: "messages"
+CREATE INDEX "idx_messages_content_tsv" ON "public"."messages" USING gin ("content_tsv");
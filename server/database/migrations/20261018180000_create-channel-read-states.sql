@@ -0,0 +1,11 @@
+-- Create "channel_read_states" table
+CREATE TABLE "public"."channel_read_states" (
+  "user_id" uuid NOT NULL,
+  "channel_id" uuid NOT NULL,
+  "last_read_message_id" uuid NOT NULL,
+  "last_read_at" timestamp NOT NULL,
+  "updated_at" timestamp NOT NULL,
+  PRIMARY KEY ("user_id", "channel_id"),
+  CONSTRAINT "channel" FOREIGN KEY ("channel_id") REFERENCES "public"."channels" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,29 @@
+-- Create "roles" table
+CREATE TABLE "public"."roles" (
+  "id" uuid NOT NULL,
+  "guild_id" uuid NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "permissions" bigint NOT NULL,
+  "position" integer NOT NULL,
+  "created_at" timestamp NOT NULL,
+  "updated_at" timestamp NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "guild" FOREIGN KEY ("guild_id") REFERENCES "public"."guilds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_roles_guild_id" to table: "roles"
+CREATE INDEX "idx_roles_guild_id" ON "public"."roles" ("guild_id");
+-- Create "member_roles" table
+CREATE TABLE "public"."member_roles" (
+  "guild_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("guild_id", "user_id", "role_id"),
+  CONSTRAINT "member" FOREIGN KEY ("guild_id", "user_id") REFERENCES "public"."members" ("guild_id", "user_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "role" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_member_roles_role_id" to table: "member_roles"
+CREATE INDEX "idx_member_roles_role_id" ON "public"."member_roles" ("role_id");
+-- Backfill "@everyone" roles for existing guilds (VIEW_CHANNEL | SEND_MESSAGES | ADD_REACTIONS)
+INSERT INTO "public"."roles" ("id", "guild_id", "name", "permissions", "position", "created_at", "updated_at")
+SELECT "id", "id", '@everyone', 7, 0, NOW(), NOW() FROM "public"."guilds";
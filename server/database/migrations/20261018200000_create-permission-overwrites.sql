@@ -0,0 +1,27 @@
+-- Create "permission_overwrites" table
+CREATE TABLE "public"."permission_overwrites" (
+  "id" uuid NOT NULL,
+  "guild_id" uuid NOT NULL,
+  "channel_id" uuid NULL,
+  "category_id" uuid NULL,
+  "role_id" uuid NULL,
+  "user_id" uuid NULL,
+  "allow" bigint NOT NULL,
+  "deny" bigint NOT NULL,
+  "created_at" timestamp NOT NULL,
+  "updated_at" timestamp NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "channel" FOREIGN KEY ("channel_id") REFERENCES "public"."channels" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "guild" FOREIGN KEY ("guild_id") REFERENCES "public"."guilds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "member" FOREIGN KEY ("guild_id", "user_id") REFERENCES "public"."members" ("guild_id", "user_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "role" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "scope" CHECK ((channel_id IS NULL) <> (category_id IS NULL)),
+  CONSTRAINT "target" CHECK ((role_id IS NULL) <> (user_id IS NULL))
+);
+-- Create index "idx_permission_overwrites_guild_id" to table: "permission_overwrites"
+CREATE INDEX "idx_permission_overwrites_guild_id" ON "public"."permission_overwrites" ("guild_id");
+-- Create index "idx_permission_overwrites_scope_target" to table: "permission_overwrites"
+CREATE UNIQUE INDEX "idx_permission_overwrites_scope_target" ON "public"."permission_overwrites" ((COALESCE(channel_id, category_id)), (COALESCE(role_id, user_id)));
+-- Grant ATTACH_FILES to existing "@everyone" roles
+UPDATE "public"."roles" SET "permissions" = "permissions" | 4096 WHERE "id" = "guild_id";
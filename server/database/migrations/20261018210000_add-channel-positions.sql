@@ -0,0 +1,11 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "position" integer NOT NULL DEFAULT 0;
+-- Modify "channels" table
+ALTER TABLE "public"."channels" ADD COLUMN "position" integer NOT NULL DEFAULT 0;
+-- Backfill positions in creation order
+UPDATE "public"."categories" AS c SET "position" = o."position"
+FROM (SELECT "id", ROW_NUMBER() OVER (PARTITION BY "guild_id" ORDER BY "created_at", "id") - 1 AS "position" FROM "public"."categories") AS o
+WHERE c."id" = o."id";
+UPDATE "public"."channels" AS ch SET "position" = o."position"
+FROM (SELECT "id", ROW_NUMBER() OVER (PARTITION BY "category_id" ORDER BY "created_at", "id") - 1 AS "position" FROM "public"."channels") AS o
+WHERE ch."id" = o."id";
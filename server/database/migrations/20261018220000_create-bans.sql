@@ -0,0 +1,13 @@
+-- Create "bans" table
+CREATE TABLE "public"."bans" (
+  "guild_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "moderator_id" uuid NOT NULL,
+  "reason" character varying(512) NOT NULL,
+  "expires_at" timestamp NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("guild_id", "user_id"),
+  CONSTRAINT "guild" FOREIGN KEY ("guild_id") REFERENCES "public"."guilds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "moderator" FOREIGN KEY ("moderator_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Modify "members" table
+ALTER TABLE "public"."members" ADD COLUMN "communication_disabled_until" timestamp NULL;
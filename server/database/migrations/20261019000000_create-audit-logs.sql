@@ -0,0 +1,16 @@
+-- Create "audit_logs" table
+CREATE TABLE "public"."audit_logs" (
+  "id" uuid NOT NULL,
+  "guild_id" uuid NOT NULL,
+  "actor_id" uuid NOT NULL,
+  "action_type" character varying(64) NOT NULL,
+  "target_id" uuid NULL,
+  "changes" jsonb NOT NULL,
+  "reason" character varying(512) NOT NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "actor" FOREIGN KEY ("actor_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "guild" FOREIGN KEY ("guild_id") REFERENCES "public"."guilds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_audit_logs_guild_created_at" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_guild_created_at" ON "public"."audit_logs" ("guild_id", "created_at", "id");
@@ -0,0 +1,17 @@
+-- Modify "invites" table
+ALTER TABLE "public"."invites" ADD COLUMN "vanity" boolean NOT NULL DEFAULT false;
+-- Create index "idx_invites_vanity_guild_id" to table: "invites"
+CREATE UNIQUE INDEX "idx_invites_vanity_guild_id" ON "public"."invites" ("guild_id") WHERE vanity;
+-- Create "invite_uses" table
+CREATE TABLE "public"."invite_uses" (
+  "id" uuid NOT NULL,
+  "guild_id" uuid NOT NULL,
+  "invite_code" character varying(16) NOT NULL,
+  "user_id" uuid NOT NULL,
+  "used_at" timestamp NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "guild" FOREIGN KEY ("guild_id") REFERENCES "public"."guilds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_invite_uses_guild_code_used_at" to table: "invite_uses"
+CREATE INDEX "idx_invite_uses_guild_code_used_at" ON "public"."invite_uses" ("guild_id", "invite_code", "used_at");
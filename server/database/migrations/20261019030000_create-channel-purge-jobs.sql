@@ -0,0 +1,13 @@
+-- Create "channel_purge_jobs" table
+CREATE TABLE "public"."channel_purge_jobs" (
+  "id" uuid NOT NULL,
+  "channel_id" uuid NOT NULL,
+  "target" character varying(16) NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "next_run_at" timestamp NOT NULL,
+  "last_error" text NULL,
+  "created_at" timestamp NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_channel_purge_jobs_next_run_at" to table: "channel_purge_jobs"
+CREATE INDEX "idx_channel_purge_jobs_next_run_at" ON "public"."channel_purge_jobs" ("next_run_at");
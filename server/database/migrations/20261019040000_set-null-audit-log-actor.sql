@@ -0,0 +1,2 @@
+-- Modify "audit_logs" table
+ALTER TABLE "public"."audit_logs" DROP CONSTRAINT "actor", ALTER COLUMN "actor_id" DROP NOT NULL, ADD CONSTRAINT "actor" FOREIGN KEY ("actor_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
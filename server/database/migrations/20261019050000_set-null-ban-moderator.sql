@@ -0,0 +1,2 @@
+-- Modify "bans" table
+ALTER TABLE "public"."bans" DROP CONSTRAINT "moderator", ALTER COLUMN "moderator_id" DROP NOT NULL, ADD CONSTRAINT "moderator" FOREIGN KEY ("moderator_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Modify "message_mentions" table
+ALTER TABLE "public"."message_mentions" ADD COLUMN "position" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "guilds" table
+ALTER TABLE "public"."guilds" DROP CONSTRAINT "owner", ALTER COLUMN "owner_id" DROP NOT NULL, ADD CONSTRAINT "owner" FOREIGN KEY ("owner_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -79,6 +79,22 @@ table "messages" {
     null = false
     type = timestamp
   }
+  column "edited_at" {
+    null = true
+    type = timestamp
+  }
+  column "pinned_at" {
+    null = true
+    type = timestamp
+  }
+  column "content_tsv" {
+    null = false
+    type = tsvector
+    as {
+      expr = "to_tsvector('simple'::regconfig, content)"
+      type = STORED
+    }
+  }
   primary_key {
     columns = [column.id]
   }
@@ -87,18 +103,173 @@ table "messages" {
     ref_columns = [table.users.column.id]
     on_delete = NO_ACTION
   }
+  index "idx_channel_created_at" {
+    columns = [column.channel_id, column.created_at]
+  }
+  index "idx_channel_pinned_at" {
+    columns = [column.channel_id, column.pinned_at]
+    where   = "(pinned_at IS NOT NULL)"
+  }
+  index "idx_messages_content_tsv" {
+    columns = [column.content_tsv]
+    type    = GIN
+  }
+}
+
+table "message_reactions" {
+  schema = schema.public
+  column "message_id" {
+    null = false
+    type = uuid
+  }
+  column "user_id" {
+    null = false
+    type = uuid
+  }
+  column "emoji" {
+    null = false
+    type = varchar(32)
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.message_id, column.emoji, column.user_id]
+  }
+  foreign_key "message" {
+    columns = [column.message_id]
+    ref_columns = [table.messages.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "user" {
+    columns = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete = NO_ACTION
+  }
+}
+
+table "message_mentions" {
+  schema = schema.public
+  column "message_id" {
+    null = false
+    type = uuid
+  }
+  column "type" {
+    null = false
+    type = varchar(16)
+  }
+  column "target_id" {
+    null = false
+    type = uuid
+  }
+  column "position" {
+    null = false
+    type = int
+    default = 0
+  }
+  primary_key {
+    columns = [column.message_id, column.type, column.target_id]
+  }
+  foreign_key "message" {
+    columns = [column.message_id]
+    ref_columns = [table.messages.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_mention_target" {
+    columns = [column.target_id, column.type]
+  }
+}
+
+table "message_attachments" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "message_id" {
+    null = false
+    type = uuid
+  }
+  column "object_key" {
+    null = false
+    type = varchar(512)
+  }
+  column "filename" {
+    null = false
+    type = varchar(255)
+  }
+  column "size" {
+    null = false
+    type = bigint
+  }
+  column "content_type" {
+    null = false
+    type = varchar(127)
+  }
+  column "url" {
+    null = false
+    type = varchar(1024)
+  }
+  column "position" {
+    null = false
+    type = int
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "message" {
+    columns = [column.message_id]
+    ref_columns = [table.messages.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_attachment_message_id" {
+    columns = [column.message_id, column.position]
+  }
+  index "idx_attachment_object_key" {
+    unique  = true
+    columns = [column.object_key]
+  }
+}
+
+table "channel_read_states" {
+  schema = schema.public
+  column "user_id" {
+    null = false
+    type = uuid
+  }
+  column "channel_id" {
+    null = false
+    type = uuid
+  }
+  column "last_read_message_id" {
+    null = false
+    type = uuid
+  }
+  column "last_read_at" {
+    null = false
+    type = timestamp
+  }
+  column "updated_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.user_id, column.channel_id]
+  }
   foreign_key "channel" {
     columns = [column.channel_id]
     ref_columns = [table.channels.column.id]
     on_delete = CASCADE
   }
-  foreign_key "reply" {
-    columns = [column.reply_id]
-    ref_columns = [table.messages.column.id]
-    on_delete = SET_NULL
-  }
-  index "idx_channel_created_at" {
-    columns = [column.channel_id, column.created_at]
+  foreign_key "user" {
+    columns = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete = CASCADE
   }
 }
 
@@ -113,7 +284,7 @@ table "guilds" {
     type = varchar(100)
   }
   column "owner_id" {
-    null = false
+    null = true
     type = uuid
   }
   column "description" {
@@ -142,7 +313,7 @@ table "guilds" {
   foreign_key "owner" {
     columns = [column.owner_id]
     ref_columns = [table.users.column.id]
-    on_delete = RESTRICT
+    on_delete = SET_NULL
   }
 }
 
@@ -164,6 +335,10 @@ table "members" {
     null = false
     type = timestamp
   }
+  column "communication_disabled_until" {
+    null = true
+    type = timestamp
+  }
   primary_key {
     columns = [column.guild_id, column.user_id]
   }
@@ -204,6 +379,11 @@ table "categories" {
     null = false
     type = timestamp
   }
+  column "position" {
+    null = false
+    type = int
+    default = 0
+  }
   primary_key {
     columns = [column.id]
   }
@@ -239,6 +419,11 @@ table "channels" {
     null = false
     type = timestamp
   }
+  column "position" {
+    null = false
+    type = int
+    default = 0
+  }
   primary_key {
     columns = [column.id]
   }
@@ -279,6 +464,11 @@ table "invites" {
     null = true
     type = timestamp
   }
+  column "vanity" {
+    null = false
+    type = boolean
+    default = false
+  }
   column "created_at" {
     null = false
     type = timestamp
@@ -299,4 +489,353 @@ table "invites" {
   index "idx_guild_id" {
     columns = [column.guild_id]
   }
+  index "idx_invites_vanity_guild_id" {
+    unique  = true
+    columns = [column.guild_id]
+    where   = "vanity"
+  }
+}
+table "invite_uses" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "invite_code" {
+    null = false
+    type = varchar(16)
+  }
+  column "user_id" {
+    null = false
+    type = uuid
+  }
+  column "used_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "guild" {
+    columns = [column.guild_id]
+    ref_columns = [table.guilds.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "user" {
+    columns = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_invite_uses_guild_code_used_at" {
+    columns = [column.guild_id, column.invite_code, column.used_at]
+  }
+}
+
+table "roles" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "name" {
+    null = false
+    type = varchar(100)
+  }
+  column "permissions" {
+    null = false
+    type = bigint
+  }
+  column "position" {
+    null = false
+    type = integer
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  column "updated_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "guild" {
+    columns = [column.guild_id]
+    ref_columns = [table.guilds.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_roles_guild_id" {
+    columns = [column.guild_id]
+  }
+}
+
+table "member_roles" {
+  schema = schema.public
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "user_id" {
+    null = false
+    type = uuid
+  }
+  column "role_id" {
+    null = false
+    type = uuid
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.guild_id, column.user_id, column.role_id]
+  }
+  foreign_key "member" {
+    columns = [column.guild_id, column.user_id]
+    ref_columns = [table.members.column.guild_id, table.members.column.user_id]
+    on_delete = CASCADE
+  }
+  foreign_key "role" {
+    columns = [column.role_id]
+    ref_columns = [table.roles.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_member_roles_role_id" {
+    columns = [column.role_id]
+  }
+}
+
+table "permission_overwrites" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "channel_id" {
+    null = true
+    type = uuid
+  }
+  column "category_id" {
+    null = true
+    type = uuid
+  }
+  column "role_id" {
+    null = true
+    type = uuid
+  }
+  column "user_id" {
+    null = true
+    type = uuid
+  }
+  column "allow" {
+    null = false
+    type = bigint
+  }
+  column "deny" {
+    null = false
+    type = bigint
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  column "updated_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "category" {
+    columns = [column.category_id]
+    ref_columns = [table.categories.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "channel" {
+    columns = [column.channel_id]
+    ref_columns = [table.channels.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "guild" {
+    columns = [column.guild_id]
+    ref_columns = [table.guilds.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "member" {
+    columns = [column.guild_id, column.user_id]
+    ref_columns = [table.members.column.guild_id, table.members.column.user_id]
+    on_delete = CASCADE
+  }
+  foreign_key "role" {
+    columns = [column.role_id]
+    ref_columns = [table.roles.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_permission_overwrites_guild_id" {
+    columns = [column.guild_id]
+  }
+  index "idx_permission_overwrites_scope_target" {
+    unique = true
+    on {
+      expr = "COALESCE(channel_id, category_id)"
+    }
+    on {
+      expr = "COALESCE(role_id, user_id)"
+    }
+  }
+  check "scope" {
+    expr = "((channel_id IS NULL) <> (category_id IS NULL))"
+  }
+  check "target" {
+    expr = "((role_id IS NULL) <> (user_id IS NULL))"
+  }
+}
+
+table "bans" {
+  schema = schema.public
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "user_id" {
+    null = false
+    type = uuid
+  }
+  column "moderator_id" {
+    null = true
+    type = uuid
+  }
+  column "reason" {
+    null = false
+    type = varchar(512)
+  }
+  column "expires_at" {
+    null = true
+    type = timestamp
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.guild_id, column.user_id]
+  }
+  foreign_key "guild" {
+    columns = [column.guild_id]
+    ref_columns = [table.guilds.column.id]
+    on_delete = CASCADE
+  }
+  foreign_key "moderator" {
+    columns = [column.moderator_id]
+    ref_columns = [table.users.column.id]
+    on_delete = SET_NULL
+  }
+  foreign_key "user" {
+    columns = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete = CASCADE
+  }
+}
+
+table "audit_logs" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "guild_id" {
+    null = false
+    type = uuid
+  }
+  column "actor_id" {
+    null = true
+    type = uuid
+  }
+  column "action_type" {
+    null = false
+    type = varchar(64)
+  }
+  column "target_id" {
+    null = true
+    type = uuid
+  }
+  column "changes" {
+    null = false
+    type = jsonb
+  }
+  column "reason" {
+    null = false
+    type = varchar(512)
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "actor" {
+    columns = [column.actor_id]
+    ref_columns = [table.users.column.id]
+    on_delete = SET_NULL
+  }
+  foreign_key "guild" {
+    columns = [column.guild_id]
+    ref_columns = [table.guilds.column.id]
+    on_delete = CASCADE
+  }
+  index "idx_audit_logs_guild_created_at" {
+    columns = [column.guild_id, column.created_at, column.id]
+  }
+}
+table "channel_purge_jobs" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "channel_id" {
+    null = false
+    type = uuid
+  }
+  column "target" {
+    null = false
+    type = varchar(16)
+  }
+  column "attempts" {
+    null = false
+    type = int
+    default = 0
+  }
+  column "next_run_at" {
+    null = false
+    type = timestamp
+  }
+  column "last_error" {
+    null = true
+    type = text
+  }
+  column "created_at" {
+    null = false
+    type = timestamp
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "idx_channel_purge_jobs_next_run_at" {
+    columns = [column.next_run_at]
+  }
 }
@@ -87,6 +87,7 @@ func main() {
 
 	grpcGatewayMux := runtime.NewServeMux(
 		runtime.WithErrorHandler(utils.CustomErrorHandler),
+		runtime.WithIncomingHeaderMatcher(utils.CustomHeaderMatcher),
 	)
 
 	opts := []grpc.DialOption{
@@ -125,7 +126,7 @@ func main() {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins: []string{"*"},
 		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type"},
+		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "X-Audit-Log-Reason"},
 		ExposedHeaders: []string{"Link"},
 	}))
 	r.Use(mdw.JWTAuthorizer(tokenAuth, mdw.Config{
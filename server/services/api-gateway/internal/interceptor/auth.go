@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 	"fmt"
+	sharedmd "shared/metadata"
 
 	"github.com/go-chi/jwtauth/v5"
 	"google.golang.org/grpc"
@@ -30,6 +31,13 @@ func JWTToMetadata() grpc.UnaryClientInterceptor {
 			pairs = append(pairs, "iat", fmt.Sprintf("%d", int64(iat)))
 		}
 
+		// 送信用のメタデータを置き換えるので、ヘッダーから転送されたもののうち必要なものだけを引き継ぐ
+		if md, ok := metadata.FromOutgoingContext(ctx); ok {
+			if reasons := md.Get(sharedmd.AuditLogReasonKey); len(reasons) > 0 {
+				pairs = append(pairs, sharedmd.AuditLogReasonKey, reasons[0])
+			}
+		}
+
 		if len(pairs) > 0 {
 			md := metadata.Pairs(pairs...)
 			ctx = metadata.NewOutgoingContext(ctx, md)
@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"net/http"
+	"shared/metadata"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// 監査ログの理由をGrpc-Metadata-の接頭辞なしで受け取れるようにする
+func CustomHeaderMatcher(key string) (string, bool) {
+	if http.CanonicalHeaderKey(key) == "X-Audit-Log-Reason" {
+		return metadata.AuditLogReasonKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -3,10 +3,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"guild-service/internal/domain"
 	"guild-service/internal/handler"
 	user "guild-service/internal/infrastructure/grpc"
 	"guild-service/internal/infrastructure/postgres"
+	rds "guild-service/internal/infrastructure/redis"
+	"guild-service/internal/interceptor"
 	"guild-service/internal/usecase"
+	"guild-service/internal/worker"
 	"net"
 	"net/http"
 	"os"
@@ -24,6 +28,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
 	_ "net/http/pprof"
 
@@ -125,25 +130,75 @@ func main() {
 		}
 	}()
 
+	messageServiceURL := os.Getenv("MESSAGE_SERVICE_URL")
+	messageConn, err := grpc.NewClient(messageServiceURL, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to connect to message service", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := messageConn.Close(); err != nil {
+			log.Error("Failed to close message service connection", "error", err)
+		}
+	}()
+
+	mediaServiceURL := os.Getenv("MEDIA_SERVICE_URL")
+	mediaConn, err := grpc.NewClient(mediaServiceURL, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to connect to media service", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := mediaConn.Close(); err != nil {
+			log.Error("Failed to close media service connection", "error", err)
+		}
+	}()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: os.Getenv("REDIS_ADDR"),
+	})
+	defer func() {
+		if err := redisClient.Close(); err != nil {
+			log.Error("Failed to close redis connection", "error", err)
+		}
+	}()
+
 	userClient := user.NewUserServiceClient(userConn)
+	messageClient := user.NewMessageServiceClient(messageConn)
+	mediaClient := user.NewMediaServiceClient(mediaConn)
+	presenceClient := rds.NewPresenceClient(redisClient)
+	publisher := rds.NewRedisPublisher(redisClient)
 	store := postgres.NewPostgresStore(db)
+	permissionResolver := domain.NewPermissionResolver(store)
 
-	guildUsecase := usecase.NewGuildUsecase(store, userClient, validate)
-	categoryUsecase := usecase.NewCategoryUsecase(store, validate)
-	channelUsecase := usecase.NewChannelUsecase(store, validate)
-	inviteUsecase := usecase.NewInviteUsecase(store, userClient, validate)
+	guildUsecase := usecase.NewGuildUsecase(store, permissionResolver, userClient, messageClient, presenceClient, publisher, validate)
+	categoryUsecase := usecase.NewCategoryUsecase(store, permissionResolver, publisher, validate)
+	channelUsecase := usecase.NewChannelUsecase(store, permissionResolver, publisher, validate)
+	inviteUsecase := usecase.NewInviteUsecase(store, permissionResolver, userClient, publisher, validate)
+	memberUsecase := usecase.NewMemberUsecase(store, permissionResolver, publisher, validate)
+	roleUsecase := usecase.NewRoleUsecase(store, permissionResolver, publisher, validate)
+	overwriteUsecase := usecase.NewPermissionOverwriteUsecase(store, permissionResolver, publisher, validate)
+	banUsecase := usecase.NewBanUsecase(store, permissionResolver, userClient, messageClient, publisher, validate)
+	auditLogUsecase := usecase.NewAuditLogUsecase(store, permissionResolver, validate)
+	channelPurgeUsecase := usecase.NewChannelPurgeUsecase(store, messageClient, mediaClient)
 
 	guildHandler := handler.NewGuildServiceHandler(&handler.NewGuildServiceHandlerParams{
-		GuildHandler:    handler.NewGuildHandler(guildUsecase, log),
-		CategoryHandler: handler.NewCategoryHandler(categoryUsecase, log),
-		ChannelHandler:  handler.NewChannelHandler(channelUsecase, log),
-		InviteHandler:   handler.NewInviteHandler(inviteUsecase, log),
+		GuildHandler:     handler.NewGuildHandler(guildUsecase, log),
+		CategoryHandler:  handler.NewCategoryHandler(categoryUsecase, log),
+		ChannelHandler:   handler.NewChannelHandler(channelUsecase, log),
+		InviteHandler:    handler.NewInviteHandler(inviteUsecase, log),
+		MemberHandler:    handler.NewMemberHandler(memberUsecase, log),
+		RoleHandler:      handler.NewRoleHandler(roleUsecase, log),
+		OverwriteHandler: handler.NewPermissionOverwriteHandler(overwriteUsecase, log),
+		BanHandler:       handler.NewBanHandler(banUsecase, log),
+		AuditLogHandler:  handler.NewAuditLogHandler(auditLogUsecase, log),
 	})
 
 	grpcSrv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
 			srvMetrics.UnaryServerInterceptor(),
+			interceptor.AuditLogReason(),
 		),
 	)
 	srvMetrics.InitializeMetrics(grpcSrv)
@@ -177,6 +232,24 @@ func main() {
 		}
 	})
 
+	sweeperCtx, cancelSweeper := context.WithCancel(context.Background())
+	inviteSweeper := worker.NewInviteSweeper(inviteUsecase, log)
+	g.Add(func() error {
+		log.Info("starting invite sweeper")
+		return inviteSweeper.Run(sweeperCtx)
+	}, func(error) {
+		cancelSweeper()
+	})
+
+	purgerCtx, cancelPurger := context.WithCancel(context.Background())
+	channelPurger := worker.NewChannelPurger(channelPurgeUsecase, log)
+	g.Add(func() error {
+		log.Info("starting channel purger")
+		return channelPurger.Run(purgerCtx)
+	}, func(error) {
+		cancelPurger()
+	})
+
 	g.Add(run.SignalHandler(context.Background(), syscall.SIGINT, syscall.SIGTERM))
 
 	if err := g.Run(); err != nil {
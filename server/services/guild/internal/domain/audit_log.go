@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 監査ログに記録する操作の種類。値はDBに保存するので変えてはいけない
+type AuditLogAction string
+
+const (
+	AuditLogGuildUpdate      AuditLogAction = "GUILD_UPDATE"
+	AuditLogGuildOwnerUpdate AuditLogAction = "GUILD_OWNER_UPDATE"
+
+	AuditLogCategoryCreate AuditLogAction = "CATEGORY_CREATE"
+	AuditLogCategoryUpdate AuditLogAction = "CATEGORY_UPDATE"
+	AuditLogCategoryDelete AuditLogAction = "CATEGORY_DELETE"
+
+	AuditLogChannelCreate   AuditLogAction = "CHANNEL_CREATE"
+	AuditLogChannelUpdate   AuditLogAction = "CHANNEL_UPDATE"
+	AuditLogChannelDelete   AuditLogAction = "CHANNEL_DELETE"
+	AuditLogChannelsReorder AuditLogAction = "CHANNELS_REORDER"
+
+	AuditLogOverwriteUpdate AuditLogAction = "PERMISSION_OVERWRITE_UPDATE"
+	AuditLogOverwriteDelete AuditLogAction = "PERMISSION_OVERWRITE_DELETE"
+
+	AuditLogInviteCreate AuditLogAction = "INVITE_CREATE"
+	AuditLogInviteDelete AuditLogAction = "INVITE_DELETE"
+
+	AuditLogMemberKick       AuditLogAction = "MEMBER_KICK"
+	AuditLogMemberTimeout    AuditLogAction = "MEMBER_TIMEOUT"
+	AuditLogMemberBan        AuditLogAction = "MEMBER_BAN_ADD"
+	AuditLogMemberUnban      AuditLogAction = "MEMBER_BAN_REMOVE"
+	AuditLogMemberRoleAdd    AuditLogAction = "MEMBER_ROLE_ADD"
+	AuditLogMemberRoleRemove AuditLogAction = "MEMBER_ROLE_REMOVE"
+
+	AuditLogRoleCreate   AuditLogAction = "ROLE_CREATE"
+	AuditLogRoleUpdate   AuditLogAction = "ROLE_UPDATE"
+	AuditLogRoleDelete   AuditLogAction = "ROLE_DELETE"
+	AuditLogRolesReorder AuditLogAction = "ROLES_REORDER"
+)
+
+var auditLogActions = map[AuditLogAction]bool{
+	AuditLogGuildUpdate:      true,
+	AuditLogGuildOwnerUpdate: true,
+	AuditLogCategoryCreate:   true,
+	AuditLogCategoryUpdate:   true,
+	AuditLogCategoryDelete:   true,
+	AuditLogChannelCreate:    true,
+	AuditLogChannelUpdate:    true,
+	AuditLogChannelDelete:    true,
+	AuditLogChannelsReorder:  true,
+	AuditLogOverwriteUpdate:  true,
+	AuditLogOverwriteDelete:  true,
+	AuditLogInviteCreate:     true,
+	AuditLogInviteDelete:     true,
+	AuditLogMemberKick:       true,
+	AuditLogMemberTimeout:    true,
+	AuditLogMemberBan:        true,
+	AuditLogMemberUnban:      true,
+	AuditLogMemberRoleAdd:    true,
+	AuditLogMemberRoleRemove: true,
+	AuditLogRoleCreate:       true,
+	AuditLogRoleUpdate:       true,
+	AuditLogRoleDelete:       true,
+	AuditLogRolesReorder:     true,
+}
+
+func (a AuditLogAction) IsValid() bool {
+	return auditLogActions[a]
+}
+
+const MaxAuditLogReasonLength = 512
+
+// 変更されたフィールドの前後の値をJSONで持つ。作成時のBeforeと削除時のAfterはnil
+type AuditLogChange struct {
+	Key    string          `json:"key"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// 追記のみで、更新や削除はしない
+type AuditLog struct {
+	ID      uuid.UUID
+	GuildID uuid.UUID
+	// 操作したユーザーが削除された場合はnil
+	ActorID *uuid.UUID
+	Action  AuditLogAction
+	// 操作の対象のID。対象がない操作ではnil
+	TargetID  *uuid.UUID
+	Changes   []*AuditLogChange
+	Reason    string
+	CreatedAt time.Time
+}
+
+// reasonはリクエストのX-Audit-Log-Reasonヘッダーから取り出す
+func NewAuditLog(ctx context.Context, guildID, actorID uuid.UUID, action AuditLogAction, targetID *uuid.UUID) *AuditLog {
+	return &AuditLog{
+		ID:        uuid.New(),
+		GuildID:   guildID,
+		ActorID:   &actorID,
+		Action:    action,
+		TargetID:  targetID,
+		Changes:   []*AuditLogChange{},
+		Reason:    AuditLogReason(ctx),
+		CreatedAt: time.Now(),
+	}
+}
+
+// beforeとafterが異なる場合だけ変更として記録する。作成時はbefore、削除時はafterにnilを渡す
+func (l *AuditLog) Change(key string, before, after any) *AuditLog {
+	b := marshalAuditLogValue(before)
+	a := marshalAuditLogValue(after)
+	if bytes.Equal(b, a) {
+		return l
+	}
+	l.Changes = append(l.Changes, &AuditLogChange{
+		Key:    key,
+		Before: b,
+		After:  a,
+	})
+	return l
+}
+
+// nilとJSONにできない値は記録しない
+func marshalAuditLogValue(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil || string(data) == "null" {
+		return nil
+	}
+	return data
+}
+
+// nilのフィールドは絞り込みに使わない
+type AuditLogQuery struct {
+	GuildID  uuid.UUID
+	ActorID  *uuid.UUID
+	Action   *AuditLogAction
+	TargetID *uuid.UUID
+	// この記録より古いものを返す
+	Cursor *AuditLog
+	Limit  int32
+}
+
+type IAuditLogRepository interface {
+	Create(ctx context.Context, log *AuditLog) error
+	GetByID(ctx context.Context, id uuid.UUID) (*AuditLog, error)
+	// 新しい順に返す
+	List(ctx context.Context, query *AuditLogQuery) ([]*AuditLog, error)
+}
+
+type auditLogReasonKey struct{}
+
+// MaxAuditLogReasonLength文字を超える部分は切り捨てる
+func WithAuditLogReason(ctx context.Context, reason string) context.Context {
+	if runes := []rune(reason); len(runes) > MaxAuditLogReasonLength {
+		reason = string(runes[:MaxAuditLogReasonLength])
+	}
+	return context.WithValue(ctx, auditLogReasonKey{}, reason)
+}
+
+func AuditLogReason(ctx context.Context) string {
+	reason, _ := ctx.Value(auditLogReasonKey{}).(string)
+	return reason
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BAN時に削除できるメッセージの最大期間
+const MaxBanDeleteMessageDuration = 7 * 24 * time.Hour
+
+type Ban struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+	User    *User
+	// BANしたユーザーが削除された場合はnil
+	ModeratorID *uuid.UUID
+	Reason      string
+	// nilの場合は無期限
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+type IBanRepository interface {
+	// 既にBANされている場合は内容を上書きする
+	Upsert(ctx context.Context, ban *Ban) (*Ban, error)
+	// 期限切れのBANは含めない
+	GetActiveByGuildID(ctx context.Context, guildID uuid.UUID) ([]*Ban, error)
+	IsBanned(ctx context.Context, guildID, userID uuid.UUID) (bool, error)
+	Delete(ctx context.Context, guildID, userID uuid.UUID) error
+}
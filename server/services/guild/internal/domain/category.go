@@ -12,19 +12,34 @@ const (
 )
 
 type Category struct {
-	ID        uuid.UUID
-	GuildID   uuid.UUID
-	Name      string
+	ID      uuid.UUID
+	GuildID uuid.UUID
+	Name    string
+	// ギルド内での並び順。小さいほど上
+	Position  int32
 	CreatedAt time.Time
 }
 
+// サイドバーでの、1つのカテゴリーとその中のチャンネルの並び
+type CategoryLayout struct {
+	CategoryID uuid.UUID
+	ChannelIDs []uuid.UUID
+}
+
 type CategoryOverview struct {
 	*Category
-	Channels []*Channel
+	Channels []*ChannelOverview
 }
 
 type ICategoryRepository interface {
 	Create(ctx context.Context, category *Category) (*Category, error)
 	GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*Category, error)
 	GetGuildIDByCategoryID(ctx context.Context, categoryID uuid.UUID) (uuid.UUID, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Category, error)
+	Update(ctx context.Context, category *Category) (*Category, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ギルド内でexcludeID以外の最も古いカテゴリを返す。無ければErrCategoryNotFound
+	GetOldestExcept(ctx context.Context, guildID, excludeID uuid.UUID) (*Category, error)
+	// categoryIDsの順に0から位置を振り直す
+	UpdatePositions(ctx context.Context, guildID uuid.UUID, categoryIDs []uuid.UUID) error
 }
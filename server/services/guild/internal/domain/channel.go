@@ -13,11 +13,34 @@ type Channel struct {
 	ID         uuid.UUID
 	CategoryID uuid.UUID
 	Name       string
-	CreatedAt  time.Time
+	// カテゴリー内での並び順。小さいほど上
+	Position  int32
+	CreatedAt time.Time
+}
+
+// リクエストしたユーザーから見た未読状態を含むチャンネル
+type ChannelOverview struct {
+	*Channel
+	Unread *ChannelUnread
 }
 
 type IChannelRepository interface {
 	Create(ctx context.Context, channel *Channel) (*Channel, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Channel, error)
+	GetGuildIDByChannelID(ctx context.Context, channelID uuid.UUID) (uuid.UUID, error)
+	Update(ctx context.Context, channel *Channel) (*Channel, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// fromCategoryIDのチャンネルをすべてtoCategoryIDに移し、移したチャンネルを返す
+	MoveToCategory(ctx context.Context, fromCategoryID, toCategoryID uuid.UUID) ([]*Channel, error)
 	GetByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*Channel, error)
-	CheckChannelMember(ctx context.Context, userID, channelID uuid.UUID) (bool, error)
+	// カテゴリーの順、その中ではチャンネルの順に返す
+	GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*Channel, error)
+	// guildIDのチャンネルのうちchannelIDsをcategoryIDに移し、その順に0から位置を振り直す
+	UpdatePositions(ctx context.Context, guildID, categoryID uuid.UUID, channelIDs []uuid.UUID) error
+	// channelIDsのうち存在するものを、属するギルドのIDごとにまとめて返す
+	GroupByGuildID(ctx context.Context, channelIDs []uuid.UUID) (map[uuid.UUID][]*Channel, error)
+	// channelIDと同じギルドのメンバーだけを返す
+	FilterGuildMembers(ctx context.Context, channelID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error)
+	// channelIDと同じギルドのチャンネルだけを返す
+	FilterSameGuildChannels(ctx context.Context, channelID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error)
 }
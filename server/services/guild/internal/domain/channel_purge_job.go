@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 削除されたチャンネルについて、別のサービスに消してもらうデータの種類
+type ChannelPurgeTarget string
+
+const (
+	ChannelPurgeTargetMessages    ChannelPurgeTarget = "messages"
+	ChannelPurgeTargetAttachments ChannelPurgeTarget = "attachments"
+)
+
+// チャンネルを削除したトランザクションの中で書き込み、コミット後にワーカーが成功するまで再試行する
+type ChannelPurgeJob struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	Target    ChannelPurgeTarget
+	// 取り出された回数。失敗時の待ち時間の計算に使う
+	Attempts  int32
+	NextRunAt time.Time
+	LastError *string
+	CreatedAt time.Time
+}
+
+// チャンネルごとにメッセージと添付ファイルのジョブを作る
+func NewChannelPurgeJobs(channelIDs []uuid.UUID, now time.Time) []*ChannelPurgeJob {
+	jobs := make([]*ChannelPurgeJob, 0, len(channelIDs)*2)
+	for _, channelID := range channelIDs {
+		for _, target := range []ChannelPurgeTarget{ChannelPurgeTargetMessages, ChannelPurgeTargetAttachments} {
+			jobs = append(jobs, &ChannelPurgeJob{
+				ID:        uuid.New(),
+				ChannelID: channelID,
+				Target:    target,
+				NextRunAt: now,
+				CreatedAt: now,
+			})
+		}
+	}
+	return jobs
+}
+
+type IChannelPurgeJobRepository interface {
+	CreateMany(ctx context.Context, jobs []*ChannelPurgeJob) error
+	// 実行時刻を過ぎたジョブを最大limit件取り出し、leaseUntilまでほかのワーカーから見えなくする
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]*ChannelPurgeJob, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Reschedule(ctx context.Context, id uuid.UUID, nextRunAt time.Time, lastError string) error
+}
@@ -9,6 +9,12 @@ var (
 	ErrCategoryNotFound = errors.New("category not found")
 	ErrChannelNotFound  = errors.New("channel not found")
 	ErrMemberNotFound   = errors.New("member not found")
+	ErrInviteNotFound   = errors.New("invite not found")
+	ErrRoleNotFound     = errors.New("role not found")
+	ErrBanNotFound      = errors.New("ban not found")
+	ErrAuditLogNotFound = errors.New("audit log not found")
+
+	ErrPermissionOverwriteNotFound = errors.New("permission overwrite not found")
 
 	// Conflict
 	ErrInvalidGuildData    = errors.New("invalid guild data")
@@ -16,6 +22,8 @@ var (
 	ErrInvalidChannelData  = errors.New("invalid channel data")
 	ErrMemberAlreadyExists = errors.New("member already exists")
 
+	ErrInviteCodeAlreadyExists = errors.New("invite code already exists")
+
 	// Bad Request
 	ErrInvalidCredential = errors.New("invalid credential")
 	ErrInvalidArgument   = errors.New("invalid argument")
@@ -25,9 +33,31 @@ var (
 	ErrInvalidMemberID   = errors.New("invalid member ID")
 	ErrInvalidInviteData = errors.New("invalid invite data")
 	ErrInvalidInviteCode = errors.New("invalid invite code")
+	ErrInvalidRoleData   = errors.New("invalid role data")
+	ErrInvalidRoleID     = errors.New("invalid role ID")
+	ErrInvalidBanData    = errors.New("invalid ban data")
+
+	ErrInvalidPermissionOverwriteData = errors.New("invalid permission overwrite data")
+	ErrInvalidPermissionOverwriteID   = errors.New("invalid permission overwrite target ID")
+	ErrInvalidAuditLogQuery           = errors.New("invalid audit log query")
 
 	// 403
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrUserBanned       = errors.New("user is banned from the guild")
+
+	// Failed Precondition
+	ErrOwnerCannotLeave          = errors.New("guild owner cannot leave the guild")
+	ErrCannotRemoveOwner         = errors.New("guild owner cannot be removed")
+	ErrCannotBanOwner            = errors.New("guild owner cannot be banned")
+	ErrCannotTimeoutMember       = errors.New("guild owner and administrators cannot be timed out")
+	ErrDefaultChannelUndeletable = errors.New("default channel cannot be deleted")
+	ErrLastCategoryUndeletable   = errors.New("last category cannot be deleted")
+	ErrCategoryHasOverwrites     = errors.New("category with permission overwrites cannot be deleted")
+	ErrEveryoneRoleImmutable     = errors.New("@everyone role cannot be renamed, deleted or assigned")
+
+	ErrCannotTransferToSelf = errors.New("guild ownership cannot be transferred to the current owner")
+
+	ErrGuildAlreadyHasVanityCode = errors.New("guild already has a vanity invite code")
 
 	// Internal Server Error
 	ErrInternalServerError = errors.New("internal server error")
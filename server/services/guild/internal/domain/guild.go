@@ -8,8 +8,9 @@ import (
 )
 
 type Guild struct {
-	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	ID uuid.UUID
+	// オーナーが削除された場合はnil。以降そのギルドにオーナーはいない
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconURL          string
@@ -18,9 +19,15 @@ type Guild struct {
 	CreatedAt        time.Time
 }
 
+func (g *Guild) IsOwnedBy(userID uuid.UUID) bool {
+	return g.OwnerID != nil && *g.OwnerID == userID
+}
+
 type GuildOverview struct {
 	*Guild
 	Categories []*CategoryOverview
+	// message-serviceから未読数を取得できなかった理由。nilでない場合、未読数はすべて0になっている
+	UnreadErr error
 }
 
 type IGuildRepository interface {
@@ -28,5 +35,9 @@ type IGuildRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*Guild, error)
 	GetMyGuilds(ctx context.Context, userID uuid.UUID) ([]*Guild, error)
 	Update(ctx context.Context, guild *Guild) (*Guild, error)
-	IsOwner(ctx context.Context, guildID uuid.UUID, userID uuid.UUID) (bool, error)
+	UpdateOwner(ctx context.Context, id, ownerID uuid.UUID) (*Guild, error)
+	// トランザクションの中で呼び、コミットまでギルドの行をロックする
+	LockByID(ctx context.Context, id uuid.UUID) error
+	// カテゴリー、チャンネル、メンバー、招待などはON DELETE CASCADEで一緒に消える
+	Delete(ctx context.Context, id uuid.UUID) error
 }
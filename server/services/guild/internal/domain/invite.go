@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +14,10 @@ import (
 const (
 	INVITE_CODE_LENGTH = 8
 	CHARSET            = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	VANITY_INVITE_CODE_MIN_LENGTH = 3
+	VANITY_INVITE_CODE_MAX_LENGTH = 16
+	VANITY_CHARSET                = "abcdefghijklmnopqrstuvwxyz0123456789-"
 )
 
 type Invite struct {
@@ -24,7 +29,19 @@ type Invite struct {
 	MaxUses     *int32
 	CurrentUses int32
 	ExpiresAt   *time.Time
-	CreatedAt   time.Time
+	// バニティURLはギルドに1つだけで、MaxUsesとExpiresAtを持たない
+	Vanity    bool
+	CreatedAt time.Time
+}
+
+// 招待コードでギルドに参加した記録。招待コードが削除されても残す
+type InviteUse struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	InviteCode string
+	UserID     uuid.UUID
+	User       *User
+	UsedAt     time.Time
 }
 
 type IInviteRepository interface {
@@ -32,9 +49,23 @@ type IInviteRepository interface {
 	GetByGuildID(cxt context.Context, guildID uuid.UUID) ([]*Invite, error)
 	GetByInviteCode(cxt context.Context, inviteCode string) (*Invite, error)
 	IncrementUses(cxt context.Context, code string) (*Invite, error)
+	Delete(ctx context.Context, inviteCode string) error
+	// ランダムなコードとバニティURLのどちらかで使われていればtrueを返す
+	Exists(ctx context.Context, inviteCode string) (bool, error)
+	GetVanityByGuildID(ctx context.Context, guildID uuid.UUID) (*Invite, error)
+	// 期限切れか使用回数の上限に達した招待を最大limit件削除し、削除した件数を返す
+	DeleteExpired(ctx context.Context, limit int32) (int64, error)
+	AddUse(ctx context.Context, use *InviteUse) error
+	// 新しい順に返す
+	GetUses(ctx context.Context, guildID uuid.UUID, inviteCode string) ([]*InviteUse, error)
 }
 
+// ランダムに生成したコードとバニティURLのどちらの形式も受け付ける
 func ValidateInviteCode(inviteCode string) bool {
+	return isGeneratedInviteCode(inviteCode) || ValidateVanityInviteCode(inviteCode)
+}
+
+func isGeneratedInviteCode(inviteCode string) bool {
 	if len(inviteCode) != INVITE_CODE_LENGTH {
 		return false
 	}
@@ -46,6 +77,22 @@ func ValidateInviteCode(inviteCode string) bool {
 	return true
 }
 
+// 英小文字、数字、ハイフンで、先頭と末尾にハイフンは使えない
+func ValidateVanityInviteCode(inviteCode string) bool {
+	if len(inviteCode) < VANITY_INVITE_CODE_MIN_LENGTH || len(inviteCode) > VANITY_INVITE_CODE_MAX_LENGTH {
+		return false
+	}
+	if strings.HasPrefix(inviteCode, "-") || strings.HasSuffix(inviteCode, "-") {
+		return false
+	}
+	for _, c := range inviteCode {
+		if !strings.ContainsRune(VANITY_CHARSET, c) {
+			return false
+		}
+	}
+	return true
+}
+
 // Generate 8-character alphanumeric invite code
 func GenerateInviteCode() (string, error) {
 	inviteCode := make([]byte, INVITE_CODE_LENGTH)
@@ -1,6 +1,10 @@
 package domain
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
 
 type MediaType = int32
 
@@ -10,4 +14,6 @@ const (
 
 type IMediaService interface {
 	GetPresignedUploadURL(context.Context, MediaType, string) (string, error)
+	// 削除されたチャンネルの添付ファイルを消し、完了してから返る。何度呼んでもよい
+	PurgeChannelAttachments(ctx context.Context, channelIDs []uuid.UUID) error
 }
@@ -12,11 +12,24 @@ type Member struct {
 	GuildID  uuid.UUID
 	User     *User
 	JoinedAt time.Time
+	// 取得しなかった場合は空
+	Status PresenceStatus
+	// @everyoneは含まない
+	RoleIDs []uuid.UUID
+	// タイムアウトの解除時刻。過去の時刻の場合は解除済み
+	CommunicationDisabledUntil *time.Time
 }
 
+// タイムアウトできる最大期間
+const MaxMemberTimeoutDuration = 28 * 24 * time.Hour
+
 type IMemberRepository interface {
 	Add(ctx context.Context, member *Member) (*Member, error)
+	Remove(ctx context.Context, guildID, userID uuid.UUID) error
 	GetMembersByGuildID(ctx context.Context, guildID uuid.UUID) ([]Member, error)
 	CountByGuildID(ctx context.Context, guildID uuid.UUID) (int32, error)
 	IsMember(ctx context.Context, guildID uuid.UUID, userID uuid.UUID) (bool, error)
+	GetGuildIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	// untilがnilの場合はタイムアウトを解除する
+	UpdateCommunicationDisabledUntil(ctx context.Context, guildID, userID uuid.UUID, until *time.Time) (*Member, error)
 }
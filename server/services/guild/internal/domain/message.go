@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// チャンネルごとの未読状態
+type ChannelUnread struct {
+	// 一度も既読にしていない場合はnil
+	LastReadMessageID *uuid.UUID
+	UnreadCount       int32
+	MentionCount      int32
+}
+
+type IMessageService interface {
+	// チャンネルIDをキーに未読状態を返す
+	GetUnreadCounts(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) (map[uuid.UUID]*ChannelUnread, error)
+	// channelIDsでsince以降にuserIDが送信したメッセージを削除し、削除した件数を返す
+	DeleteUserMessages(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID, since time.Time) (int32, error)
+	// 削除されたチャンネルのメッセージを消し、完了してから返る。何度呼んでもよい
+	PurgeChannelMessages(ctx context.Context, channelIDs []uuid.UUID) error
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ロールに付ける権限のビット集合。値はDBに保存するので、既存のビットの意味を変えてはいけない
+type Permission int64
+
+const (
+	PermissionViewChannel Permission = 1 << iota
+	PermissionSendMessages
+	PermissionAddReactions
+	PermissionMentionEveryone
+	PermissionManageMessages
+	PermissionManageChannels
+	PermissionManageInvites
+	PermissionKickMembers
+	PermissionBanMembers
+	PermissionManageRoles
+	PermissionManageGuild
+	// すべての権限を持つ
+	PermissionAdministrator
+	PermissionAttachFiles
+	// 他のメンバーをタイムアウトできる
+	PermissionModerateMembers
+
+	// 新しい権限はこの上に追加する
+	permissionEnd
+)
+
+const (
+	PermissionAll Permission = permissionEnd - 1
+
+	// チャンネルやカテゴリーの上書きで変えられる権限
+	PermissionChannelScoped = PermissionViewChannel | PermissionSendMessages | PermissionAddReactions |
+		PermissionMentionEveryone | PermissionManageMessages | PermissionAttachFiles
+
+	// 新しいギルドの@everyoneに付ける権限
+	DefaultEveryonePermissions = PermissionViewChannel | PermissionSendMessages | PermissionAddReactions | PermissionAttachFiles
+)
+
+// 未定義のビットが立っていないか
+func (p Permission) IsValid() bool {
+	return p&^PermissionAll == 0
+}
+
+func (p Permission) Has(perm Permission) bool {
+	return p&perm == perm
+}
+
+// ユーザーがギルドで持っている権限
+type GuildPermissions struct {
+	GuildID  uuid.UUID
+	IsOwner  bool
+	IsMember bool
+	// @everyoneと持っているロールの権限の和
+	Permissions Permission
+	// 持っているロールのうち最も高いposition。ロールを持っていない場合は@everyoneの0
+	TopPosition int32
+	// タイムアウトの解除時刻。過去の時刻の場合は解除済み
+	CommunicationDisabledUntil *time.Time
+}
+
+// オーナーとAdministratorを持つメンバーはすべての権限を持つ
+func (p *GuildPermissions) Has(perm Permission) bool {
+	if !p.IsMember {
+		return false
+	}
+	if p.IsOwner || p.Permissions&PermissionAdministrator != 0 {
+		return true
+	}
+	return p.Permissions.Has(perm)
+}
+
+// タイムアウト中の場合は解除時刻を返す。オーナーとAdministratorを持つメンバーはタイムアウトの対象外
+func (p *GuildPermissions) TimedOutUntil(now time.Time) *time.Time {
+	if !p.IsMember || p.IsOwner || p.Permissions&PermissionAdministrator != 0 {
+		return nil
+	}
+	if p.CommunicationDisabledUntil == nil || !p.CommunicationDisabledUntil.After(now) {
+		return nil
+	}
+	return p.CommunicationDisabledUntil
+}
+
+// positionのロールより上のロールを持っているか。オーナーはすべてのロールより上として扱う
+func (p *GuildPermissions) Outranks(position int32) bool {
+	return p.IsOwner || p.TopPosition > position
+}
+
+// 自分が持っている権限だけを他のロールに付けられる
+func (p *GuildPermissions) CanGrant(perm Permission) bool {
+	if p.IsOwner || p.Permissions&PermissionAdministrator != 0 {
+		return true
+	}
+	return perm&^p.Permissions == 0
+}
+
+// カテゴリー、チャンネルの順に上書きを適用した、channelでの権限。
+// 各段階では@everyone、ロール(拒否してから許可)、メンバー本人の順に適用し、後のものを優先する。
+// overwritesにはGetForMemberが返すものを渡す
+func (p *GuildPermissions) InChannel(channel *Channel, overwrites []*PermissionOverwrite) Permission {
+	if !p.IsMember {
+		return 0
+	}
+	if p.IsOwner || p.Permissions&PermissionAdministrator != 0 {
+		return PermissionAll
+	}
+
+	perms := p.Permissions
+	for _, scope := range []OverwriteScopeType{OverwriteScopeCategory, OverwriteScopeChannel} {
+		var everyone, member *PermissionOverwrite
+		var roleAllow, roleDeny Permission
+		for _, o := range overwrites {
+			if o.ScopeType != scope || !o.appliesTo(channel) {
+				continue
+			}
+			switch {
+			case o.TargetType == OverwriteTargetMember:
+				member = o
+			case o.TargetID == p.GuildID:
+				everyone = o
+			default:
+				roleAllow |= o.Allow
+				roleDeny |= o.Deny
+			}
+		}
+
+		if everyone != nil {
+			perms = perms&^everyone.Deny | everyone.Allow
+		}
+		perms = perms&^roleDeny | roleAllow
+		if member != nil {
+			perms = perms&^member.Deny | member.Allow
+		}
+	}
+
+	// 見られないチャンネルでは他の権限も持たない
+	if !perms.Has(PermissionViewChannel) {
+		return 0
+	}
+	return perms
+}
+
+// ギルドごとの権限の判定をまとめる。各usecaseはこれを通して権限を確認する
+type PermissionResolver struct {
+	store IStore
+}
+
+func NewPermissionResolver(store IStore) *PermissionResolver {
+	return &PermissionResolver{
+		store: store,
+	}
+}
+
+// ギルドが存在しない場合はErrGuildNotFound
+func (r *PermissionResolver) Resolve(ctx context.Context, guildID, userID uuid.UUID) (*GuildPermissions, error) {
+	return r.store.Roles().GetMemberPermissions(ctx, guildID, userID)
+}
+
+// permを持っていない場合はErrPermissionDenied
+func (r *PermissionResolver) Require(ctx context.Context, guildID, userID uuid.UUID, perm Permission) (*GuildPermissions, error) {
+	perms, err := r.Resolve(ctx, guildID, userID)
+	if err != nil {
+		if err == ErrGuildNotFound {
+			return nil, ErrPermissionDenied
+		}
+		return nil, err
+	}
+	if !perms.Has(perm) {
+		return nil, ErrPermissionDenied
+	}
+	return perms, nil
+}
+
+// channelsそれぞれでの権限をチャンネルIDごとに返す。channelsはすべてguildIDのもの
+func (r *PermissionResolver) ResolveChannels(ctx context.Context, guildID, userID uuid.UUID, channels []*Channel) (map[uuid.UUID]Permission, error) {
+	perms, err := r.Resolve(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return r.inChannels(ctx, perms, userID, channels)
+}
+
+// チャンネルでの実効権限と、タイムアウト中の場合の解除時刻
+type ChannelAccess struct {
+	Permissions Permission
+	// タイムアウト中でない場合はnil
+	CommunicationDisabledUntil *time.Time
+}
+
+// チャンネルが存在しない場合はErrChannelNotFound
+func (r *PermissionResolver) ResolveChannel(ctx context.Context, channelID, userID uuid.UUID) (*ChannelAccess, error) {
+	guildID, err := r.store.Channels().GetGuildIDByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := r.store.Channels().GetByID(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := r.Resolve(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.inChannels(ctx, perms, userID, []*Channel{channel})
+	if err != nil {
+		return nil, err
+	}
+	return &ChannelAccess{
+		Permissions:                result[channel.ID],
+		CommunicationDisabledUntil: perms.TimedOutUntil(time.Now()),
+	}, nil
+}
+
+func (r *PermissionResolver) inChannels(ctx context.Context, perms *GuildPermissions, userID uuid.UUID, channels []*Channel) (map[uuid.UUID]Permission, error) {
+	result := make(map[uuid.UUID]Permission, len(channels))
+	if !perms.IsMember {
+		for _, channel := range channels {
+			result[channel.ID] = 0
+		}
+		return result, nil
+	}
+
+	overwrites, err := r.store.PermissionOverwrites().GetForMember(ctx, perms.GuildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range channels {
+		result[channel.ID] = perms.InChannel(channel, overwrites)
+	}
+	return result, nil
+}
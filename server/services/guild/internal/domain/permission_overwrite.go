@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 上書きを設定する場所
+type OverwriteScopeType int
+
+const (
+	OverwriteScopeChannel OverwriteScopeType = iota + 1
+	// カテゴリーの上書きは、その中のすべてのチャンネルに適用される
+	OverwriteScopeCategory
+)
+
+// 上書きの対象
+type OverwriteTargetType int
+
+const (
+	OverwriteTargetRole OverwriteTargetType = iota + 1
+	OverwriteTargetMember
+)
+
+// チャンネルかカテゴリーに設定する、ロールかメンバーごとの権限の上書き
+type PermissionOverwrite struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ScopeType  OverwriteScopeType
+	ScopeID    uuid.UUID
+	TargetType OverwriteTargetType
+	// ロールのIDかメンバーのユーザーID
+	TargetID  uuid.UUID
+	Allow     Permission
+	Deny      Permission
+	CreatedAt time.Time
+}
+
+// チャンネルに関係する権限だけを、allowとdenyで重ならないように指定しているか
+func (o *PermissionOverwrite) IsValid() bool {
+	return o.Allow&^PermissionChannelScoped == 0 &&
+		o.Deny&^PermissionChannelScoped == 0 &&
+		o.Allow&o.Deny == 0
+}
+
+func (o *PermissionOverwrite) appliesTo(channel *Channel) bool {
+	switch o.ScopeType {
+	case OverwriteScopeChannel:
+		return o.ScopeID == channel.ID
+	case OverwriteScopeCategory:
+		return o.ScopeID == channel.CategoryID
+	}
+	return false
+}
+
+type IPermissionOverwriteRepository interface {
+	// 同じ場所・同じ対象の上書きがあればallowとdenyを置き換える
+	Upsert(ctx context.Context, overwrite *PermissionOverwrite) (*PermissionOverwrite, error)
+	// scopeIDはチャンネルかカテゴリーのID
+	GetByScopeID(ctx context.Context, scopeID uuid.UUID) ([]*PermissionOverwrite, error)
+	Delete(ctx context.Context, scopeID, targetID uuid.UUID) error
+	// ギルド内の上書きのうち、@everyone・userIDが持っているロール・userID本人を対象にするもの
+	GetForMember(ctx context.Context, guildID, userID uuid.UUID) ([]*PermissionOverwrite, error)
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PresenceStatus string
+
+const (
+	PresenceStatusOnline  PresenceStatus = "online"
+	PresenceStatusIdle    PresenceStatus = "idle"
+	PresenceStatusDnd     PresenceStatus = "dnd"
+	PresenceStatusOffline PresenceStatus = "offline"
+)
+
+// realtime-serviceが記録しているオンライン状態を読む
+type IPresenceService interface {
+	// ユーザーIDをキーに状態を返す。接続していないユーザーはofflineになる
+	GetStatuses(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]PresenceStatus, error)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ギルドの変更をguild:{guildID}に流し、realtime-serviceがメンバーのセッションに届ける
+type IPublisher interface {
+	PublishGuildUpdated(ctx context.Context, guild *Guild) error
+	PublishGuildDeleted(ctx context.Context, guildID uuid.UUID) error
+	PublishGuildOwnerUpdated(ctx context.Context, guildID, previousOwnerID, ownerID uuid.UUID) error
+	PublishCategoryCreated(ctx context.Context, category *Category) error
+	PublishCategoryUpdated(ctx context.Context, category *Category) error
+	PublishCategoryDeleted(ctx context.Context, category *Category) error
+	PublishChannelCreated(ctx context.Context, guildID uuid.UUID, channel *Channel) error
+	PublishChannelUpdated(ctx context.Context, guildID uuid.UUID, channel *Channel) error
+	PublishChannelDeleted(ctx context.Context, guildID uuid.UUID, channel *Channel) error
+	PublishChannelsReordered(ctx context.Context, guildID uuid.UUID, layout []*CategoryLayout) error
+	PublishMemberAdded(ctx context.Context, member *Member) error
+	PublishMemberRemoved(ctx context.Context, guildID, userID uuid.UUID) error
+	PublishMemberUpdated(ctx context.Context, member *Member) error
+	// ロールや権限の上書きが変わり、チャンネルを見られるメンバーが変わったかもしれないことを知らせる。
+	// userIDがnilの場合はギルドの全メンバーが対象
+	PublishPermissionsUpdated(ctx context.Context, guildID uuid.UUID, userID *uuid.UUID) error
+}
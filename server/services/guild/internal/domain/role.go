@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EveryoneRoleName = "@everyone"
+	// @everyoneは常に一番下にある
+	EveryoneRolePosition = 0
+)
+
+type Role struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions Permission
+	// 大きいほど上。@everyoneは0で、他のロールは1から
+	Position  int32
+	CreatedAt time.Time
+}
+
+// @everyoneはギルドと同じIDを持ち、全メンバーに暗黙的に付いている
+func (r *Role) IsEveryone() bool {
+	return r.ID == r.GuildID
+}
+
+func NewEveryoneRole(guildID uuid.UUID, createdAt time.Time) *Role {
+	return &Role{
+		ID:          guildID,
+		GuildID:     guildID,
+		Name:        EveryoneRoleName,
+		Permissions: DefaultEveryonePermissions,
+		Position:    EveryoneRolePosition,
+		CreatedAt:   createdAt,
+	}
+}
+
+type IRoleRepository interface {
+	Create(ctx context.Context, role *Role) (*Role, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Role, error)
+	// positionの昇順で返す
+	GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*Role, error)
+	Update(ctx context.Context, role *Role) (*Role, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// @everyone以外のロールを1つずつ上げ、position = 1を空ける
+	ShiftPositions(ctx context.Context, guildID uuid.UUID) error
+	// roleIDsの順にpositionを1から振り直す
+	UpdatePositions(ctx context.Context, guildID uuid.UUID, roleIDs []uuid.UUID) error
+	AddToMember(ctx context.Context, guildID, userID, roleID uuid.UUID) error
+	RemoveFromMember(ctx context.Context, guildID, userID, roleID uuid.UUID) error
+	// ユーザーIDをキーに、ギルドのメンバーが持っているロールのIDを返す
+	GetMemberRoleIDs(ctx context.Context, guildID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	GetMemberPermissions(ctx context.Context, guildID, userID uuid.UUID) (*GuildPermissions, error)
+}
@@ -10,5 +10,10 @@ type IStore interface {
 	Categories() ICategoryRepository
 	Members() IMemberRepository
 	Invites() IInviteRepository
+	Roles() IRoleRepository
+	PermissionOverwrites() IPermissionOverwriteRepository
+	Bans() IBanRepository
+	AuditLogs() IAuditLogRepository
+	ChannelPurgeJobs() IChannelPurgeJobRepository
 	ExecTx(ctx context.Context, fn func(IStore) error) error
 }
@@ -19,4 +19,6 @@ type IUserService interface {
 	Exists(userID uuid.UUID) (bool, error)
 	GetUserByID(userID uuid.UUID) (User, error)
 	GetUsersByIDs(ids []uuid.UUID) ([]*User, error)
+	// パスワードが一致しない場合、ユーザーが存在しない場合はfalseを返す
+	VerifyPassword(userID uuid.UUID, password string) (bool, error)
 }
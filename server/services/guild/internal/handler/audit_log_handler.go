@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/usecase"
+	"log/slog"
+
+	pb "chat-app-proto/gen/guild"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type auditLogHandler struct {
+	auditLogUsecase usecase.AuditLogUsecase
+	logger          *slog.Logger
+}
+
+func NewAuditLogHandler(auditLogUsecase usecase.AuditLogUsecase, logger *slog.Logger) *auditLogHandler {
+	return &auditLogHandler{
+		auditLogUsecase: auditLogUsecase,
+		logger:          logger,
+	}
+}
+
+func (h *auditLogHandler) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	actorID, err := parseOptionalUUID(req.ActorId)
+	if err != nil {
+		h.logger.Warn("Invalid actor ID format", "actor_id", req.GetActorId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidAuditLogQuery.Error())
+	}
+	targetID, err := parseOptionalUUID(req.TargetId)
+	if err != nil {
+		h.logger.Warn("Invalid target ID format", "target_id", req.GetTargetId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidAuditLogQuery.Error())
+	}
+	cursor, err := parseOptionalUUID(req.Cursor)
+	if err != nil {
+		h.logger.Warn("Invalid cursor format", "cursor", req.GetCursor(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidAuditLogQuery.Error())
+	}
+
+	params := &usecase.ListAuditLogParams{
+		GuildID:  guildID,
+		UserID:   userID,
+		ActorID:  actorID,
+		TargetID: targetID,
+		Cursor:   cursor,
+		Limit:    req.GetLimit(),
+	}
+	if req.ActionType != nil {
+		action := domain.AuditLogAction(*req.ActionType)
+		params.Action = &action
+	}
+
+	result, err := h.auditLogUsecase.List(ctx, params)
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to list audit log", "guild_id", guildID)
+	}
+
+	entries := make([]*pb.AuditLogEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = toPbAuditLogEntry(entry)
+	}
+
+	res := &pb.ListAuditLogResponse{
+		Entries: entries,
+		HasMore: result.NextCursor != nil,
+	}
+	if result.NextCursor != nil {
+		nextCursor := result.NextCursor.String()
+		res.NextCursor = &nextCursor
+	}
+	return res, nil
+}
+
+func (h *auditLogHandler) toStatusError(err error, msg string, args ...any) error {
+	switch err {
+	case domain.ErrPermissionDenied:
+		h.logger.Warn("Permission denied", args...)
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidAuditLogQuery:
+		h.logger.Warn("Invalid audit log query", args...)
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		h.logger.Error(msg, append(args, "error", err)...)
+		return status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+}
+
+func toPbAuditLogEntry(entry *domain.AuditLog) *pb.AuditLogEntry {
+	changes := make([]*pb.AuditLogChange, len(entry.Changes))
+	for i, change := range entry.Changes {
+		changes[i] = &pb.AuditLogChange{Key: change.Key}
+		if change.Before != nil {
+			before := string(change.Before)
+			changes[i].OldValue = &before
+		}
+		if change.After != nil {
+			after := string(change.After)
+			changes[i].NewValue = &after
+		}
+	}
+
+	pbEntry := &pb.AuditLogEntry{
+		Id:         entry.ID.String(),
+		GuildId:    entry.GuildID.String(),
+		ActionType: string(entry.Action),
+		Changes:    changes,
+		Reason:     entry.Reason,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+	if entry.ActorID != nil {
+		actorID := entry.ActorID.String()
+		pbEntry.ActorId = &actorID
+	}
+	if entry.TargetID != nil {
+		targetID := entry.TargetID.String()
+		pbEntry.TargetId = &targetID
+	}
+	return pbEntry
+}
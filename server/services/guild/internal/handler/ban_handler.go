@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/usecase"
+	"log/slog"
+	"time"
+
+	pb "chat-app-proto/gen/guild"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type banHandler struct {
+	banUsecase usecase.BanUsecase
+	logger     *slog.Logger
+}
+
+func NewBanHandler(banUsecase usecase.BanUsecase, logger *slog.Logger) *banHandler {
+	return &banHandler{
+		banUsecase: banUsecase,
+		logger:     logger,
+	}
+}
+
+func (h *banHandler) BanMember(ctx context.Context, req *pb.BanMemberRequest) (*pb.BanMemberResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	targetUserID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid member ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	result, err := h.banUsecase.Ban(ctx, &usecase.BanMemberParams{
+		GuildID:               guildID,
+		UserID:                userID,
+		TargetUserID:          targetUserID,
+		Reason:                req.Reason,
+		ExpiresAt:             expiresAt,
+		DeleteMessageDuration: time.Duration(req.DeleteMessageSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to ban member", "guild_id", guildID, "user_id", targetUserID)
+	}
+	if result.MessageDeleteErr != nil {
+		h.logger.Error("Banned member but failed to delete their messages", "guild_id", guildID, "user_id", targetUserID, "error", result.MessageDeleteErr)
+	}
+
+	return &pb.BanMemberResponse{Ban: toPbBan(result.Ban)}, nil
+}
+
+func (h *banHandler) UnbanMember(ctx context.Context, req *pb.UnbanMemberRequest) (*pb.UnbanMemberResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	targetUserID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid member ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	err = h.banUsecase.Unban(ctx, &usecase.UnbanMemberParams{
+		GuildID:      guildID,
+		UserID:       userID,
+		TargetUserID: targetUserID,
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to unban member", "guild_id", guildID, "user_id", targetUserID)
+	}
+
+	return &pb.UnbanMemberResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *banHandler) ListBans(ctx context.Context, req *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	bans, err := h.banUsecase.List(ctx, userID, guildID)
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to list bans", "guild_id", guildID)
+	}
+
+	pbBans := make([]*pb.Ban, len(bans))
+	for i, ban := range bans {
+		pbBans[i] = toPbBan(ban)
+	}
+
+	return &pb.ListBansResponse{Bans: pbBans}, nil
+}
+
+func (h *banHandler) toStatusError(err error, msg string, args ...any) error {
+	switch err {
+	case domain.ErrGuildNotFound, domain.ErrUserNotFound, domain.ErrBanNotFound:
+		h.logger.Warn(err.Error(), args...)
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrPermissionDenied:
+		h.logger.Warn("Permission denied", args...)
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidBanData:
+		h.logger.Warn("Invalid ban data", args...)
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrCannotBanOwner:
+		h.logger.Warn("Cannot ban guild owner", args...)
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		h.logger.Error(msg, append(args, "error", err)...)
+		return status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+}
+
+func toPbBan(ban *domain.Ban) *pb.Ban {
+	pbBan := &pb.Ban{
+		GuildId:   ban.GuildID.String(),
+		UserId:    ban.UserID.String(),
+		Reason:    ban.Reason,
+		CreatedAt: timestamppb.New(ban.CreatedAt),
+	}
+	if ban.ModeratorID != nil {
+		moderatorID := ban.ModeratorID.String()
+		pbBan.ModeratorId = &moderatorID
+	}
+	if ban.ExpiresAt != nil {
+		pbBan.ExpiresAt = timestamppb.New(*ban.ExpiresAt)
+	}
+	if ban.User != nil {
+		pbBan.User = &pb.User{
+			Id:        ban.User.ID.String(),
+			Name:      ban.User.Name,
+			DisplayId: ban.User.DisplayId,
+			IconUrl:   ban.User.IconURL,
+			CreatedAt: timestamppb.New(ban.User.CreatedAt),
+		}
+	}
+	return pbBan
+}
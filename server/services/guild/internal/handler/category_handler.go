@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -61,6 +62,7 @@ func (h *categoryHandler) CreateCategory(ctx context.Context, req *pb.CreateCate
 		Id:        category.ID.String(),
 		GuildId:   category.GuildID.String(),
 		Name:      category.Name,
+		Position:  category.Position,
 		CreatedAt: timestamppb.New(category.CreatedAt),
 	}
 
@@ -68,3 +70,87 @@ func (h *categoryHandler) CreateCategory(ctx context.Context, req *pb.CreateCate
 		Category: pbCategory,
 	}, nil
 }
+
+func (h *categoryHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := uuid.Parse(req.CategoryId)
+	if err != nil {
+		h.logger.Warn("Invalid category ID format", "category_id", req.CategoryId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCategoryID.Error())
+	}
+
+	category, err := h.categoryUsecase.UpdateCategory(ctx, &usecase.UpdateCategoryParams{
+		CategoryID: categoryID,
+		UserID:     userID,
+		Name:       req.Name,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidCategoryData:
+			h.logger.Warn("Invalid category data", "category_id", categoryID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCategoryData.Error())
+		case domain.ErrCategoryNotFound:
+			h.logger.Warn("Category not found", "category_id", categoryID)
+			return nil, status.Error(codes.NotFound, domain.ErrCategoryNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "category_id", categoryID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to update category", "category_id", categoryID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.UpdateCategoryResponse{
+		Category: &pb.Category{
+			Id:        category.ID.String(),
+			GuildId:   category.GuildID.String(),
+			Name:      category.Name,
+			Position:  category.Position,
+			CreatedAt: timestamppb.New(category.CreatedAt),
+		},
+	}, nil
+}
+
+func (h *categoryHandler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := uuid.Parse(req.CategoryId)
+	if err != nil {
+		h.logger.Warn("Invalid category ID format", "category_id", req.CategoryId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCategoryID.Error())
+	}
+
+	err = h.categoryUsecase.DeleteCategory(ctx, &usecase.DeleteCategoryParams{
+		CategoryID: categoryID,
+		UserID:     userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrCategoryNotFound:
+			h.logger.Warn("Category not found", "category_id", categoryID)
+			return nil, status.Error(codes.NotFound, domain.ErrCategoryNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "category_id", categoryID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrLastCategoryUndeletable:
+			h.logger.Warn("Cannot delete last category", "category_id", categoryID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrLastCategoryUndeletable.Error())
+		case domain.ErrCategoryHasOverwrites:
+			h.logger.Warn("Cannot delete category with permission overwrites", "category_id", categoryID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrCategoryHasOverwrites.Error())
+		default:
+			h.logger.Error("Failed to delete category", "category_id", categoryID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.DeleteCategoryResponse{Empty: &emptypb.Empty{}}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -61,12 +62,153 @@ func (h *channelHandler) CreateChannel(ctx context.Context, req *pb.CreateChanne
 		Id:         channel.ID.String(),
 		CategoryId: channel.CategoryID.String(),
 		Name:       channel.Name,
+		Position:   channel.Position,
 		CreatedAt:  timestamppb.New(channel.CreatedAt),
 	}
 
 	return &pb.CreateChannelResponse{Channel: pbChannel}, nil
 }
 
+func (h *channelHandler) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.UpdateChannelResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelID.Error())
+	}
+
+	channel, err := h.channelUsecase.Update(ctx, &usecase.UpdateChannelParams{
+		ChannelID: channelID,
+		UserID:    userID,
+		Name:      req.Name,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidChannelData:
+			h.logger.Warn("Invalid channel data", "channel_id", channelID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelData.Error())
+		case domain.ErrChannelNotFound:
+			h.logger.Warn("Channel not found", "channel_id", channelID)
+			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "channel_id", channelID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to update channel", "channel_id", channelID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.UpdateChannelResponse{
+		Channel: &pb.Channel{
+			Id:         channel.ID.String(),
+			CategoryId: channel.CategoryID.String(),
+			Name:       channel.Name,
+			Position:   channel.Position,
+			CreatedAt:  timestamppb.New(channel.CreatedAt),
+		},
+	}, nil
+}
+
+func (h *channelHandler) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.DeleteChannelResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelID.Error())
+	}
+
+	err = h.channelUsecase.Delete(ctx, &usecase.DeleteChannelParams{
+		ChannelID: channelID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrChannelNotFound:
+			h.logger.Warn("Channel not found", "channel_id", channelID)
+			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "channel_id", channelID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrDefaultChannelUndeletable:
+			h.logger.Warn("Cannot delete default channel", "channel_id", channelID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrDefaultChannelUndeletable.Error())
+		default:
+			h.logger.Error("Failed to delete channel", "channel_id", channelID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.DeleteChannelResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *channelHandler) ReorderChannels(ctx context.Context, req *pb.ReorderChannelsRequest) (*pb.ReorderChannelsResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	layout := make([]*domain.CategoryLayout, len(req.Categories))
+	for i, category := range req.Categories {
+		categoryID, err := uuid.Parse(category.CategoryId)
+		if err != nil {
+			h.logger.Warn("Invalid category ID format", "category_id", category.CategoryId, "error", err)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCategoryID.Error())
+		}
+		channelIDs, err := parseUUIDs(category.ChannelIds)
+		if err != nil {
+			h.logger.Warn("Invalid channel ID format", "channel_ids", category.ChannelIds, "error", err)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelID.Error())
+		}
+		layout[i] = &domain.CategoryLayout{
+			CategoryID: categoryID,
+			ChannelIDs: channelIDs,
+		}
+	}
+
+	layout, err = h.channelUsecase.Reorder(ctx, &usecase.ReorderChannelsParams{
+		GuildID:    guildID,
+		UserID:     userID,
+		Categories: layout,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidChannelData:
+			h.logger.Warn("Invalid channel layout", "guild_id", guildID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelData.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to reorder channels", "guild_id", guildID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	pbLayout := make([]*pb.CategoryLayout, len(layout))
+	for i, category := range layout {
+		pbLayout[i] = &pb.CategoryLayout{
+			CategoryId: category.CategoryID.String(),
+			ChannelIds: uuidsToStrings(category.ChannelIDs),
+		}
+	}
+	return &pb.ReorderChannelsResponse{Categories: pbLayout}, nil
+}
+
 func (h *channelHandler) CheckChannelAccess(ctx context.Context, req *pb.CheckChannelAccessRequest) (*pb.CheckChannelAccessResponse, error) {
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
@@ -80,7 +222,7 @@ func (h *channelHandler) CheckChannelAccess(ctx context.Context, req *pb.CheckCh
 		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
 	}
 
-	hasAccess, err := h.channelUsecase.CheckAccess(ctx, userID, channelID)
+	access, err := h.channelUsecase.CheckAccess(ctx, userID, channelID)
 	if err != nil {
 		switch err {
 		case domain.ErrChannelNotFound:
@@ -92,5 +234,103 @@ func (h *channelHandler) CheckChannelAccess(ctx context.Context, req *pb.CheckCh
 		}
 	}
 
-	return &pb.CheckChannelAccessResponse{HasAccess: hasAccess}, nil
+	perms := access.Permissions
+	res := &pb.CheckChannelAccessResponse{
+		Permissions: &pb.ChannelPermissions{
+			ViewChannel:     perms.Has(domain.PermissionViewChannel),
+			SendMessages:    perms.Has(domain.PermissionSendMessages),
+			AttachFiles:     perms.Has(domain.PermissionAttachFiles),
+			AddReactions:    perms.Has(domain.PermissionAddReactions),
+			MentionEveryone: perms.Has(domain.PermissionMentionEveryone),
+			ManageMessages:  perms.Has(domain.PermissionManageMessages),
+		},
+	}
+	if access.CommunicationDisabledUntil != nil {
+		res.CommunicationDisabledUntil = timestamppb.New(*access.CommunicationDisabledUntil)
+	}
+	return res, nil
+}
+
+func (h *channelHandler) FilterMentionTargets(ctx context.Context, req *pb.FilterMentionTargetsRequest) (*pb.FilterMentionTargetsResponse, error) {
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	userIDs, err := parseUUIDs(req.UserIds)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_ids", req.UserIds, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+	channelIDs, err := parseUUIDs(req.ChannelIds)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_ids", req.ChannelIds, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	result, err := h.channelUsecase.FilterMentionTargets(ctx, &usecase.FilterMentionTargetsParams{
+		ChannelID:  channelID,
+		UserIDs:    userIDs,
+		ChannelIDs: channelIDs,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidArgument:
+			h.logger.Warn("Too many mention targets", "channel_id", channelID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+		default:
+			h.logger.Error("Failed to filter mention targets", "channel_id", channelID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.FilterMentionTargetsResponse{
+		UserIds:    uuidsToStrings(result.UserIDs),
+		ChannelIds: uuidsToStrings(result.ChannelIDs),
+	}, nil
+}
+
+func (h *channelHandler) ListAccessibleChannelIDs(ctx context.Context, req *pb.ListAccessibleChannelIDsRequest) (*pb.ListAccessibleChannelIDsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	channelIDs, err := h.channelUsecase.GetAccessibleIDs(ctx, userID, guildID)
+	if err != nil {
+		h.logger.Error("Failed to list accessible channels", "user_id", userID, "guild_id", guildID, "error", err)
+		return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+
+	return &pb.ListAccessibleChannelIDsResponse{ChannelIds: uuidsToStrings(channelIDs)}, nil
+}
+
+func (h *channelHandler) BatchCheckChannelAccess(ctx context.Context, req *pb.BatchCheckChannelAccessRequest) (*pb.BatchCheckChannelAccessResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	channelIDs, err := parseUUIDs(req.ChannelIds)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_ids", req.ChannelIds, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	accessible, err := h.channelUsecase.FilterAccessible(ctx, userID, channelIDs)
+	if err != nil {
+		h.logger.Error("Failed to check channel access", "user_id", userID, "error", err)
+		return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+
+	return &pb.BatchCheckChannelAccessResponse{ChannelIds: uuidsToStrings(accessible)}, nil
 }
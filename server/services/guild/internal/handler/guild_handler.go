@@ -5,6 +5,7 @@ import (
 	"guild-service/internal/domain"
 	"guild-service/internal/usecase"
 	"log/slog"
+	"time"
 
 	pb "chat-app-proto/gen/guild"
 
@@ -52,7 +53,7 @@ func (h *guildHandler) CreateGuild(ctx context.Context, req *pb.CreateGuildReque
 
 	pbGuild := &pb.Guild{
 		Id:               guild.ID.String(),
-		OwnerId:          guild.OwnerID.String(),
+		OwnerId:          formatOptionalUUID(guild.OwnerID),
 		Name:             guild.Name,
 		Description:      guild.Description,
 		IconUrl:          guild.IconURL,
@@ -74,7 +75,11 @@ func (h *guildHandler) GetGuildByID(ctx context.Context, req *pb.GetGuildByIDReq
 		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
 	}
 
-	guild, err := h.guildUsecase.GetByID(ctx, userID, guildID)
+	guild, err := h.guildUsecase.GetByID(ctx, &usecase.GetGuildByIDParams{
+		UserID:       userID,
+		GuildID:      guildID,
+		WithPresence: req.WithPresence,
+	})
 	if err != nil {
 		switch err {
 		case domain.ErrGuildNotFound:
@@ -86,6 +91,7 @@ func (h *guildHandler) GetGuildByID(ctx context.Context, req *pb.GetGuildByIDReq
 		}
 	}
 
+	now := time.Now()
 	pbMembers := make([]*pb.Member, len(guild.Members))
 	for i, member := range guild.Members {
 		pbMembers[i] = &pb.Member{
@@ -99,12 +105,17 @@ func (h *guildHandler) GetGuildByID(ctx context.Context, req *pb.GetGuildByIDReq
 			},
 			GuildId:  member.GuildID.String(),
 			JoinedAt: timestamppb.New(member.JoinedAt),
+			Status:   toPbPresenceStatus(member.Status),
+			RoleIds:  uuidsToStrings(member.RoleIDs),
+		}
+		if until := member.CommunicationDisabledUntil; until != nil && until.After(now) {
+			pbMembers[i].CommunicationDisabledUntil = timestamppb.New(*until)
 		}
 	}
 
 	pbGuild := &pb.GuildWithMembers{
 		Id:               guild.ID.String(),
-		OwnerId:          guild.OwnerID.String(),
+		OwnerId:          formatOptionalUUID(guild.OwnerID),
 		Name:             guild.Name,
 		Description:      guild.Description,
 		IconUrl:          guild.IconURL,
@@ -164,7 +175,7 @@ func (h *guildHandler) UpdateGuild(ctx context.Context, req *pb.UpdateGuildReque
 
 	pbGuild := &pb.Guild{
 		Id:               updatedGuild.ID.String(),
-		OwnerId:          updatedGuild.OwnerID.String(),
+		OwnerId:          formatOptionalUUID(updatedGuild.OwnerID),
 		Name:             updatedGuild.Name,
 		Description:      updatedGuild.Description,
 		IconUrl:          updatedGuild.IconURL,
@@ -175,6 +186,102 @@ func (h *guildHandler) UpdateGuild(ctx context.Context, req *pb.UpdateGuildReque
 	return &pb.UpdateGuildResponse{Guild: pbGuild}, nil
 }
 
+func (h *guildHandler) DeleteGuild(ctx context.Context, req *pb.DeleteGuildRequest) (*pb.DeleteGuildResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	err = h.guildUsecase.Delete(ctx, &usecase.DeleteGuildParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrGuildNotFound:
+			h.logger.Warn("Guild not found", "guild_id", guildID)
+			return nil, status.Error(codes.NotFound, domain.ErrGuildNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Not guild owner", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to delete guild", "guild_id", guildID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.DeleteGuildResponse{}, nil
+}
+
+func (h *guildHandler) TransferGuildOwnership(ctx context.Context, req *pb.TransferGuildOwnershipRequest) (*pb.TransferGuildOwnershipResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	newOwnerID, err := uuid.Parse(req.NewOwnerId)
+	if err != nil {
+		h.logger.Warn("Invalid new owner ID format", "new_owner_id", req.NewOwnerId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	guild, err := h.guildUsecase.TransferOwnership(ctx, &usecase.TransferOwnershipParams{
+		GuildID:    guildID,
+		UserID:     userID,
+		NewOwnerID: newOwnerID,
+		Password:   req.Password,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrGuildNotFound:
+			h.logger.Warn("Guild not found", "guild_id", guildID)
+			return nil, status.Error(codes.NotFound, domain.ErrGuildNotFound.Error())
+		case domain.ErrMemberNotFound:
+			h.logger.Warn("New owner is not a member", "guild_id", guildID, "new_owner_id", newOwnerID)
+			return nil, status.Error(codes.NotFound, domain.ErrMemberNotFound.Error())
+		case domain.ErrInvalidArgument:
+			h.logger.Warn("Invalid ownership transfer request", "guild_id", guildID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+		case domain.ErrInvalidCredential:
+			h.logger.Warn("Invalid password for ownership transfer", "guild_id", guildID, "user_id", userID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCredential.Error())
+		case domain.ErrCannotTransferToSelf:
+			h.logger.Warn("Cannot transfer ownership to self", "guild_id", guildID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrCannotTransferToSelf.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Not guild owner", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to transfer guild ownership", "guild_id", guildID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.TransferGuildOwnershipResponse{
+		Guild: &pb.Guild{
+			Id:               guild.ID.String(),
+			OwnerId:          formatOptionalUUID(guild.OwnerID),
+			Name:             guild.Name,
+			Description:      guild.Description,
+			IconUrl:          guild.IconURL,
+			DefaultChannelId: guild.DefaultChannelID.String(),
+			CreatedAt:        timestamppb.New(guild.CreatedAt),
+		},
+	}, nil
+}
+
 func (h *guildHandler) GetGuildOverview(ctx context.Context, req *pb.GetGuildOverviewRequest) (*pb.GetGuildOverviewResponse, error) {
 	userID, err := getUserID(ctx, h.logger)
 	if err != nil {
@@ -198,23 +305,35 @@ func (h *guildHandler) GetGuildOverview(ctx context.Context, req *pb.GetGuildOve
 			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
 		}
 	}
+	if guildOverview.UnreadErr != nil {
+		h.logger.Warn("Failed to get unread counts, returning guild overview without them", "guild_id", guildID, "user_id", userID, "error", guildOverview.UnreadErr)
+	}
 
 	pbCategories := make([]*pb.CategoryDetail, len(guildOverview.Categories))
 	for i, category := range guildOverview.Categories {
-		pbChannels := make([]*pb.Channel, len(category.Channels))
+		pbChannels := make([]*pb.ChannelDetail, len(category.Channels))
 		for j, channel := range category.Channels {
-			pbChannels[j] = &pb.Channel{
-				Id:         channel.ID.String(),
-				CategoryId: channel.CategoryID.String(),
-				Name:       channel.Name,
-				CreatedAt:  timestamppb.New(channel.CreatedAt),
+			pbChannel := &pb.ChannelDetail{
+				Id:           channel.ID.String(),
+				CategoryId:   channel.CategoryID.String(),
+				Name:         channel.Name,
+				Position:     channel.Position,
+				CreatedAt:    timestamppb.New(channel.CreatedAt),
+				UnreadCount:  channel.Unread.UnreadCount,
+				MentionCount: channel.Unread.MentionCount,
+			}
+			if channel.Unread.LastReadMessageID != nil {
+				lastReadMessageID := channel.Unread.LastReadMessageID.String()
+				pbChannel.LastReadMessageId = &lastReadMessageID
 			}
+			pbChannels[j] = pbChannel
 		}
 
 		pbCategories[i] = &pb.CategoryDetail{
 			Id:        category.ID.String(),
 			GuildId:   category.GuildID.String(),
 			Name:      category.Name,
+			Position:  category.Position,
 			CreatedAt: timestamppb.New(category.CreatedAt),
 			Channels:  pbChannels,
 		}
@@ -222,7 +341,7 @@ func (h *guildHandler) GetGuildOverview(ctx context.Context, req *pb.GetGuildOve
 
 	pbGuild := &pb.GuildDetail{
 		Id:               guildOverview.ID.String(),
-		OwnerId:          guildOverview.OwnerID.String(),
+		OwnerId:          formatOptionalUUID(guildOverview.OwnerID),
 		Name:             guildOverview.Name,
 		Description:      guildOverview.Description,
 		IconUrl:          guildOverview.IconURL,
@@ -250,7 +369,7 @@ func (h *guildHandler) GetMyGuilds(ctx context.Context, req *pb.ListMyGuildsRequ
 	for i, guild := range guilds {
 		pbGuilds[i] = &pb.GuildWithMemberCount{
 			Id:               guild.ID.String(),
-			OwnerId:          guild.OwnerID.String(),
+			OwnerId:          formatOptionalUUID(guild.OwnerID),
 			Name:             guild.Name,
 			Description:      guild.Description,
 			IconUrl:          guild.IconURL,
@@ -264,3 +383,34 @@ func (h *guildHandler) GetMyGuilds(ctx context.Context, req *pb.ListMyGuildsRequ
 
 	return &pb.ListMyGuildsResponse{Guilds: pbGuilds}, nil
 }
+
+func (h *guildHandler) ListUserGuildIDs(ctx context.Context, req *pb.ListUserGuildIDsRequest) (*pb.ListUserGuildIDsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+	}
+
+	guildIDs, err := h.guildUsecase.ListGuildIDs(ctx, userID)
+	if err != nil {
+		h.logger.Error("Failed to list user guilds", "user_id", userID, "error", err)
+		return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+
+	return &pb.ListUserGuildIDsResponse{GuildIds: uuidsToStrings(guildIDs)}, nil
+}
+
+func toPbPresenceStatus(status domain.PresenceStatus) pb.PresenceStatus {
+	switch status {
+	case domain.PresenceStatusOnline:
+		return pb.PresenceStatus_PRESENCE_STATUS_ONLINE
+	case domain.PresenceStatusIdle:
+		return pb.PresenceStatus_PRESENCE_STATUS_IDLE
+	case domain.PresenceStatusDnd:
+		return pb.PresenceStatus_PRESENCE_STATUS_DND
+	case domain.PresenceStatusOffline:
+		return pb.PresenceStatus_PRESENCE_STATUS_OFFLINE
+	default:
+		return pb.PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+	}
+}
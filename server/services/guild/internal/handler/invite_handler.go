@@ -3,12 +3,16 @@ package handler
 import (
 	pb "chat-app-proto/gen/guild"
 	"context"
+	"guild-service/internal/domain"
 	"guild-service/internal/usecase"
 	"log/slog"
 	"shared/metadata"
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -67,6 +71,7 @@ func (h *inviteHandler) CreateGuildInvite(ctx context.Context, req *pb.CreateGui
 			MaxUses:     invite.MaxUses,
 			CurrentUses: invite.CurrentUses,
 			ExpiresAt:   expiresAtPb,
+			Vanity:      invite.Vanity,
 			CreatedAt:   timestamppb.New(invite.CreatedAt),
 		},
 	}, nil
@@ -111,6 +116,7 @@ func (h *inviteHandler) GetGuildInvites(ctx context.Context, req *pb.GetGuildInv
 			MaxUses:     invite.MaxUses,
 			CurrentUses: invite.CurrentUses,
 			ExpiresAt:   expiresAt,
+			Vanity:      invite.Vanity,
 			CreatedAt:   timestamppb.New(invite.CreatedAt),
 		}
 	}
@@ -137,6 +143,10 @@ func (h *inviteHandler) JoinGuild(ctx context.Context, req *pb.JoinGuildRequest)
 		UserID:     userID,
 	})
 	if err != nil {
+		if err == domain.ErrUserBanned {
+			h.logger.Warn("Banned user tried to join guild", "code", req.InviteCode, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrUserBanned.Error())
+		}
 		h.logger.Error("Failed to join guild", "code", req.InviteCode, "error", err)
 		return nil, err
 	}
@@ -171,6 +181,7 @@ func (h *inviteHandler) GetGuildByInviteCode(ctx context.Context, req *pb.GetGui
 		MaxUses:     invite.MaxUses,
 		CurrentUses: invite.CurrentUses,
 		ExpiresAt:   expiresAtPb,
+		Vanity:      invite.Vanity,
 		CreatedAt:   timestamppb.New(invite.CreatedAt),
 	}
 
@@ -187,7 +198,7 @@ func (h *inviteHandler) GetGuildByInviteCode(ctx context.Context, req *pb.GetGui
 	if invite.Guild != nil {
 		pbInvite.Guild = &pb.Guild{
 			Id:               invite.Guild.ID.String(),
-			OwnerId:          invite.Guild.OwnerID.String(),
+			OwnerId:          formatOptionalUUID(invite.Guild.OwnerID),
 			Name:             invite.Guild.Name,
 			Description:      invite.Guild.Description,
 			DefaultChannelId: invite.Guild.DefaultChannelID.String(),
@@ -203,3 +214,131 @@ func (h *inviteHandler) GetGuildByInviteCode(ctx context.Context, req *pb.GetGui
 }
 
 var _ pb.GuildServiceServer = (*inviteHandler)(nil)
+
+func (h *inviteHandler) DeleteGuildInvite(ctx context.Context, req *pb.DeleteGuildInviteRequest) (*pb.DeleteGuildInviteResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.inviteUsecase.Delete(ctx, userID, req.InviteCode)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidInviteCode:
+			h.logger.Warn("Invalid invite code", "code", req.InviteCode)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidInviteCode.Error())
+		case domain.ErrInviteNotFound:
+			h.logger.Warn("Invite not found", "code", req.InviteCode)
+			return nil, status.Error(codes.NotFound, domain.ErrInviteNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "code", req.InviteCode)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to delete invite", "code", req.InviteCode, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.DeleteGuildInviteResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *inviteHandler) SetGuildVanityInvite(ctx context.Context, req *pb.SetGuildVanityInviteRequest) (*pb.SetGuildVanityInviteResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	invite, err := h.inviteUsecase.SetVanity(ctx, &usecase.SetVanityInviteParams{
+		GuildID:    guildID,
+		UserID:     userID,
+		InviteCode: req.InviteCode,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidInviteCode, domain.ErrInvalidInviteData:
+			h.logger.Warn("Invalid vanity invite code", "guild_id", guildID, "code", req.InviteCode)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidInviteCode.Error())
+		case domain.ErrInviteCodeAlreadyExists:
+			h.logger.Warn("Invite code already exists", "guild_id", guildID, "code", req.InviteCode)
+			return nil, status.Error(codes.AlreadyExists, domain.ErrInviteCodeAlreadyExists.Error())
+		case domain.ErrGuildAlreadyHasVanityCode:
+			h.logger.Warn("Vanity invite code was set concurrently", "guild_id", guildID, "code", req.InviteCode)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrGuildAlreadyHasVanityCode.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to set vanity invite", "guild_id", guildID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.SetGuildVanityInviteResponse{
+		Invite: &pb.Invite{
+			InviteCode:  invite.InviteCode,
+			GuildId:     invite.GuildID.String(),
+			CreatorId:   invite.CreatorID.String(),
+			CurrentUses: invite.CurrentUses,
+			Vanity:      invite.Vanity,
+			CreatedAt:   timestamppb.New(invite.CreatedAt),
+		},
+	}, nil
+}
+
+func (h *inviteHandler) ListGuildInviteUses(ctx context.Context, req *pb.ListGuildInviteUsesRequest) (*pb.ListGuildInviteUsesResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	uses, err := h.inviteUsecase.ListUses(ctx, &usecase.ListInviteUsesParams{
+		GuildID:    guildID,
+		UserID:     userID,
+		InviteCode: req.InviteCode,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidInviteCode, domain.ErrInvalidInviteData:
+			h.logger.Warn("Invalid invite code", "guild_id", guildID, "code", req.InviteCode)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidInviteCode.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Failed to list invite uses", "guild_id", guildID, "code", req.InviteCode, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	pbUses := make([]*pb.InviteUse, len(uses))
+	for i, use := range uses {
+		pbUses[i] = &pb.InviteUse{
+			InviteCode: use.InviteCode,
+			UserId:     use.UserID.String(),
+			UsedAt:     timestamppb.New(use.UsedAt),
+		}
+		if use.User != nil {
+			pbUses[i].User = &pb.User{
+				Id:        use.User.ID.String(),
+				Name:      use.User.Name,
+				DisplayId: use.User.DisplayId,
+				IconUrl:   use.User.IconURL,
+				CreatedAt: timestamppb.New(use.User.CreatedAt),
+			}
+		}
+	}
+
+	return &pb.ListGuildInviteUsesResponse{Uses: pbUses}, nil
+}
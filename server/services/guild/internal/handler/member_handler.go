@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/usecase"
+	"log/slog"
+	"time"
+
+	pb "chat-app-proto/gen/guild"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type memberHandler struct {
+	memberUsecase usecase.MemberUsecase
+	logger        *slog.Logger
+}
+
+func NewMemberHandler(memberUsecase usecase.MemberUsecase, logger *slog.Logger) *memberHandler {
+	return &memberHandler{
+		memberUsecase: memberUsecase,
+		logger:        logger,
+	}
+}
+
+func (h *memberHandler) DeleteGuildMember(ctx context.Context, req *pb.DeleteGuildMemberRequest) (*pb.DeleteGuildMemberResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	targetUserID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid member ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	err = h.memberUsecase.Remove(ctx, &usecase.RemoveMemberParams{
+		GuildID:      guildID,
+		UserID:       userID,
+		TargetUserID: targetUserID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrGuildNotFound:
+			h.logger.Warn("Guild not found", "guild_id", guildID)
+			return nil, status.Error(codes.NotFound, domain.ErrGuildNotFound.Error())
+		case domain.ErrMemberNotFound:
+			h.logger.Warn("Member not found", "guild_id", guildID, "user_id", targetUserID)
+			return nil, status.Error(codes.NotFound, domain.ErrMemberNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrCannotRemoveOwner:
+			h.logger.Warn("Cannot remove guild owner", "guild_id", guildID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrCannotRemoveOwner.Error())
+		default:
+			h.logger.Error("Failed to remove member", "guild_id", guildID, "user_id", targetUserID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.DeleteGuildMemberResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *memberHandler) LeaveGuild(ctx context.Context, req *pb.LeaveGuildRequest) (*pb.LeaveGuildResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	err = h.memberUsecase.Leave(ctx, userID, guildID)
+	if err != nil {
+		switch err {
+		case domain.ErrGuildNotFound:
+			h.logger.Warn("Guild not found", "guild_id", guildID)
+			return nil, status.Error(codes.NotFound, domain.ErrGuildNotFound.Error())
+		case domain.ErrOwnerCannotLeave:
+			h.logger.Warn("Owner cannot leave guild", "guild_id", guildID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrOwnerCannotLeave.Error())
+		default:
+			h.logger.Error("Failed to leave guild", "guild_id", guildID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	return &pb.LeaveGuildResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *memberHandler) TimeoutMember(ctx context.Context, req *pb.TimeoutMemberRequest) (*pb.TimeoutMemberResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	targetUserID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid member ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	var until *time.Time
+	if req.CommunicationDisabledUntil != nil {
+		t := req.CommunicationDisabledUntil.AsTime()
+		until = &t
+	}
+
+	member, err := h.memberUsecase.Timeout(ctx, &usecase.TimeoutMemberParams{
+		GuildID:      guildID,
+		UserID:       userID,
+		TargetUserID: targetUserID,
+		Until:        until,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrGuildNotFound:
+			h.logger.Warn("Guild not found", "guild_id", guildID)
+			return nil, status.Error(codes.NotFound, domain.ErrGuildNotFound.Error())
+		case domain.ErrMemberNotFound:
+			h.logger.Warn("Member not found", "guild_id", guildID, "user_id", targetUserID)
+			return nil, status.Error(codes.NotFound, domain.ErrMemberNotFound.Error())
+		case domain.ErrInvalidArgument:
+			h.logger.Warn("Invalid timeout", "guild_id", guildID, "user_id", targetUserID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidArgument.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Permission denied", "guild_id", guildID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrCannotTimeoutMember:
+			h.logger.Warn("Cannot timeout owner or administrator", "guild_id", guildID, "user_id", targetUserID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrCannotTimeoutMember.Error())
+		default:
+			h.logger.Error("Failed to timeout member", "guild_id", guildID, "user_id", targetUserID, "error", err)
+			return nil, status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+		}
+	}
+
+	pbMember := &pb.Member{
+		GuildId:  member.GuildID.String(),
+		UserId:   member.UserID.String(),
+		JoinedAt: timestamppb.New(member.JoinedAt),
+	}
+	if member.CommunicationDisabledUntil != nil {
+		pbMember.CommunicationDisabledUntil = timestamppb.New(*member.CommunicationDisabledUntil)
+	}
+
+	return &pb.TimeoutMemberResponse{Member: pbMember}, nil
+}
@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/usecase"
+	"log/slog"
+
+	pb "chat-app-proto/gen/guild"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type permissionOverwriteHandler struct {
+	overwriteUsecase usecase.PermissionOverwriteUsecase
+	logger           *slog.Logger
+}
+
+func NewPermissionOverwriteHandler(overwriteUsecase usecase.PermissionOverwriteUsecase, logger *slog.Logger) *permissionOverwriteHandler {
+	return &permissionOverwriteHandler{
+		overwriteUsecase: overwriteUsecase,
+		logger:           logger,
+	}
+}
+
+func (h *permissionOverwriteHandler) ListChannelPermissionOverwrites(ctx context.Context, req *pb.ListChannelPermissionOverwritesRequest) (*pb.ListChannelPermissionOverwritesResponse, error) {
+	overwrites, err := h.list(ctx, domain.OverwriteScopeChannel, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListChannelPermissionOverwritesResponse{Overwrites: overwrites}, nil
+}
+
+func (h *permissionOverwriteHandler) SetChannelPermissionOverwrite(ctx context.Context, req *pb.SetChannelPermissionOverwriteRequest) (*pb.SetChannelPermissionOverwriteResponse, error) {
+	overwrite, err := h.set(ctx, domain.OverwriteScopeChannel, req.ChannelId, req.TargetId, req.TargetType, req.Allow, req.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetChannelPermissionOverwriteResponse{Overwrite: overwrite}, nil
+}
+
+func (h *permissionOverwriteHandler) DeleteChannelPermissionOverwrite(ctx context.Context, req *pb.DeleteChannelPermissionOverwriteRequest) (*pb.DeleteChannelPermissionOverwriteResponse, error) {
+	if err := h.delete(ctx, domain.OverwriteScopeChannel, req.ChannelId, req.TargetId); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteChannelPermissionOverwriteResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *permissionOverwriteHandler) ListCategoryPermissionOverwrites(ctx context.Context, req *pb.ListCategoryPermissionOverwritesRequest) (*pb.ListCategoryPermissionOverwritesResponse, error) {
+	overwrites, err := h.list(ctx, domain.OverwriteScopeCategory, req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListCategoryPermissionOverwritesResponse{Overwrites: overwrites}, nil
+}
+
+func (h *permissionOverwriteHandler) SetCategoryPermissionOverwrite(ctx context.Context, req *pb.SetCategoryPermissionOverwriteRequest) (*pb.SetCategoryPermissionOverwriteResponse, error) {
+	overwrite, err := h.set(ctx, domain.OverwriteScopeCategory, req.CategoryId, req.TargetId, req.TargetType, req.Allow, req.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCategoryPermissionOverwriteResponse{Overwrite: overwrite}, nil
+}
+
+func (h *permissionOverwriteHandler) DeleteCategoryPermissionOverwrite(ctx context.Context, req *pb.DeleteCategoryPermissionOverwriteRequest) (*pb.DeleteCategoryPermissionOverwriteResponse, error) {
+	if err := h.delete(ctx, domain.OverwriteScopeCategory, req.CategoryId, req.TargetId); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteCategoryPermissionOverwriteResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *permissionOverwriteHandler) list(ctx context.Context, scopeType domain.OverwriteScopeType, scopeIDStr string) ([]*pb.PermissionOverwrite, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeID, err := h.parseScopeID(scopeType, scopeIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	overwrites, err := h.overwriteUsecase.List(ctx, &usecase.ListPermissionOverwritesParams{
+		UserID:    userID,
+		ScopeType: scopeType,
+		ScopeID:   scopeID,
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to list permission overwrites", "scope_id", scopeID)
+	}
+
+	pbOverwrites := make([]*pb.PermissionOverwrite, len(overwrites))
+	for i, overwrite := range overwrites {
+		pbOverwrites[i] = toPbPermissionOverwrite(overwrite)
+	}
+	return pbOverwrites, nil
+}
+
+func (h *permissionOverwriteHandler) set(ctx context.Context, scopeType domain.OverwriteScopeType, scopeIDStr, targetIDStr string, targetType pb.PermissionOverwriteTargetType, allow, deny int64) (*pb.PermissionOverwrite, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeID, err := h.parseScopeID(scopeType, scopeIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	targetID, err := uuid.Parse(targetIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid overwrite target ID format", "target_id", targetIDStr, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidPermissionOverwriteID.Error())
+	}
+
+	overwrite, err := h.overwriteUsecase.Set(ctx, &usecase.SetPermissionOverwriteParams{
+		UserID:     userID,
+		ScopeType:  scopeType,
+		ScopeID:    scopeID,
+		TargetType: toDomainOverwriteTargetType(targetType),
+		TargetID:   targetID,
+		Allow:      domain.Permission(allow),
+		Deny:       domain.Permission(deny),
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to set permission overwrite", "scope_id", scopeID, "target_id", targetID)
+	}
+
+	return toPbPermissionOverwrite(overwrite), nil
+}
+
+func (h *permissionOverwriteHandler) delete(ctx context.Context, scopeType domain.OverwriteScopeType, scopeIDStr, targetIDStr string) error {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return err
+	}
+
+	scopeID, err := h.parseScopeID(scopeType, scopeIDStr)
+	if err != nil {
+		return err
+	}
+
+	targetID, err := uuid.Parse(targetIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid overwrite target ID format", "target_id", targetIDStr, "error", err)
+		return status.Error(codes.InvalidArgument, domain.ErrInvalidPermissionOverwriteID.Error())
+	}
+
+	err = h.overwriteUsecase.Delete(ctx, &usecase.DeletePermissionOverwriteParams{
+		UserID:    userID,
+		ScopeType: scopeType,
+		ScopeID:   scopeID,
+		TargetID:  targetID,
+	})
+	if err != nil {
+		return h.toStatusError(err, "Failed to delete permission overwrite", "scope_id", scopeID, "target_id", targetID)
+	}
+	return nil
+}
+
+func (h *permissionOverwriteHandler) parseScopeID(scopeType domain.OverwriteScopeType, scopeIDStr string) (uuid.UUID, error) {
+	scopeID, err := uuid.Parse(scopeIDStr)
+	if err != nil {
+		if scopeType == domain.OverwriteScopeCategory {
+			h.logger.Warn("Invalid category ID format", "category_id", scopeIDStr, "error", err)
+			return uuid.Nil, status.Error(codes.InvalidArgument, domain.ErrInvalidCategoryID.Error())
+		}
+		h.logger.Warn("Invalid channel ID format", "channel_id", scopeIDStr, "error", err)
+		return uuid.Nil, status.Error(codes.InvalidArgument, domain.ErrInvalidChannelID.Error())
+	}
+	return scopeID, nil
+}
+
+func (h *permissionOverwriteHandler) toStatusError(err error, msg string, args ...any) error {
+	switch err {
+	case domain.ErrChannelNotFound, domain.ErrCategoryNotFound, domain.ErrRoleNotFound, domain.ErrMemberNotFound, domain.ErrPermissionOverwriteNotFound:
+		h.logger.Warn(err.Error(), args...)
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrPermissionDenied:
+		h.logger.Warn("Permission denied", args...)
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidPermissionOverwriteData, domain.ErrInvalidArgument:
+		h.logger.Warn("Invalid permission overwrite data", args...)
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		h.logger.Error(msg, append(args, "error", err)...)
+		return status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+}
+
+func toDomainOverwriteTargetType(targetType pb.PermissionOverwriteTargetType) domain.OverwriteTargetType {
+	switch targetType {
+	case pb.PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_ROLE:
+		return domain.OverwriteTargetRole
+	case pb.PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER:
+		return domain.OverwriteTargetMember
+	}
+	return 0
+}
+
+func toPbPermissionOverwrite(overwrite *domain.PermissionOverwrite) *pb.PermissionOverwrite {
+	targetType := pb.PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_ROLE
+	if overwrite.TargetType == domain.OverwriteTargetMember {
+		targetType = pb.PermissionOverwriteTargetType_PERMISSION_OVERWRITE_TARGET_TYPE_MEMBER
+	}
+	return &pb.PermissionOverwrite{
+		TargetType: targetType,
+		TargetId:   overwrite.TargetID.String(),
+		Allow:      int64(overwrite.Allow),
+		Deny:       int64(overwrite.Deny),
+	}
+}
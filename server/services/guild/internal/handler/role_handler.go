@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/usecase"
+	"log/slog"
+
+	pb "chat-app-proto/gen/guild"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type roleHandler struct {
+	roleUsecase usecase.RoleUsecase
+	logger      *slog.Logger
+}
+
+func NewRoleHandler(roleUsecase usecase.RoleUsecase, logger *slog.Logger) *roleHandler {
+	return &roleHandler{
+		roleUsecase: roleUsecase,
+		logger:      logger,
+	}
+}
+
+func (h *roleHandler) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	roles, err := h.roleUsecase.List(ctx, userID, guildID)
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to list roles", "guild_id", guildID)
+	}
+
+	return &pb.ListRolesResponse{Roles: toPbRoles(roles)}, nil
+}
+
+func (h *roleHandler) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	role, err := h.roleUsecase.Create(ctx, &usecase.CreateRoleParams{
+		GuildID:     guildID,
+		UserID:      userID,
+		Name:        req.Name,
+		Permissions: domain.Permission(req.Permissions),
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to create role", "guild_id", guildID)
+	}
+
+	return &pb.CreateRoleResponse{Role: toPbRole(role)}, nil
+}
+
+func (h *roleHandler) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*pb.UpdateRoleResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		h.logger.Warn("Invalid role ID format", "role_id", req.RoleId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidRoleID.Error())
+	}
+
+	role, err := h.roleUsecase.Update(ctx, &usecase.UpdateRoleParams{
+		RoleID:      roleID,
+		UserID:      userID,
+		Name:        req.Name,
+		Permissions: domain.Permission(req.Permissions),
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to update role", "role_id", roleID)
+	}
+
+	return &pb.UpdateRoleResponse{Role: toPbRole(role)}, nil
+}
+
+func (h *roleHandler) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		h.logger.Warn("Invalid role ID format", "role_id", req.RoleId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidRoleID.Error())
+	}
+
+	err = h.roleUsecase.Delete(ctx, &usecase.DeleteRoleParams{
+		RoleID: roleID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to delete role", "role_id", roleID)
+	}
+
+	return &pb.DeleteRoleResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *roleHandler) ReorderRoles(ctx context.Context, req *pb.ReorderRolesRequest) (*pb.ReorderRolesResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	roleIDs, err := parseUUIDs(req.RoleIds)
+	if err != nil {
+		h.logger.Warn("Invalid role ID format", "role_ids", req.RoleIds, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidRoleID.Error())
+	}
+
+	roles, err := h.roleUsecase.Reorder(ctx, &usecase.ReorderRolesParams{
+		GuildID: guildID,
+		UserID:  userID,
+		RoleIDs: roleIDs,
+	})
+	if err != nil {
+		return nil, h.toStatusError(err, "Failed to reorder roles", "guild_id", guildID)
+	}
+
+	return &pb.ReorderRolesResponse{Roles: toPbRoles(roles)}, nil
+}
+
+func (h *roleHandler) AddMemberRole(ctx context.Context, req *pb.AddMemberRoleRequest) (*pb.AddMemberRoleResponse, error) {
+	params, err := h.parseMemberRoleParams(ctx, req.GuildId, req.UserId, req.RoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.roleUsecase.AddToMember(ctx, params); err != nil {
+		return nil, h.toStatusError(err, "Failed to add role to member", "guild_id", params.GuildID, "role_id", params.RoleID)
+	}
+
+	return &pb.AddMemberRoleResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *roleHandler) RemoveMemberRole(ctx context.Context, req *pb.RemoveMemberRoleRequest) (*pb.RemoveMemberRoleResponse, error) {
+	params, err := h.parseMemberRoleParams(ctx, req.GuildId, req.UserId, req.RoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.roleUsecase.RemoveFromMember(ctx, params); err != nil {
+		return nil, h.toStatusError(err, "Failed to remove role from member", "guild_id", params.GuildID, "role_id", params.RoleID)
+	}
+
+	return &pb.RemoveMemberRoleResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *roleHandler) parseMemberRoleParams(ctx context.Context, guildIDStr, targetUserIDStr, roleIDStr string) (*usecase.MemberRoleParams, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(guildIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", guildIDStr, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidGuildID.Error())
+	}
+
+	targetUserID, err := uuid.Parse(targetUserIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid member ID format", "user_id", targetUserIDStr, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMemberID.Error())
+	}
+
+	roleID, err := uuid.Parse(roleIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid role ID format", "role_id", roleIDStr, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidRoleID.Error())
+	}
+
+	return &usecase.MemberRoleParams{
+		GuildID:      guildID,
+		UserID:       userID,
+		TargetUserID: targetUserID,
+		RoleID:       roleID,
+	}, nil
+}
+
+func (h *roleHandler) toStatusError(err error, msg string, args ...any) error {
+	switch err {
+	case domain.ErrGuildNotFound, domain.ErrRoleNotFound, domain.ErrMemberNotFound:
+		h.logger.Warn(err.Error(), args...)
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ErrPermissionDenied:
+		h.logger.Warn("Permission denied", args...)
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ErrInvalidRoleData, domain.ErrInvalidArgument:
+		h.logger.Warn("Invalid role data", args...)
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.ErrEveryoneRoleImmutable:
+		h.logger.Warn("@everyone role is immutable", args...)
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		h.logger.Error(msg, append(args, "error", err)...)
+		return status.Error(codes.Internal, domain.ErrInternalServerError.Error())
+	}
+}
+
+func toPbRole(role *domain.Role) *pb.Role {
+	return &pb.Role{
+		Id:          role.ID.String(),
+		GuildId:     role.GuildID.String(),
+		Name:        role.Name,
+		Permissions: int64(role.Permissions),
+		Position:    role.Position,
+		CreatedAt:   timestamppb.New(role.CreatedAt),
+	}
+}
+
+func toPbRoles(roles []*domain.Role) []*pb.Role {
+	pbRoles := make([]*pb.Role, len(roles))
+	for i, role := range roles {
+		pbRoles[i] = toPbRole(role)
+	}
+	return pbRoles
+}
@@ -8,25 +8,40 @@ import (
 
 type GuildServiceHandler struct {
 	pb.UnimplementedGuildServiceServer
-	guildHandler    *guildHandler
-	categoryHandler *categoryHandler
-	channelHandler  *channelHandler
-	inviteHandler   *inviteHandler
+	guildHandler     *guildHandler
+	categoryHandler  *categoryHandler
+	channelHandler   *channelHandler
+	inviteHandler    *inviteHandler
+	memberHandler    *memberHandler
+	roleHandler      *roleHandler
+	overwriteHandler *permissionOverwriteHandler
+	banHandler       *banHandler
+	auditLogHandler  *auditLogHandler
 }
 
 type NewGuildServiceHandlerParams struct {
-	GuildHandler    *guildHandler
-	CategoryHandler *categoryHandler
-	ChannelHandler  *channelHandler
-	InviteHandler   *inviteHandler
+	GuildHandler     *guildHandler
+	CategoryHandler  *categoryHandler
+	ChannelHandler   *channelHandler
+	InviteHandler    *inviteHandler
+	MemberHandler    *memberHandler
+	RoleHandler      *roleHandler
+	OverwriteHandler *permissionOverwriteHandler
+	BanHandler       *banHandler
+	AuditLogHandler  *auditLogHandler
 }
 
 func NewGuildServiceHandler(params *NewGuildServiceHandlerParams) *GuildServiceHandler {
 	return &GuildServiceHandler{
-		guildHandler:    params.GuildHandler,
-		categoryHandler: params.CategoryHandler,
-		channelHandler:  params.ChannelHandler,
-		inviteHandler:   params.InviteHandler,
+		guildHandler:     params.GuildHandler,
+		categoryHandler:  params.CategoryHandler,
+		channelHandler:   params.ChannelHandler,
+		inviteHandler:    params.InviteHandler,
+		memberHandler:    params.MemberHandler,
+		roleHandler:      params.RoleHandler,
+		overwriteHandler: params.OverwriteHandler,
+		banHandler:       params.BanHandler,
+		auditLogHandler:  params.AuditLogHandler,
 	}
 }
 
@@ -46,18 +61,74 @@ func (h *GuildServiceHandler) UpdateGuild(ctx context.Context, req *pb.UpdateGui
 	return h.guildHandler.UpdateGuild(ctx, req)
 }
 
+func (h *GuildServiceHandler) DeleteGuild(ctx context.Context, req *pb.DeleteGuildRequest) (*pb.DeleteGuildResponse, error) {
+	return h.guildHandler.DeleteGuild(ctx, req)
+}
+
+func (h *GuildServiceHandler) TransferGuildOwnership(ctx context.Context, req *pb.TransferGuildOwnershipRequest) (*pb.TransferGuildOwnershipResponse, error) {
+	return h.guildHandler.TransferGuildOwnership(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	return h.auditLogHandler.ListAuditLog(ctx, req)
+}
+
 func (h *GuildServiceHandler) GetGuildOverview(ctx context.Context, req *pb.GetGuildOverviewRequest) (*pb.GetGuildOverviewResponse, error) {
 	return h.guildHandler.GetGuildOverview(ctx, req)
 }
 
+func (h *GuildServiceHandler) DeleteGuildMember(ctx context.Context, req *pb.DeleteGuildMemberRequest) (*pb.DeleteGuildMemberResponse, error) {
+	return h.memberHandler.DeleteGuildMember(ctx, req)
+}
+
+func (h *GuildServiceHandler) LeaveGuild(ctx context.Context, req *pb.LeaveGuildRequest) (*pb.LeaveGuildResponse, error) {
+	return h.memberHandler.LeaveGuild(ctx, req)
+}
+
+func (h *GuildServiceHandler) TimeoutMember(ctx context.Context, req *pb.TimeoutMemberRequest) (*pb.TimeoutMemberResponse, error) {
+	return h.memberHandler.TimeoutMember(ctx, req)
+}
+
+func (h *GuildServiceHandler) BanMember(ctx context.Context, req *pb.BanMemberRequest) (*pb.BanMemberResponse, error) {
+	return h.banHandler.BanMember(ctx, req)
+}
+
+func (h *GuildServiceHandler) UnbanMember(ctx context.Context, req *pb.UnbanMemberRequest) (*pb.UnbanMemberResponse, error) {
+	return h.banHandler.UnbanMember(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListBans(ctx context.Context, req *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	return h.banHandler.ListBans(ctx, req)
+}
+
 func (h *GuildServiceHandler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
 	return h.categoryHandler.CreateCategory(ctx, req)
 }
 
+func (h *GuildServiceHandler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	return h.categoryHandler.UpdateCategory(ctx, req)
+}
+
+func (h *GuildServiceHandler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	return h.categoryHandler.DeleteCategory(ctx, req)
+}
+
 func (h *GuildServiceHandler) CreateChannel(ctx context.Context, req *pb.CreateChannelRequest) (*pb.CreateChannelResponse, error) {
 	return h.channelHandler.CreateChannel(ctx, req)
 }
 
+func (h *GuildServiceHandler) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.UpdateChannelResponse, error) {
+	return h.channelHandler.UpdateChannel(ctx, req)
+}
+
+func (h *GuildServiceHandler) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.DeleteChannelResponse, error) {
+	return h.channelHandler.DeleteChannel(ctx, req)
+}
+
+func (h *GuildServiceHandler) ReorderChannels(ctx context.Context, req *pb.ReorderChannelsRequest) (*pb.ReorderChannelsResponse, error) {
+	return h.channelHandler.ReorderChannels(ctx, req)
+}
+
 func (h *GuildServiceHandler) CreateGuildInvite(ctx context.Context, req *pb.CreateGuildInviteRequest) (*pb.CreateGuildInviteResponse, error) {
 	return h.inviteHandler.CreateGuildInvite(ctx, req)
 }
@@ -66,6 +137,18 @@ func (h *GuildServiceHandler) GetGuildInvites(ctx context.Context, req *pb.GetGu
 	return h.inviteHandler.GetGuildInvites(ctx, req)
 }
 
+func (h *GuildServiceHandler) DeleteGuildInvite(ctx context.Context, req *pb.DeleteGuildInviteRequest) (*pb.DeleteGuildInviteResponse, error) {
+	return h.inviteHandler.DeleteGuildInvite(ctx, req)
+}
+
+func (h *GuildServiceHandler) SetGuildVanityInvite(ctx context.Context, req *pb.SetGuildVanityInviteRequest) (*pb.SetGuildVanityInviteResponse, error) {
+	return h.inviteHandler.SetGuildVanityInvite(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListGuildInviteUses(ctx context.Context, req *pb.ListGuildInviteUsesRequest) (*pb.ListGuildInviteUsesResponse, error) {
+	return h.inviteHandler.ListGuildInviteUses(ctx, req)
+}
+
 func (h *GuildServiceHandler) JoinGuild(ctx context.Context, req *pb.JoinGuildRequest) (*pb.JoinGuildResponse, error) {
 	return h.inviteHandler.JoinGuild(ctx, req)
 }
@@ -79,4 +162,72 @@ func (h *GuildServiceHandler) CheckChannelAccess(ctx context.Context, req *pb.Ch
 	return h.channelHandler.CheckChannelAccess(ctx, req)
 }
 
+func (h *GuildServiceHandler) FilterMentionTargets(ctx context.Context, req *pb.FilterMentionTargetsRequest) (*pb.FilterMentionTargetsResponse, error) {
+	return h.channelHandler.FilterMentionTargets(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListAccessibleChannelIDs(ctx context.Context, req *pb.ListAccessibleChannelIDsRequest) (*pb.ListAccessibleChannelIDsResponse, error) {
+	return h.channelHandler.ListAccessibleChannelIDs(ctx, req)
+}
+
+func (h *GuildServiceHandler) BatchCheckChannelAccess(ctx context.Context, req *pb.BatchCheckChannelAccessRequest) (*pb.BatchCheckChannelAccessResponse, error) {
+	return h.channelHandler.BatchCheckChannelAccess(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListUserGuildIDs(ctx context.Context, req *pb.ListUserGuildIDsRequest) (*pb.ListUserGuildIDsResponse, error) {
+	return h.guildHandler.ListUserGuildIDs(ctx, req)
+}
+
 var _ pb.GuildServiceServer = (*GuildServiceHandler)(nil)
+
+func (h *GuildServiceHandler) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	return h.roleHandler.ListRoles(ctx, req)
+}
+
+func (h *GuildServiceHandler) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+	return h.roleHandler.CreateRole(ctx, req)
+}
+
+func (h *GuildServiceHandler) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*pb.UpdateRoleResponse, error) {
+	return h.roleHandler.UpdateRole(ctx, req)
+}
+
+func (h *GuildServiceHandler) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	return h.roleHandler.DeleteRole(ctx, req)
+}
+
+func (h *GuildServiceHandler) ReorderRoles(ctx context.Context, req *pb.ReorderRolesRequest) (*pb.ReorderRolesResponse, error) {
+	return h.roleHandler.ReorderRoles(ctx, req)
+}
+
+func (h *GuildServiceHandler) AddMemberRole(ctx context.Context, req *pb.AddMemberRoleRequest) (*pb.AddMemberRoleResponse, error) {
+	return h.roleHandler.AddMemberRole(ctx, req)
+}
+
+func (h *GuildServiceHandler) RemoveMemberRole(ctx context.Context, req *pb.RemoveMemberRoleRequest) (*pb.RemoveMemberRoleResponse, error) {
+	return h.roleHandler.RemoveMemberRole(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListChannelPermissionOverwrites(ctx context.Context, req *pb.ListChannelPermissionOverwritesRequest) (*pb.ListChannelPermissionOverwritesResponse, error) {
+	return h.overwriteHandler.ListChannelPermissionOverwrites(ctx, req)
+}
+
+func (h *GuildServiceHandler) SetChannelPermissionOverwrite(ctx context.Context, req *pb.SetChannelPermissionOverwriteRequest) (*pb.SetChannelPermissionOverwriteResponse, error) {
+	return h.overwriteHandler.SetChannelPermissionOverwrite(ctx, req)
+}
+
+func (h *GuildServiceHandler) DeleteChannelPermissionOverwrite(ctx context.Context, req *pb.DeleteChannelPermissionOverwriteRequest) (*pb.DeleteChannelPermissionOverwriteResponse, error) {
+	return h.overwriteHandler.DeleteChannelPermissionOverwrite(ctx, req)
+}
+
+func (h *GuildServiceHandler) ListCategoryPermissionOverwrites(ctx context.Context, req *pb.ListCategoryPermissionOverwritesRequest) (*pb.ListCategoryPermissionOverwritesResponse, error) {
+	return h.overwriteHandler.ListCategoryPermissionOverwrites(ctx, req)
+}
+
+func (h *GuildServiceHandler) SetCategoryPermissionOverwrite(ctx context.Context, req *pb.SetCategoryPermissionOverwriteRequest) (*pb.SetCategoryPermissionOverwriteResponse, error) {
+	return h.overwriteHandler.SetCategoryPermissionOverwrite(ctx, req)
+}
+
+func (h *GuildServiceHandler) DeleteCategoryPermissionOverwrite(ctx context.Context, req *pb.DeleteCategoryPermissionOverwriteRequest) (*pb.DeleteCategoryPermissionOverwriteResponse, error) {
+	return h.overwriteHandler.DeleteCategoryPermissionOverwrite(ctx, req)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"guild-service/internal/domain"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 )
 
@@ -30,4 +31,16 @@ func (c *mediaServiceClient) GetPresignedUploadURL(ctx context.Context, mediaTyp
 	return res.UploadUrl, nil
 }
 
+func (c *mediaServiceClient) PurgeChannelAttachments(ctx context.Context, channelIDs []uuid.UUID) error {
+	channelIDStrs := make([]string, len(channelIDs))
+	for i, id := range channelIDs {
+		channelIDStrs[i] = id.String()
+	}
+
+	_, err := c.client.PurgeChannelAttachments(ctx, &pb.PurgeChannelAttachmentsRequest{
+		ChannelIds: channelIDStrs,
+	})
+	return err
+}
+
 var _ domain.IMediaService = (*mediaServiceClient)(nil)
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	pb "chat-app-proto/gen/message"
+	"context"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type messageServiceClient struct {
+	client pb.MessageServiceClient
+}
+
+func NewMessageServiceClient(conn *grpc.ClientConn) *messageServiceClient {
+	return &messageServiceClient{
+		client: pb.NewMessageServiceClient(conn),
+	}
+}
+
+func (c *messageServiceClient) GetUnreadCounts(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) (map[uuid.UUID]*domain.ChannelUnread, error) {
+	channelIDStrs := make([]string, len(channelIDs))
+	for i, id := range channelIDs {
+		channelIDStrs[i] = id.String()
+	}
+
+	res, err := c.client.GetUnreadCounts(ctx, &pb.GetUnreadCountsRequest{
+		UserId:     userID.String(),
+		ChannelIds: channelIDStrs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unreads := make(map[uuid.UUID]*domain.ChannelUnread, len(res.Unreads))
+	for _, unread := range res.Unreads {
+		channelID, err := uuid.Parse(unread.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+		u := &domain.ChannelUnread{
+			UnreadCount:  unread.UnreadCount,
+			MentionCount: unread.MentionCount,
+		}
+		if unread.LastReadMessageId != nil {
+			lastReadMessageID, err := uuid.Parse(*unread.LastReadMessageId)
+			if err != nil {
+				return nil, err
+			}
+			u.LastReadMessageID = &lastReadMessageID
+		}
+		unreads[channelID] = u
+	}
+	return unreads, nil
+}
+
+func (c *messageServiceClient) DeleteUserMessages(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID, since time.Time) (int32, error) {
+	channelIDStrs := make([]string, len(channelIDs))
+	for i, id := range channelIDs {
+		channelIDStrs[i] = id.String()
+	}
+
+	res, err := c.client.DeleteUserMessages(ctx, &pb.DeleteUserMessagesRequest{
+		UserId:     userID.String(),
+		ChannelIds: channelIDStrs,
+		Since:      timestamppb.New(since),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func (c *messageServiceClient) PurgeChannelMessages(ctx context.Context, channelIDs []uuid.UUID) error {
+	channelIDStrs := make([]string, len(channelIDs))
+	for i, id := range channelIDs {
+		channelIDStrs[i] = id.String()
+	}
+
+	_, err := c.client.PurgeChannelMessages(ctx, &pb.PurgeChannelMessagesRequest{
+		ChannelIds: channelIDStrs,
+	})
+	return err
+}
+
+var _ domain.IMessageService = (*messageServiceClient)(nil)
@@ -87,4 +87,16 @@ func (c *userServiceClient) GetUsersByIDs(ids []uuid.UUID) ([]*domain.User, erro
 	return users, nil
 }
 
+func (c *userServiceClient) VerifyPassword(userID uuid.UUID, password string) (bool, error) {
+	req := &pb.VerifyPasswordRequest{
+		UserId:   userID.String(),
+		Password: password,
+	}
+	res, err := c.client.VerifyPassword(context.Background(), req)
+	if err != nil {
+		return false, err
+	}
+	return res.Valid, nil
+}
+
 var _ domain.IUserService = (*userServiceClient)(nil)
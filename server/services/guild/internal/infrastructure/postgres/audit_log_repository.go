@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"guild-service/internal/domain"
+	"guild-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type auditLogRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresAuditLogRepository(queries *gen.Queries) *auditLogRepository {
+	return &auditLogRepository{
+		queries: queries,
+	}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	changes, err := json.Marshal(log.Changes)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.CreateAuditLog(ctx, gen.CreateAuditLogParams{
+		ID:         log.ID,
+		GuildID:    log.GuildID,
+		ActorID:    log.ActorID,
+		ActionType: string(log.Action),
+		TargetID:   log.TargetID,
+		Changes:    changes,
+		Reason:     log.Reason,
+		CreatedAt:  log.CreatedAt,
+	})
+}
+
+func (r *auditLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AuditLog, error) {
+	dbLog, err := r.queries.GetAuditLogByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrAuditLogNotFound
+		}
+		return nil, err
+	}
+	return toDomainAuditLog(dbLog)
+}
+
+func (r *auditLogRepository) List(ctx context.Context, query *domain.AuditLogQuery) ([]*domain.AuditLog, error) {
+	params := gen.ListAuditLogsParams{
+		GuildID:    query.GuildID,
+		ActorID:    query.ActorID,
+		ActionType: (*string)(query.Action),
+		TargetID:   query.TargetID,
+		RowLimit:   query.Limit,
+	}
+	if query.Cursor != nil {
+		params.CursorID = &query.Cursor.ID
+		params.CursorCreatedAt = &query.Cursor.CreatedAt
+	}
+
+	dbLogs, err := r.queries.ListAuditLogs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]*domain.AuditLog, len(dbLogs))
+	for i, dbLog := range dbLogs {
+		logs[i], err = toDomainAuditLog(dbLog)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return logs, nil
+}
+
+func toDomainAuditLog(dbLog *gen.AuditLog) (*domain.AuditLog, error) {
+	changes := []*domain.AuditLogChange{}
+	if err := json.Unmarshal(dbLog.Changes, &changes); err != nil {
+		return nil, err
+	}
+	return &domain.AuditLog{
+		ID:        dbLog.ID,
+		GuildID:   dbLog.GuildID,
+		ActorID:   dbLog.ActorID,
+		Action:    domain.AuditLogAction(dbLog.ActionType),
+		TargetID:  dbLog.TargetID,
+		Changes:   changes,
+		Reason:    dbLog.Reason,
+		CreatedAt: dbLog.CreatedAt,
+	}, nil
+}
+
+var _ domain.IAuditLogRepository = (*auditLogRepository)(nil)
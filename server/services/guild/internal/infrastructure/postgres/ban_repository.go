@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+)
+
+type banRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresBanRepository(queries *gen.Queries) *banRepository {
+	return &banRepository{
+		queries: queries,
+	}
+}
+
+func (r *banRepository) Upsert(ctx context.Context, ban *domain.Ban) (*domain.Ban, error) {
+	dbBan, err := r.queries.UpsertBan(ctx, gen.UpsertBanParams{
+		GuildID:     ban.GuildID,
+		UserID:      ban.UserID,
+		ModeratorID: ban.ModeratorID,
+		Reason:      ban.Reason,
+		ExpiresAt:   ban.ExpiresAt,
+		CreatedAt:   ban.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBan(dbBan), nil
+}
+
+func (r *banRepository) GetActiveByGuildID(ctx context.Context, guildID uuid.UUID) ([]*domain.Ban, error) {
+	dbBans, err := r.queries.GetActiveBansByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	bans := make([]*domain.Ban, len(dbBans))
+	for i, dbBan := range dbBans {
+		bans[i] = toDomainBan(dbBan)
+	}
+	return bans, nil
+}
+
+func (r *banRepository) IsBanned(ctx context.Context, guildID, userID uuid.UUID) (bool, error) {
+	return r.queries.IsBanned(ctx, gen.IsBannedParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+}
+
+func (r *banRepository) Delete(ctx context.Context, guildID, userID uuid.UUID) error {
+	rows, err := r.queries.DeleteBan(ctx, gen.DeleteBanParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrBanNotFound
+	}
+	return nil
+}
+
+func toDomainBan(dbBan *gen.Ban) *domain.Ban {
+	return &domain.Ban{
+		GuildID:     dbBan.GuildID,
+		UserID:      dbBan.UserID,
+		ModeratorID: dbBan.ModeratorID,
+		Reason:      dbBan.Reason,
+		ExpiresAt:   dbBan.ExpiresAt,
+		CreatedAt:   dbBan.CreatedAt,
+	}
+}
+
+var _ domain.IBanRepository = (*banRepository)(nil)
@@ -6,6 +6,7 @@ import (
 	"guild-service/internal/infrastructure/postgres/gen"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type categoryRepository struct {
@@ -32,6 +33,7 @@ func (r *categoryRepository) Create(ctx context.Context, category *domain.Catego
 		ID:        dbCategory.ID,
 		GuildID:   dbCategory.GuildID,
 		Name:      dbCategory.Name,
+		Position:  dbCategory.Position,
 		CreatedAt: dbCategory.CreatedAt,
 	}, nil
 }
@@ -47,6 +49,7 @@ func (r *categoryRepository) GetByGuildID(ctx context.Context, guildID uuid.UUID
 			ID:        dbCategory.ID,
 			GuildID:   dbCategory.GuildID,
 			Name:      dbCategory.Name,
+			Position:  dbCategory.Position,
 			CreatedAt: dbCategory.CreatedAt,
 		}
 	}
@@ -56,9 +59,87 @@ func (r *categoryRepository) GetByGuildID(ctx context.Context, guildID uuid.UUID
 func (r *categoryRepository) GetGuildIDByCategoryID(ctx context.Context, categoryID uuid.UUID) (uuid.UUID, error) {
 	guildID, err := r.queries.GetGuildIDByCategoryID(ctx, categoryID)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, domain.ErrCategoryNotFound
+		}
 		return uuid.Nil, err
 	}
 	return guildID, nil
 }
 
+func (r *categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Category, error) {
+	dbCategory, err := r.queries.GetCategoryByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &domain.Category{
+		ID:        dbCategory.ID,
+		GuildID:   dbCategory.GuildID,
+		Name:      dbCategory.Name,
+		Position:  dbCategory.Position,
+		CreatedAt: dbCategory.CreatedAt,
+	}, nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	dbCategory, err := r.queries.UpdateCategory(ctx, gen.UpdateCategoryParams{
+		ID:   category.ID,
+		Name: category.Name,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &domain.Category{
+		ID:        dbCategory.ID,
+		GuildID:   dbCategory.GuildID,
+		Name:      dbCategory.Name,
+		Position:  dbCategory.Position,
+		CreatedAt: dbCategory.CreatedAt,
+	}, nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	rows, err := r.queries.DeleteCategory(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *categoryRepository) GetOldestExcept(ctx context.Context, guildID, excludeID uuid.UUID) (*domain.Category, error) {
+	dbCategory, err := r.queries.GetOldestCategoryExcept(ctx, gen.GetOldestCategoryExceptParams{
+		GuildID: guildID,
+		ID:      excludeID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &domain.Category{
+		ID:        dbCategory.ID,
+		GuildID:   dbCategory.GuildID,
+		Name:      dbCategory.Name,
+		Position:  dbCategory.Position,
+		CreatedAt: dbCategory.CreatedAt,
+	}, nil
+}
+
+func (r *categoryRepository) UpdatePositions(ctx context.Context, guildID uuid.UUID, categoryIDs []uuid.UUID) error {
+	return r.queries.UpdateCategoryPositions(ctx, gen.UpdateCategoryPositionsParams{
+		GuildID:     guildID,
+		CategoryIds: categoryIDs,
+	})
+}
+
 var _ domain.ICategoryRepository = (*categoryRepository)(nil)
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/infrastructure/postgres/gen"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type channelPurgeJobRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresChannelPurgeJobRepository(queries *gen.Queries) *channelPurgeJobRepository {
+	return &channelPurgeJobRepository{
+		queries: queries,
+	}
+}
+
+func (r *channelPurgeJobRepository) CreateMany(ctx context.Context, jobs []*domain.ChannelPurgeJob) error {
+	for _, job := range jobs {
+		err := r.queries.CreateChannelPurgeJob(ctx, gen.CreateChannelPurgeJobParams{
+			ID:        job.ID,
+			ChannelID: job.ChannelID,
+			Target:    string(job.Target),
+			Attempts:  job.Attempts,
+			NextRunAt: job.NextRunAt,
+			CreatedAt: job.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *channelPurgeJobRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]*domain.ChannelPurgeJob, error) {
+	dbJobs, err := r.queries.ClaimDueChannelPurgeJobs(ctx, gen.ClaimDueChannelPurgeJobsParams{
+		LeaseUntil: leaseUntil,
+		Now:        now,
+		RowLimit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*domain.ChannelPurgeJob, len(dbJobs))
+	for i, dbJob := range dbJobs {
+		jobs[i] = &domain.ChannelPurgeJob{
+			ID:        dbJob.ID,
+			ChannelID: dbJob.ChannelID,
+			Target:    domain.ChannelPurgeTarget(dbJob.Target),
+			Attempts:  dbJob.Attempts,
+			NextRunAt: dbJob.NextRunAt,
+			LastError: dbJob.LastError,
+			CreatedAt: dbJob.CreatedAt,
+		}
+	}
+	return jobs, nil
+}
+
+func (r *channelPurgeJobRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteChannelPurgeJob(ctx, id)
+}
+
+func (r *channelPurgeJobRepository) Reschedule(ctx context.Context, id uuid.UUID, nextRunAt time.Time, lastError string) error {
+	return r.queries.RescheduleChannelPurgeJob(ctx, gen.RescheduleChannelPurgeJobParams{
+		ID:        id,
+		NextRunAt: nextRunAt,
+		LastError: &lastError,
+	})
+}
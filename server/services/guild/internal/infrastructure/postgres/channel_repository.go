@@ -6,6 +6,7 @@ import (
 	"guild-service/internal/infrastructure/postgres/gen"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type channelRepository struct {
@@ -32,10 +33,91 @@ func (r *channelRepository) Create(ctx context.Context, channel *domain.Channel)
 		ID:         dbChannel.ID,
 		CategoryID: dbChannel.CategoryID,
 		Name:       dbChannel.Name,
+		Position:   dbChannel.Position,
 		CreatedAt:  dbChannel.CreatedAt,
 	}, nil
 }
 
+func (r *channelRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Channel, error) {
+	dbChannel, err := r.queries.GetChannelByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrChannelNotFound
+		}
+		return nil, err
+	}
+	return &domain.Channel{
+		ID:         dbChannel.ID,
+		CategoryID: dbChannel.CategoryID,
+		Name:       dbChannel.Name,
+		Position:   dbChannel.Position,
+		CreatedAt:  dbChannel.CreatedAt,
+	}, nil
+}
+
+func (r *channelRepository) GetGuildIDByChannelID(ctx context.Context, channelID uuid.UUID) (uuid.UUID, error) {
+	guildID, err := r.queries.GetGuildIDByChannelID(ctx, channelID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, domain.ErrChannelNotFound
+		}
+		return uuid.Nil, err
+	}
+	return guildID, nil
+}
+
+func (r *channelRepository) Update(ctx context.Context, channel *domain.Channel) (*domain.Channel, error) {
+	dbChannel, err := r.queries.UpdateChannel(ctx, gen.UpdateChannelParams{
+		ID:   channel.ID,
+		Name: channel.Name,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrChannelNotFound
+		}
+		return nil, err
+	}
+	return &domain.Channel{
+		ID:         dbChannel.ID,
+		CategoryID: dbChannel.CategoryID,
+		Name:       dbChannel.Name,
+		Position:   dbChannel.Position,
+		CreatedAt:  dbChannel.CreatedAt,
+	}, nil
+}
+
+func (r *channelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	rows, err := r.queries.DeleteChannel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrChannelNotFound
+	}
+	return nil
+}
+
+func (r *channelRepository) MoveToCategory(ctx context.Context, fromCategoryID, toCategoryID uuid.UUID) ([]*domain.Channel, error) {
+	dbChannels, err := r.queries.MoveChannelsToCategory(ctx, gen.MoveChannelsToCategoryParams{
+		FromCategoryID: fromCategoryID,
+		ToCategoryID:   toCategoryID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	channels := make([]*domain.Channel, len(dbChannels))
+	for i, dbChannel := range dbChannels {
+		channels[i] = &domain.Channel{
+			ID:         dbChannel.ID,
+			CategoryID: dbChannel.CategoryID,
+			Name:       dbChannel.Name,
+			Position:   dbChannel.Position,
+			CreatedAt:  dbChannel.CreatedAt,
+		}
+	}
+	return channels, nil
+}
+
 func (r *channelRepository) GetByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]*domain.Channel, error) {
 	dbChannels, err := r.queries.GetByCategoryID(ctx, categoryID)
 	if err != nil {
@@ -47,21 +129,69 @@ func (r *channelRepository) GetByCategoryID(ctx context.Context, categoryID uuid
 			ID:         dbChannel.ID,
 			CategoryID: dbChannel.CategoryID,
 			Name:       dbChannel.Name,
+			Position:   dbChannel.Position,
 			CreatedAt:  dbChannel.CreatedAt,
 		}
 	}
 	return channels, nil
 }
 
-func (r *channelRepository) CheckChannelMember(ctx context.Context, userID, channelID uuid.UUID) (bool, error) {
-	isMember, err := r.queries.CheckChannelMember(ctx, gen.CheckChannelMemberParams{
-		UserID: userID,
-		ID:     channelID,
-	})
+func (r *channelRepository) GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*domain.Channel, error) {
+	dbChannels, err := r.queries.GetChannelsByGuildID(ctx, guildID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return isMember, nil
+	channels := make([]*domain.Channel, len(dbChannels))
+	for i, dbChannel := range dbChannels {
+		channels[i] = &domain.Channel{
+			ID:         dbChannel.ID,
+			CategoryID: dbChannel.CategoryID,
+			Name:       dbChannel.Name,
+			Position:   dbChannel.Position,
+			CreatedAt:  dbChannel.CreatedAt,
+		}
+	}
+	return channels, nil
+}
+
+func (r *channelRepository) GroupByGuildID(ctx context.Context, channelIDs []uuid.UUID) (map[uuid.UUID][]*domain.Channel, error) {
+	rows, err := r.queries.GetChannelsWithGuildIDByIDs(ctx, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+	channels := make(map[uuid.UUID][]*domain.Channel)
+	for _, row := range rows {
+		channels[row.GuildID] = append(channels[row.GuildID], &domain.Channel{
+			ID:         row.ID,
+			CategoryID: row.CategoryID,
+			Name:       row.Name,
+			Position:   row.Position,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return channels, nil
+}
+
+func (r *channelRepository) FilterGuildMembers(ctx context.Context, channelID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.FilterChannelGuildMembers(ctx, gen.FilterChannelGuildMembersParams{
+		ChannelID: channelID,
+		UserIds:   userIDs,
+	})
+}
+
+func (r *channelRepository) FilterSameGuildChannels(ctx context.Context, channelID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.FilterSameGuildChannels(ctx, gen.FilterSameGuildChannelsParams{
+		ChannelID:  channelID,
+		ChannelIds: channelIDs,
+	})
+}
+
+func (r *channelRepository) UpdatePositions(ctx context.Context, guildID, categoryID uuid.UUID, channelIDs []uuid.UUID) error {
+	return r.queries.UpdateChannelPositions(ctx, gen.UpdateChannelPositionsParams{
+		GuildID:    guildID,
+		CategoryID: categoryID,
+		ChannelIds: channelIDs,
+	})
 }
 
 var _ domain.IChannelRepository = (*channelRepository)(nil)
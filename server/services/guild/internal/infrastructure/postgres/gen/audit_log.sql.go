@@ -0,0 +1,126 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_log.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAuditLog = `-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (id, guild_id, actor_id, action_type, target_id, changes, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateAuditLogParams struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ActorID    *uuid.UUID
+	ActionType string
+	TargetID   *uuid.UUID
+	Changes    []byte
+	Reason     string
+	CreatedAt  time.Time
+}
+
+func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error {
+	_, err := q.db.Exec(ctx, createAuditLog,
+		arg.ID,
+		arg.GuildID,
+		arg.ActorID,
+		arg.ActionType,
+		arg.TargetID,
+		arg.Changes,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getAuditLogByID = `-- name: GetAuditLogByID :one
+SELECT id, guild_id, actor_id, action_type, target_id, changes, reason, created_at
+FROM audit_logs
+WHERE id = $1
+`
+
+func (q *Queries) GetAuditLogByID(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	row := q.db.QueryRow(ctx, getAuditLogByID, id)
+	var i AuditLog
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.ActorID,
+		&i.ActionType,
+		&i.TargetID,
+		&i.Changes,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listAuditLogs = `-- name: ListAuditLogs :many
+SELECT id, guild_id, actor_id, action_type, target_id, changes, reason, created_at
+FROM audit_logs
+WHERE guild_id = $1
+  AND ($2::uuid IS NULL OR actor_id = $2::uuid)
+  AND ($3::varchar IS NULL OR action_type = $3::varchar)
+  AND ($4::uuid IS NULL OR target_id = $4::uuid)
+  AND ($5::uuid IS NULL
+    OR (created_at, id) < ($6::timestamp, $5::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $7
+`
+
+type ListAuditLogsParams struct {
+	GuildID         uuid.UUID
+	ActorID         *uuid.UUID
+	ActionType      *string
+	TargetID        *uuid.UUID
+	CursorID        *uuid.UUID
+	CursorCreatedAt *time.Time
+	RowLimit        int32
+}
+
+// idx_audit_logs_guild_created_atを使い、同時刻の記録はidで順序を安定させる
+func (q *Queries) ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]*AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLogs,
+		arg.GuildID,
+		arg.ActorID,
+		arg.ActionType,
+		arg.TargetID,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.ActorID,
+			&i.ActionType,
+			&i.TargetID,
+			&i.Changes,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
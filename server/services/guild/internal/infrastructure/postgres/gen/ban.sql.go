@@ -0,0 +1,123 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ban.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteBan = `-- name: DeleteBan :execrows
+DELETE FROM bans
+WHERE guild_id = $1 AND user_id = $2
+`
+
+type DeleteBanParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (q *Queries) DeleteBan(ctx context.Context, arg DeleteBanParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBan, arg.GuildID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActiveBansByGuildID = `-- name: GetActiveBansByGuildID :many
+SELECT guild_id, user_id, moderator_id, reason, expires_at, created_at
+FROM bans
+WHERE guild_id = $1 AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetActiveBansByGuildID(ctx context.Context, guildID uuid.UUID) ([]*Ban, error) {
+	rows, err := q.db.Query(ctx, getActiveBansByGuildID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Ban
+	for rows.Next() {
+		var i Ban
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.UserID,
+			&i.ModeratorID,
+			&i.Reason,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isBanned = `-- name: IsBanned :one
+SELECT EXISTS (
+  SELECT 1 FROM bans
+  WHERE guild_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+)
+`
+
+type IsBannedParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (q *Queries) IsBanned(ctx context.Context, arg IsBannedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isBanned, arg.GuildID, arg.UserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const upsertBan = `-- name: UpsertBan :one
+INSERT INTO bans (guild_id, user_id, moderator_id, reason, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (guild_id, user_id) DO UPDATE
+SET moderator_id = EXCLUDED.moderator_id, reason = EXCLUDED.reason, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+RETURNING guild_id, user_id, moderator_id, reason, expires_at, created_at
+`
+
+type UpsertBanParams struct {
+	GuildID     uuid.UUID
+	UserID      uuid.UUID
+	ModeratorID *uuid.UUID
+	Reason      string
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// 再BANの場合は理由・実行者・期限を上書きする
+func (q *Queries) UpsertBan(ctx context.Context, arg UpsertBanParams) (*Ban, error) {
+	row := q.db.QueryRow(ctx, upsertBan,
+		arg.GuildID,
+		arg.UserID,
+		arg.ModeratorID,
+		arg.Reason,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	var i Ban
+	err := row.Scan(
+		&i.GuildID,
+		&i.UserID,
+		&i.ModeratorID,
+		&i.Reason,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
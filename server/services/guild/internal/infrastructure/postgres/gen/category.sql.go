@@ -13,9 +13,13 @@ import (
 )
 
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (id, guild_id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW())
-RETURNING id, guild_id, name, created_at
+INSERT INTO categories (id, guild_id, name, position, created_at, updated_at)
+VALUES (
+  $1, $2, $3,
+  (SELECT COALESCE(MAX(c.position) + 1, 0)::integer FROM categories c WHERE c.guild_id = $2),
+  $4, NOW()
+)
+RETURNING id, guild_id, name, position, created_at
 `
 
 type CreateCategoryParams struct {
@@ -29,9 +33,11 @@ type CreateCategoryRow struct {
 	ID        uuid.UUID
 	GuildID   uuid.UUID
 	Name      string
+	Position  int32
 	CreatedAt time.Time
 }
 
+// ギルドの一番下に追加する
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (*CreateCategoryRow, error) {
 	row := q.db.QueryRow(ctx, createCategory,
 		arg.ID,
@@ -44,21 +50,37 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		&i.ID,
 		&i.GuildID,
 		&i.Name,
+		&i.Position,
 		&i.CreatedAt,
 	)
 	return &i, err
 }
 
+const deleteCategory = `-- name: DeleteCategory :execrows
+DELETE FROM categories
+WHERE id = $1
+`
+
+func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCategory, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getByGuildID = `-- name: GetByGuildID :many
-SELECT id, guild_id, name, created_at
+SELECT id, guild_id, name, position, created_at
 FROM categories
 WHERE guild_id = $1
+ORDER BY position, created_at, id
 `
 
 type GetByGuildIDRow struct {
 	ID        uuid.UUID
 	GuildID   uuid.UUID
 	Name      string
+	Position  int32
 	CreatedAt time.Time
 }
 
@@ -75,6 +97,7 @@ func (q *Queries) GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetBy
 			&i.ID,
 			&i.GuildID,
 			&i.Name,
+			&i.Position,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -87,6 +110,33 @@ func (q *Queries) GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetBy
 	return items, nil
 }
 
+const getCategoryByID = `-- name: GetCategoryByID :one
+SELECT id, guild_id, name, position, created_at
+FROM categories
+WHERE id = $1
+`
+
+type GetCategoryByIDRow struct {
+	ID        uuid.UUID
+	GuildID   uuid.UUID
+	Name      string
+	Position  int32
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (*GetCategoryByIDRow, error) {
+	row := q.db.QueryRow(ctx, getCategoryByID, id)
+	var i GetCategoryByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
 const getGuildIDByCategoryID = `-- name: GetGuildIDByCategoryID :one
 SELECT guild_id
 FROM categories
@@ -99,3 +149,89 @@ func (q *Queries) GetGuildIDByCategoryID(ctx context.Context, id uuid.UUID) (uui
 	err := row.Scan(&guild_id)
 	return guild_id, err
 }
+
+const getOldestCategoryExcept = `-- name: GetOldestCategoryExcept :one
+SELECT id, guild_id, name, position, created_at
+FROM categories
+WHERE guild_id = $1 AND id <> $2
+ORDER BY created_at, id
+LIMIT 1
+`
+
+type GetOldestCategoryExceptParams struct {
+	GuildID uuid.UUID
+	ID      uuid.UUID
+}
+
+type GetOldestCategoryExceptRow struct {
+	ID        uuid.UUID
+	GuildID   uuid.UUID
+	Name      string
+	Position  int32
+	CreatedAt time.Time
+}
+
+// カテゴリを削除するときに、チャンネルの移動先として使う
+func (q *Queries) GetOldestCategoryExcept(ctx context.Context, arg GetOldestCategoryExceptParams) (*GetOldestCategoryExceptRow, error) {
+	row := q.db.QueryRow(ctx, getOldestCategoryExcept, arg.GuildID, arg.ID)
+	var i GetOldestCategoryExceptRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const updateCategory = `-- name: UpdateCategory :one
+UPDATE categories
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, guild_id, name, position, created_at
+`
+
+type UpdateCategoryParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type UpdateCategoryRow struct {
+	ID        uuid.UUID
+	GuildID   uuid.UUID
+	Name      string
+	Position  int32
+	CreatedAt time.Time
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (*UpdateCategoryRow, error) {
+	row := q.db.QueryRow(ctx, updateCategory, arg.ID, arg.Name)
+	var i UpdateCategoryRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const updateCategoryPositions = `-- name: UpdateCategoryPositions :exec
+UPDATE categories c
+SET position = o.position - 1, updated_at = NOW()
+FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+WHERE c.id = o.id AND c.guild_id = $1
+`
+
+type UpdateCategoryPositionsParams struct {
+	GuildID     uuid.UUID
+	CategoryIds []uuid.UUID
+}
+
+// category_idsの順に0から振り直す
+func (q *Queries) UpdateCategoryPositions(ctx context.Context, arg UpdateCategoryPositionsParams) error {
+	_, err := q.db.Exec(ctx, updateCategoryPositions, arg.GuildID, arg.CategoryIds)
+	return err
+}
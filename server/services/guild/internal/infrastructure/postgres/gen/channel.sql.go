@@ -12,32 +12,14 @@ import (
 	"github.com/google/uuid"
 )
 
-const checkChannelMember = `-- name: CheckChannelMember :one
-SELECT EXISTS (
-    SELECT 1
-    FROM members m
-    JOIN categories c ON m.guild_id = c.guild_id
-    JOIN channels ch ON c.id = ch.category_id
-    WHERE m.user_id = $1 AND ch.id = $2
-)
-`
-
-type CheckChannelMemberParams struct {
-	UserID uuid.UUID
-	ID     uuid.UUID
-}
-
-func (q *Queries) CheckChannelMember(ctx context.Context, arg CheckChannelMemberParams) (bool, error) {
-	row := q.db.QueryRow(ctx, checkChannelMember, arg.UserID, arg.ID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
-}
-
 const createChannel = `-- name: CreateChannel :one
-INSERT INTO channels (id, category_id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW())
-RETURNING id, category_id, name, created_at
+INSERT INTO channels (id, category_id, name, position, created_at, updated_at)
+VALUES (
+  $1, $2, $3,
+  (SELECT COALESCE(MAX(ch.position) + 1, 0)::integer FROM channels ch WHERE ch.category_id = $2),
+  $4, NOW()
+)
+RETURNING id, category_id, name, position, created_at
 `
 
 type CreateChannelParams struct {
@@ -51,9 +33,11 @@ type CreateChannelRow struct {
 	ID         uuid.UUID
 	CategoryID uuid.UUID
 	Name       string
+	Position   int32
 	CreatedAt  time.Time
 }
 
+// カテゴリーの一番下に追加する
 func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (*CreateChannelRow, error) {
 	row := q.db.QueryRow(ctx, createChannel,
 		arg.ID,
@@ -66,21 +50,104 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (*
 		&i.ID,
 		&i.CategoryID,
 		&i.Name,
+		&i.Position,
 		&i.CreatedAt,
 	)
 	return &i, err
 }
 
+const deleteChannel = `-- name: DeleteChannel :execrows
+DELETE FROM channels
+WHERE id = $1
+`
+
+func (q *Queries) DeleteChannel(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteChannel, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const filterChannelGuildMembers = `-- name: FilterChannelGuildMembers :many
+SELECT m.user_id
+FROM members m
+JOIN categories c ON m.guild_id = c.guild_id
+JOIN channels ch ON c.id = ch.category_id
+WHERE ch.id = $1 AND m.user_id = ANY($2::uuid[])
+`
+
+type FilterChannelGuildMembersParams struct {
+	ChannelID uuid.UUID
+	UserIds   []uuid.UUID
+}
+
+func (q *Queries) FilterChannelGuildMembers(ctx context.Context, arg FilterChannelGuildMembersParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, filterChannelGuildMembers, arg.ChannelID, arg.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const filterSameGuildChannels = `-- name: FilterSameGuildChannels :many
+SELECT target.id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+JOIN categories target_c ON target_c.guild_id = c.guild_id
+JOIN channels target ON target.category_id = target_c.id
+WHERE ch.id = $1 AND target.id = ANY($2::uuid[])
+`
+
+type FilterSameGuildChannelsParams struct {
+	ChannelID  uuid.UUID
+	ChannelIds []uuid.UUID
+}
+
+func (q *Queries) FilterSameGuildChannels(ctx context.Context, arg FilterSameGuildChannelsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, filterSameGuildChannels, arg.ChannelID, arg.ChannelIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getByCategoryID = `-- name: GetByCategoryID :many
-SELECT id, category_id, name, created_at
+SELECT id, category_id, name, position, created_at
 FROM channels
 WHERE category_id = $1
+ORDER BY position, created_at, id
 `
 
 type GetByCategoryIDRow struct {
 	ID         uuid.UUID
 	CategoryID uuid.UUID
 	Name       string
+	Position   int32
 	CreatedAt  time.Time
 }
 
@@ -97,6 +164,7 @@ func (q *Queries) GetByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]
 			&i.ID,
 			&i.CategoryID,
 			&i.Name,
+			&i.Position,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -108,3 +176,236 @@ func (q *Queries) GetByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]
 	}
 	return items, nil
 }
+
+const getChannelByID = `-- name: GetChannelByID :one
+SELECT id, category_id, name, position, created_at
+FROM channels
+WHERE id = $1
+`
+
+type GetChannelByIDRow struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Position   int32
+	CreatedAt  time.Time
+}
+
+func (q *Queries) GetChannelByID(ctx context.Context, id uuid.UUID) (*GetChannelByIDRow, error) {
+	row := q.db.QueryRow(ctx, getChannelByID, id)
+	var i GetChannelByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getChannelsByGuildID = `-- name: GetChannelsByGuildID :many
+SELECT ch.id, ch.category_id, ch.name, ch.position, ch.created_at
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE c.guild_id = $1
+ORDER BY c.position, c.created_at, c.id, ch.position, ch.created_at, ch.id
+`
+
+type GetChannelsByGuildIDRow struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Position   int32
+	CreatedAt  time.Time
+}
+
+// サイドバーに表示する順
+func (q *Queries) GetChannelsByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetChannelsByGuildIDRow, error) {
+	rows, err := q.db.Query(ctx, getChannelsByGuildID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetChannelsByGuildIDRow
+	for rows.Next() {
+		var i GetChannelsByGuildIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelsWithGuildIDByIDs = `-- name: GetChannelsWithGuildIDByIDs :many
+SELECT ch.id, ch.category_id, ch.name, ch.position, ch.created_at, c.guild_id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE ch.id = ANY($1::uuid[])
+`
+
+type GetChannelsWithGuildIDByIDsRow struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Position   int32
+	CreatedAt  time.Time
+	GuildID    uuid.UUID
+}
+
+func (q *Queries) GetChannelsWithGuildIDByIDs(ctx context.Context, channelIds []uuid.UUID) ([]*GetChannelsWithGuildIDByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getChannelsWithGuildIDByIDs, channelIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetChannelsWithGuildIDByIDsRow
+	for rows.Next() {
+		var i GetChannelsWithGuildIDByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Position,
+			&i.CreatedAt,
+			&i.GuildID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildIDByChannelID = `-- name: GetGuildIDByChannelID :one
+SELECT c.guild_id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE ch.id = $1
+`
+
+func (q *Queries) GetGuildIDByChannelID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getGuildIDByChannelID, id)
+	var guild_id uuid.UUID
+	err := row.Scan(&guild_id)
+	return guild_id, err
+}
+
+const moveChannelsToCategory = `-- name: MoveChannelsToCategory :many
+UPDATE channels moved
+SET
+  category_id = $1,
+  position = moved.position + (SELECT COALESCE(MAX(ch.position) + 1, 0)::integer FROM channels ch WHERE ch.category_id = $1),
+  updated_at = NOW()
+WHERE moved.category_id = $2
+RETURNING moved.id, moved.category_id, moved.name, moved.position, moved.created_at
+`
+
+type MoveChannelsToCategoryParams struct {
+	ToCategoryID   uuid.UUID
+	FromCategoryID uuid.UUID
+}
+
+type MoveChannelsToCategoryRow struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Position   int32
+	CreatedAt  time.Time
+}
+
+// 並び順を保ったまま移動先の一番下に追加する
+func (q *Queries) MoveChannelsToCategory(ctx context.Context, arg MoveChannelsToCategoryParams) ([]*MoveChannelsToCategoryRow, error) {
+	rows, err := q.db.Query(ctx, moveChannelsToCategory, arg.ToCategoryID, arg.FromCategoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MoveChannelsToCategoryRow
+	for rows.Next() {
+		var i MoveChannelsToCategoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChannel = `-- name: UpdateChannel :one
+UPDATE channels
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, category_id, name, position, created_at
+`
+
+type UpdateChannelParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type UpdateChannelRow struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Position   int32
+	CreatedAt  time.Time
+}
+
+func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) (*UpdateChannelRow, error) {
+	row := q.db.QueryRow(ctx, updateChannel, arg.ID, arg.Name)
+	var i UpdateChannelRow
+	err := row.Scan(
+		&i.ID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const updateChannelPositions = `-- name: UpdateChannelPositions :exec
+UPDATE channels ch
+SET category_id = $1::uuid, position = o.position - 1, updated_at = NOW()
+FROM unnest($3::uuid[]) WITH ORDINALITY AS o(id, position), categories src
+WHERE ch.id = o.id
+  AND src.id = ch.category_id AND src.guild_id = $2::uuid
+  AND EXISTS (
+    SELECT 1 FROM categories dst WHERE dst.id = $1::uuid AND dst.guild_id = $2::uuid
+  )
+`
+
+type UpdateChannelPositionsParams struct {
+	CategoryID uuid.UUID
+	GuildID    uuid.UUID
+	ChannelIds []uuid.UUID
+}
+
+// channel_idsをcategory_idに移し、その順に0から振り直す。移す元と先のカテゴリーはどちらもguild_idのものに限る
+func (q *Queries) UpdateChannelPositions(ctx context.Context, arg UpdateChannelPositionsParams) error {
+	_, err := q.db.Exec(ctx, updateChannelPositions, arg.CategoryID, arg.GuildID, arg.ChannelIds)
+	return err
+}
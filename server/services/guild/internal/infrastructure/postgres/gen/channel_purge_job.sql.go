@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: channel_purge_job.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const claimDueChannelPurgeJobs = `-- name: ClaimDueChannelPurgeJobs :many
+UPDATE channel_purge_jobs
+SET attempts = attempts + 1, next_run_at = $1::timestamp
+WHERE id IN (
+  SELECT j.id
+  FROM channel_purge_jobs j
+  WHERE j.next_run_at <= $2::timestamp
+  ORDER BY j.next_run_at
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, channel_id, target, attempts, next_run_at, last_error, created_at
+`
+
+type ClaimDueChannelPurgeJobsParams struct {
+	LeaseUntil time.Time
+	Now        time.Time
+	RowLimit   int32
+}
+
+// 取り出したジョブはnext_run_atをリース期限まで進め、ほかのレプリカが同時に処理しないようにする
+func (q *Queries) ClaimDueChannelPurgeJobs(ctx context.Context, arg ClaimDueChannelPurgeJobsParams) ([]*ChannelPurgeJob, error) {
+	rows, err := q.db.Query(ctx, claimDueChannelPurgeJobs, arg.LeaseUntil, arg.Now, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ChannelPurgeJob
+	for rows.Next() {
+		var i ChannelPurgeJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.Target,
+			&i.Attempts,
+			&i.NextRunAt,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createChannelPurgeJob = `-- name: CreateChannelPurgeJob :exec
+INSERT INTO channel_purge_jobs (id, channel_id, target, attempts, next_run_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateChannelPurgeJobParams struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	Target    string
+	Attempts  int32
+	NextRunAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreateChannelPurgeJob(ctx context.Context, arg CreateChannelPurgeJobParams) error {
+	_, err := q.db.Exec(ctx, createChannelPurgeJob,
+		arg.ID,
+		arg.ChannelID,
+		arg.Target,
+		arg.Attempts,
+		arg.NextRunAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteChannelPurgeJob = `-- name: DeleteChannelPurgeJob :exec
+DELETE FROM channel_purge_jobs
+WHERE id = $1
+`
+
+func (q *Queries) DeleteChannelPurgeJob(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteChannelPurgeJob, id)
+	return err
+}
+
+const rescheduleChannelPurgeJob = `-- name: RescheduleChannelPurgeJob :exec
+UPDATE channel_purge_jobs
+SET next_run_at = $2, last_error = $3
+WHERE id = $1
+`
+
+type RescheduleChannelPurgeJobParams struct {
+	ID        uuid.UUID
+	NextRunAt time.Time
+	LastError *string
+}
+
+func (q *Queries) RescheduleChannelPurgeJob(ctx context.Context, arg RescheduleChannelPurgeJobParams) error {
+	_, err := q.db.Exec(ctx, rescheduleChannelPurgeJob, arg.ID, arg.NextRunAt, arg.LastError)
+	return err
+}
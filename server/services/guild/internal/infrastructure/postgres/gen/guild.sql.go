@@ -20,7 +20,7 @@ RETURNING id, owner_id, name, description, icon_url, default_channel_id, created
 
 type CreateGuildParams struct {
 	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconUrl          string
@@ -30,7 +30,7 @@ type CreateGuildParams struct {
 
 type CreateGuildRow struct {
 	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconUrl          string
@@ -61,13 +61,25 @@ func (q *Queries) CreateGuild(ctx context.Context, arg CreateGuildParams) (*Crea
 	return &i, err
 }
 
+const deleteGuild = `-- name: DeleteGuild :execrows
+DELETE FROM guilds WHERE id = $1
+`
+
+func (q *Queries) DeleteGuild(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteGuild, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getGuildByID = `-- name: GetGuildByID :one
 SELECT id, owner_id, name, description, icon_url, default_channel_id, created_at FROM guilds WHERE id = $1
 `
 
 type GetGuildByIDRow struct {
 	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconUrl          string
@@ -100,7 +112,7 @@ ORDER BY g.created_at DESC
 
 type GetMyGuildsRow struct {
 	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconUrl          string
@@ -136,24 +148,15 @@ func (q *Queries) GetMyGuilds(ctx context.Context, userID uuid.UUID) ([]*GetMyGu
 	return items, nil
 }
 
-const isOwner = `-- name: IsOwner :one
-SELECT EXISTS (
-    SELECT 1
-    FROM guilds
-    WHERE id = $1 AND owner_id = $2
-)
+const lockGuildByID = `-- name: LockGuildByID :one
+SELECT id FROM guilds WHERE id = $1 FOR UPDATE
 `
 
-type IsOwnerParams struct {
-	ID      uuid.UUID
-	OwnerID uuid.UUID
-}
-
-func (q *Queries) IsOwner(ctx context.Context, arg IsOwnerParams) (bool, error) {
-	row := q.db.QueryRow(ctx, isOwner, arg.ID, arg.OwnerID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+// 同じギルドへの操作を直列にするため、ギルドの行をトランザクションの終わりまでロックする
+func (q *Queries) LockGuildByID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockGuildByID, id)
+	err := row.Scan(&id)
+	return id, err
 }
 
 const updateGuild = `-- name: UpdateGuild :one
@@ -173,7 +176,7 @@ type UpdateGuildParams struct {
 
 type UpdateGuildRow struct {
 	ID               uuid.UUID
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Name             string
 	Description      string
 	IconUrl          string
@@ -201,3 +204,40 @@ func (q *Queries) UpdateGuild(ctx context.Context, arg UpdateGuildParams) (*Upda
 	)
 	return &i, err
 }
+
+const updateGuildOwner = `-- name: UpdateGuildOwner :one
+UPDATE guilds
+SET owner_id = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, name, description, icon_url, default_channel_id, created_at
+`
+
+type UpdateGuildOwnerParams struct {
+	ID      uuid.UUID
+	OwnerID *uuid.UUID
+}
+
+type UpdateGuildOwnerRow struct {
+	ID               uuid.UUID
+	OwnerID          *uuid.UUID
+	Name             string
+	Description      string
+	IconUrl          string
+	DefaultChannelID uuid.UUID
+	CreatedAt        time.Time
+}
+
+func (q *Queries) UpdateGuildOwner(ctx context.Context, arg UpdateGuildOwnerParams) (*UpdateGuildOwnerRow, error) {
+	row := q.db.QueryRow(ctx, updateGuildOwner, arg.ID, arg.OwnerID)
+	var i UpdateGuildOwnerRow
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Description,
+		&i.IconUrl,
+		&i.DefaultChannelID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
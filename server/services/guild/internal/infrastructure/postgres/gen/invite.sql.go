@@ -13,9 +13,9 @@ import (
 )
 
 const createGuildInvite = `-- name: CreateGuildInvite :one
-INSERT INTO invites (guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at
+INSERT INTO invites (guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
 `
 
 type CreateGuildInviteParams struct {
@@ -25,6 +25,7 @@ type CreateGuildInviteParams struct {
 	MaxUses     *int32
 	CurrentUses int32
 	ExpiresAt   *time.Time
+	Vanity      bool
 	CreatedAt   time.Time
 }
 
@@ -35,6 +36,7 @@ type CreateGuildInviteRow struct {
 	MaxUses     *int32
 	CurrentUses int32
 	ExpiresAt   *time.Time
+	Vanity      bool
 	CreatedAt   time.Time
 }
 
@@ -46,6 +48,7 @@ func (q *Queries) CreateGuildInvite(ctx context.Context, arg CreateGuildInvitePa
 		arg.MaxUses,
 		arg.CurrentUses,
 		arg.ExpiresAt,
+		arg.Vanity,
 		arg.CreatedAt,
 	)
 	var i CreateGuildInviteRow
@@ -56,13 +59,70 @@ func (q *Queries) CreateGuildInvite(ctx context.Context, arg CreateGuildInvitePa
 		&i.MaxUses,
 		&i.CurrentUses,
 		&i.ExpiresAt,
+		&i.Vanity,
 		&i.CreatedAt,
 	)
 	return &i, err
 }
 
+const createInviteUse = `-- name: CreateInviteUse :exec
+INSERT INTO invite_uses (id, guild_id, invite_code, user_id, used_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateInviteUseParams struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	InviteCode string
+	UserID     uuid.UUID
+	UsedAt     time.Time
+}
+
+func (q *Queries) CreateInviteUse(ctx context.Context, arg CreateInviteUseParams) error {
+	_, err := q.db.Exec(ctx, createInviteUse,
+		arg.ID,
+		arg.GuildID,
+		arg.InviteCode,
+		arg.UserID,
+		arg.UsedAt,
+	)
+	return err
+}
+
+const deleteExpiredInvites = `-- name: DeleteExpiredInvites :execrows
+DELETE FROM invites
+WHERE invite_code IN (
+  SELECT invite_code FROM invites
+  WHERE (expires_at IS NOT NULL AND expires_at <= NOW())
+     OR (max_uses IS NOT NULL AND current_uses >= max_uses)
+  LIMIT $1
+)
+`
+
+// バニティURLは期限も上限もないので対象にならない
+func (q *Queries) DeleteExpiredInvites(ctx context.Context, rowLimit int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredInvites, rowLimit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteInvite = `-- name: DeleteInvite :execrows
+DELETE FROM invites
+WHERE invite_code = $1
+`
+
+func (q *Queries) DeleteInvite(ctx context.Context, inviteCode string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInvite, inviteCode)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getGuildInvitesByGuildID = `-- name: GetGuildInvitesByGuildID :many
-SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at
+SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
 FROM invites
 WHERE guild_id = $1
 `
@@ -74,6 +134,7 @@ type GetGuildInvitesByGuildIDRow struct {
 	MaxUses     *int32
 	CurrentUses int32
 	ExpiresAt   *time.Time
+	Vanity      bool
 	CreatedAt   time.Time
 }
 
@@ -93,6 +154,7 @@ func (q *Queries) GetGuildInvitesByGuildID(ctx context.Context, guildID uuid.UUI
 			&i.MaxUses,
 			&i.CurrentUses,
 			&i.ExpiresAt,
+			&i.Vanity,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -107,7 +169,7 @@ func (q *Queries) GetGuildInvitesByGuildID(ctx context.Context, guildID uuid.UUI
 
 const getInviteByInviteCode = `-- name: GetInviteByInviteCode :one
 SELECT
-  i.guild_id, i.creator_id, i.invite_code, i.max_uses, i.current_uses, i.expires_at, i.created_at, g.name AS "guild.name", g.description AS "guild.description", g.icon_url AS "guild.icon_url", g.owner_id AS "guild.owner_id", g.default_channel_id AS "guild.default_channel_id", g.created_at AS "guild.created_at"
+  i.guild_id, i.creator_id, i.invite_code, i.max_uses, i.current_uses, i.expires_at, i.vanity, i.created_at, g.name AS "guild.name", g.description AS "guild.description", g.icon_url AS "guild.icon_url", g.owner_id AS "guild.owner_id", g.default_channel_id AS "guild.default_channel_id", g.created_at AS "guild.created_at"
 FROM invites i
 JOIN guilds g ON i.guild_id = g.id
 WHERE i.invite_code = $1
@@ -120,11 +182,12 @@ type GetInviteByInviteCodeRow struct {
 	MaxUses               *int32
 	CurrentUses           int32
 	ExpiresAt             *time.Time
+	Vanity                bool
 	CreatedAt             time.Time
 	GuildName             string
 	GuildDescription      string
 	GuildIconUrl          string
-	GuildOwnerID          uuid.UUID
+	GuildOwnerID          *uuid.UUID
 	GuildDefaultChannelID uuid.UUID
 	GuildCreatedAt        time.Time
 }
@@ -140,6 +203,7 @@ func (q *Queries) GetInviteByInviteCode(ctx context.Context, inviteCode string)
 		&i.MaxUses,
 		&i.CurrentUses,
 		&i.ExpiresAt,
+		&i.Vanity,
 		&i.CreatedAt,
 		&i.GuildName,
 		&i.GuildDescription,
@@ -151,11 +215,82 @@ func (q *Queries) GetInviteByInviteCode(ctx context.Context, inviteCode string)
 	return &i, err
 }
 
+const getInviteUses = `-- name: GetInviteUses :many
+SELECT id, guild_id, invite_code, user_id, used_at
+FROM invite_uses
+WHERE guild_id = $1 AND invite_code = $2
+ORDER BY used_at DESC
+`
+
+type GetInviteUsesParams struct {
+	GuildID    uuid.UUID
+	InviteCode string
+}
+
+func (q *Queries) GetInviteUses(ctx context.Context, arg GetInviteUsesParams) ([]*InviteUse, error) {
+	rows, err := q.db.Query(ctx, getInviteUses, arg.GuildID, arg.InviteCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*InviteUse
+	for rows.Next() {
+		var i InviteUse
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.InviteCode,
+			&i.UserID,
+			&i.UsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVanityInviteByGuildID = `-- name: GetVanityInviteByGuildID :one
+SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
+FROM invites
+WHERE guild_id = $1 AND vanity
+`
+
+type GetVanityInviteByGuildIDRow struct {
+	GuildID     uuid.UUID
+	CreatorID   uuid.UUID
+	InviteCode  string
+	MaxUses     *int32
+	CurrentUses int32
+	ExpiresAt   *time.Time
+	Vanity      bool
+	CreatedAt   time.Time
+}
+
+func (q *Queries) GetVanityInviteByGuildID(ctx context.Context, guildID uuid.UUID) (*GetVanityInviteByGuildIDRow, error) {
+	row := q.db.QueryRow(ctx, getVanityInviteByGuildID, guildID)
+	var i GetVanityInviteByGuildIDRow
+	err := row.Scan(
+		&i.GuildID,
+		&i.CreatorID,
+		&i.InviteCode,
+		&i.MaxUses,
+		&i.CurrentUses,
+		&i.ExpiresAt,
+		&i.Vanity,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
 const incrementInviteUses = `-- name: IncrementInviteUses :one
 UPDATE invites
 SET current_uses = current_uses + 1
 WHERE invite_code = $1 AND (max_uses IS NULL OR current_uses < max_uses) AND (expires_at IS NULL OR expires_at > NOW())
-RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at
+RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
 `
 
 type IncrementInviteUsesRow struct {
@@ -165,6 +300,7 @@ type IncrementInviteUsesRow struct {
 	MaxUses     *int32
 	CurrentUses int32
 	ExpiresAt   *time.Time
+	Vanity      bool
 	CreatedAt   time.Time
 }
 
@@ -178,7 +314,19 @@ func (q *Queries) IncrementInviteUses(ctx context.Context, inviteCode string) (*
 		&i.MaxUses,
 		&i.CurrentUses,
 		&i.ExpiresAt,
+		&i.Vanity,
 		&i.CreatedAt,
 	)
 	return &i, err
 }
+
+const inviteCodeExists = `-- name: InviteCodeExists :one
+SELECT EXISTS(SELECT 1 FROM invites WHERE invite_code = $1)
+`
+
+func (q *Queries) InviteCodeExists(ctx context.Context, inviteCode string) (bool, error) {
+	row := q.db.QueryRow(ctx, inviteCodeExists, inviteCode)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
@@ -50,16 +50,43 @@ func (q *Queries) CountByGuildID(ctx context.Context, guildID uuid.UUID) (int64,
 	return count, err
 }
 
+const getGuildIDsByUserID = `-- name: GetGuildIDsByUserID :many
+SELECT guild_id
+FROM members
+WHERE user_id = $1
+`
+
+func (q *Queries) GetGuildIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getGuildIDsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var guild_id uuid.UUID
+		if err := rows.Scan(&guild_id); err != nil {
+			return nil, err
+		}
+		items = append(items, guild_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMembersByGuildID = `-- name: GetMembersByGuildID :many
-SELECT guild_id, user_id, joined_at
+SELECT guild_id, user_id, joined_at, communication_disabled_until
 FROM members
 WHERE guild_id = $1
 `
 
 type GetMembersByGuildIDRow struct {
-	GuildID  uuid.UUID
-	UserID   uuid.UUID
-	JoinedAt time.Time
+	GuildID                    uuid.UUID
+	UserID                     uuid.UUID
+	JoinedAt                   time.Time
+	CommunicationDisabledUntil *time.Time
 }
 
 func (q *Queries) GetMembersByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetMembersByGuildIDRow, error) {
@@ -71,7 +98,12 @@ func (q *Queries) GetMembersByGuildID(ctx context.Context, guildID uuid.UUID) ([
 	var items []*GetMembersByGuildIDRow
 	for rows.Next() {
 		var i GetMembersByGuildIDRow
-		if err := rows.Scan(&i.GuildID, &i.UserID, &i.JoinedAt); err != nil {
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.UserID,
+			&i.JoinedAt,
+			&i.CommunicationDisabledUntil,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -101,3 +133,53 @@ func (q *Queries) IsMember(ctx context.Context, arg IsMemberParams) (bool, error
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const removeMember = `-- name: RemoveMember :execrows
+DELETE FROM members
+WHERE guild_id = $1 AND user_id = $2
+`
+
+type RemoveMemberParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (q *Queries) RemoveMember(ctx context.Context, arg RemoveMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeMember, arg.GuildID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMemberCommunicationDisabledUntil = `-- name: UpdateMemberCommunicationDisabledUntil :one
+UPDATE members
+SET communication_disabled_until = $3, updated_at = NOW()
+WHERE guild_id = $1 AND user_id = $2
+RETURNING guild_id, user_id, joined_at, communication_disabled_until
+`
+
+type UpdateMemberCommunicationDisabledUntilParams struct {
+	GuildID                    uuid.UUID
+	UserID                     uuid.UUID
+	CommunicationDisabledUntil *time.Time
+}
+
+type UpdateMemberCommunicationDisabledUntilRow struct {
+	GuildID                    uuid.UUID
+	UserID                     uuid.UUID
+	JoinedAt                   time.Time
+	CommunicationDisabledUntil *time.Time
+}
+
+func (q *Queries) UpdateMemberCommunicationDisabledUntil(ctx context.Context, arg UpdateMemberCommunicationDisabledUntilParams) (*UpdateMemberCommunicationDisabledUntilRow, error) {
+	row := q.db.QueryRow(ctx, updateMemberCommunicationDisabledUntil, arg.GuildID, arg.UserID, arg.CommunicationDisabledUntil)
+	var i UpdateMemberCommunicationDisabledUntilRow
+	err := row.Scan(
+		&i.GuildID,
+		&i.UserID,
+		&i.JoinedAt,
+		&i.CommunicationDisabledUntil,
+	)
+	return &i, err
+}
@@ -8,15 +8,35 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ActorID    *uuid.UUID
+	ActionType string
+	TargetID   *uuid.UUID
+	Changes    []byte
+	Reason     string
+	CreatedAt  time.Time
+}
+
+type Ban struct {
+	GuildID     uuid.UUID
+	UserID      uuid.UUID
+	ModeratorID *uuid.UUID
+	Reason      string
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
 type Category struct {
 	ID        uuid.UUID
 	GuildID   uuid.UUID
 	Name      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Position  int32
 }
 
 type Channel struct {
@@ -25,12 +45,31 @@ type Channel struct {
 	CategoryID uuid.UUID
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	Position   int32
+}
+
+type ChannelPurgeJob struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	Target    string
+	Attempts  int32
+	NextRunAt time.Time
+	LastError *string
+	CreatedAt time.Time
+}
+
+type ChannelReadState struct {
+	UserID            uuid.UUID
+	ChannelID         uuid.UUID
+	LastReadMessageID uuid.UUID
+	LastReadAt        time.Time
+	UpdatedAt         time.Time
 }
 
 type Guild struct {
 	ID               uuid.UUID
 	Name             string
-	OwnerID          uuid.UUID
+	OwnerID          *uuid.UUID
 	Description      string
 	IconUrl          string
 	DefaultChannelID uuid.UUID
@@ -46,23 +85,92 @@ type Invite struct {
 	CurrentUses int32
 	ExpiresAt   *time.Time
 	CreatedAt   time.Time
+	Vanity      bool
+}
+
+type InviteUse struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	InviteCode string
+	UserID     uuid.UUID
+	UsedAt     time.Time
 }
 
 type Member struct {
-	UserID    uuid.UUID
+	UserID                     uuid.UUID
+	GuildID                    uuid.UUID
+	JoinedAt                   time.Time
+	UpdatedAt                  time.Time
+	CommunicationDisabledUntil *time.Time
+}
+
+type MemberRole struct {
 	GuildID   uuid.UUID
-	JoinedAt  time.Time
-	UpdatedAt time.Time
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt time.Time
 }
 
 type Message struct {
-	ID        uuid.UUID
-	SenderID  uuid.UUID
-	ChannelID uuid.UUID
-	Content   string
-	ReplyID   pgtype.UUID
+	ID         uuid.UUID
+	SenderID   uuid.UUID
+	ChannelID  uuid.UUID
+	Content    string
+	ReplyID    *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	EditedAt   *time.Time
+	PinnedAt   *time.Time
+	ContentTsv interface{}
+}
+
+type MessageAttachment struct {
+	ID          uuid.UUID
+	MessageID   uuid.UUID
+	ObjectKey   string
+	Filename    string
+	Size        int64
+	ContentType string
+	Url         string
+	Position    int32
+	CreatedAt   time.Time
+}
+
+type MessageMention struct {
+	MessageID uuid.UUID
+	Type      string
+	TargetID  uuid.UUID
+	Position  int32
+}
+
+type MessageReaction struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+	Emoji     string
 	CreatedAt time.Time
-	UpdatedAt time.Time
+}
+
+type PermissionOverwrite struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  *uuid.UUID
+	CategoryID *uuid.UUID
+	RoleID     *uuid.UUID
+	UserID     *uuid.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type Role struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type User struct {
@@ -0,0 +1,199 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: permission_overwrite.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deletePermissionOverwrite = `-- name: DeletePermissionOverwrite :execrows
+DELETE FROM permission_overwrites
+WHERE COALESCE(channel_id, category_id) = $1::uuid AND COALESCE(role_id, user_id) = $2::uuid
+`
+
+type DeletePermissionOverwriteParams struct {
+	ScopeID  uuid.UUID
+	TargetID uuid.UUID
+}
+
+func (q *Queries) DeletePermissionOverwrite(ctx context.Context, arg DeletePermissionOverwriteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePermissionOverwrite, arg.ScopeID, arg.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getMemberPermissionOverwrites = `-- name: GetMemberPermissionOverwrites :many
+SELECT o.id, o.guild_id, o.channel_id, o.category_id, o.role_id, o.user_id, o.allow, o.deny, o.created_at
+FROM permission_overwrites o
+WHERE o.guild_id = $1::uuid AND (
+  o.role_id = $1::uuid
+  OR o.user_id = $2::uuid
+  OR o.role_id IN (
+    SELECT mr.role_id FROM member_roles mr WHERE mr.guild_id = $1::uuid AND mr.user_id = $2::uuid
+  )
+)
+`
+
+type GetMemberPermissionOverwritesParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type GetMemberPermissionOverwritesRow struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  *uuid.UUID
+	CategoryID *uuid.UUID
+	RoleID     *uuid.UUID
+	UserID     *uuid.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  time.Time
+}
+
+// ギルド内の上書きのうち、@everyone・user_idが持っているロール・user_id本人を対象にするもの
+func (q *Queries) GetMemberPermissionOverwrites(ctx context.Context, arg GetMemberPermissionOverwritesParams) ([]*GetMemberPermissionOverwritesRow, error) {
+	rows, err := q.db.Query(ctx, getMemberPermissionOverwrites, arg.GuildID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetMemberPermissionOverwritesRow
+	for rows.Next() {
+		var i GetMemberPermissionOverwritesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.CategoryID,
+			&i.RoleID,
+			&i.UserID,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPermissionOverwritesByScopeID = `-- name: GetPermissionOverwritesByScopeID :many
+SELECT id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at
+FROM permission_overwrites
+WHERE COALESCE(channel_id, category_id) = $1::uuid
+ORDER BY created_at, id
+`
+
+type GetPermissionOverwritesByScopeIDRow struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  *uuid.UUID
+	CategoryID *uuid.UUID
+	RoleID     *uuid.UUID
+	UserID     *uuid.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  time.Time
+}
+
+func (q *Queries) GetPermissionOverwritesByScopeID(ctx context.Context, scopeID uuid.UUID) ([]*GetPermissionOverwritesByScopeIDRow, error) {
+	rows, err := q.db.Query(ctx, getPermissionOverwritesByScopeID, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPermissionOverwritesByScopeIDRow
+	for rows.Next() {
+		var i GetPermissionOverwritesByScopeIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.ChannelID,
+			&i.CategoryID,
+			&i.RoleID,
+			&i.UserID,
+			&i.Allow,
+			&i.Deny,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertPermissionOverwrite = `-- name: UpsertPermissionOverwrite :one
+INSERT INTO permission_overwrites (id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+ON CONFLICT ((COALESCE(channel_id, category_id)), (COALESCE(role_id, user_id)))
+DO UPDATE SET allow = EXCLUDED.allow, deny = EXCLUDED.deny, updated_at = NOW()
+RETURNING id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at
+`
+
+type UpsertPermissionOverwriteParams struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  *uuid.UUID
+	CategoryID *uuid.UUID
+	RoleID     *uuid.UUID
+	UserID     *uuid.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  time.Time
+}
+
+type UpsertPermissionOverwriteRow struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  *uuid.UUID
+	CategoryID *uuid.UUID
+	RoleID     *uuid.UUID
+	UserID     *uuid.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  time.Time
+}
+
+func (q *Queries) UpsertPermissionOverwrite(ctx context.Context, arg UpsertPermissionOverwriteParams) (*UpsertPermissionOverwriteRow, error) {
+	row := q.db.QueryRow(ctx, upsertPermissionOverwrite,
+		arg.ID,
+		arg.GuildID,
+		arg.ChannelID,
+		arg.CategoryID,
+		arg.RoleID,
+		arg.UserID,
+		arg.Allow,
+		arg.Deny,
+		arg.CreatedAt,
+	)
+	var i UpsertPermissionOverwriteRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.ChannelID,
+		&i.CategoryID,
+		&i.RoleID,
+		&i.UserID,
+		&i.Allow,
+		&i.Deny,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
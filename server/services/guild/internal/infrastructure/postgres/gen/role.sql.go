@@ -0,0 +1,330 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: role.sql
+
+package gen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const addMemberRole = `-- name: AddMemberRole :exec
+INSERT INTO member_roles (guild_id, user_id, role_id, created_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT DO NOTHING
+`
+
+type AddMemberRoleParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+	RoleID  uuid.UUID
+}
+
+func (q *Queries) AddMemberRole(ctx context.Context, arg AddMemberRoleParams) error {
+	_, err := q.db.Exec(ctx, addMemberRole, arg.GuildID, arg.UserID, arg.RoleID)
+	return err
+}
+
+const createRole = `-- name: CreateRole :one
+INSERT INTO roles (id, guild_id, name, permissions, position, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+RETURNING id, guild_id, name, permissions, position, created_at
+`
+
+type CreateRoleParams struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+}
+
+type CreateRoleRow struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+}
+
+func (q *Queries) CreateRole(ctx context.Context, arg CreateRoleParams) (*CreateRoleRow, error) {
+	row := q.db.QueryRow(ctx, createRole,
+		arg.ID,
+		arg.GuildID,
+		arg.Name,
+		arg.Permissions,
+		arg.Position,
+		arg.CreatedAt,
+	)
+	var i CreateRoleRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Permissions,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const deleteRole = `-- name: DeleteRole :execrows
+DELETE FROM roles
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRole(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRole, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getMemberPermissions = `-- name: GetMemberPermissions :one
+SELECT
+  COALESCE(g.owner_id = $1::uuid, false)::boolean AS is_owner,
+  EXISTS (
+    SELECT 1 FROM members m WHERE m.guild_id = g.id AND m.user_id = $1::uuid
+  )::boolean AS is_member,
+  COALESCE((
+    SELECT bit_or(r.permissions)
+    FROM roles r
+    WHERE r.guild_id = g.id AND (
+      r.id = g.id OR r.id IN (
+        SELECT mr.role_id FROM member_roles mr WHERE mr.guild_id = g.id AND mr.user_id = $1::uuid
+      )
+    )
+  ), 0)::bigint AS permissions,
+  COALESCE((
+    SELECT MAX(r.position)
+    FROM member_roles mr
+    JOIN roles r ON r.id = mr.role_id
+    WHERE mr.guild_id = g.id AND mr.user_id = $1::uuid
+  ), 0)::integer AS top_position,
+  tm.communication_disabled_until
+FROM guilds g
+LEFT JOIN members tm ON tm.guild_id = g.id AND tm.user_id = $1::uuid
+WHERE g.id = $2
+`
+
+type GetMemberPermissionsParams struct {
+	UserID  uuid.UUID
+	GuildID uuid.UUID
+}
+
+type GetMemberPermissionsRow struct {
+	IsOwner                    bool
+	IsMember                   bool
+	Permissions                int64
+	TopPosition                int32
+	CommunicationDisabledUntil *time.Time
+}
+
+// ギルドのオーナーか、メンバーか、@everyoneと持っているロールの権限の和、持っているロールの最も高いposition、タイムアウトの解除時刻
+func (q *Queries) GetMemberPermissions(ctx context.Context, arg GetMemberPermissionsParams) (*GetMemberPermissionsRow, error) {
+	row := q.db.QueryRow(ctx, getMemberPermissions, arg.UserID, arg.GuildID)
+	var i GetMemberPermissionsRow
+	err := row.Scan(
+		&i.IsOwner,
+		&i.IsMember,
+		&i.Permissions,
+		&i.TopPosition,
+		&i.CommunicationDisabledUntil,
+	)
+	return &i, err
+}
+
+const getMemberRolesByGuildID = `-- name: GetMemberRolesByGuildID :many
+SELECT user_id, role_id
+FROM member_roles
+WHERE guild_id = $1
+`
+
+type GetMemberRolesByGuildIDRow struct {
+	UserID uuid.UUID
+	RoleID uuid.UUID
+}
+
+func (q *Queries) GetMemberRolesByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetMemberRolesByGuildIDRow, error) {
+	rows, err := q.db.Query(ctx, getMemberRolesByGuildID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetMemberRolesByGuildIDRow
+	for rows.Next() {
+		var i GetMemberRolesByGuildIDRow
+		if err := rows.Scan(&i.UserID, &i.RoleID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoleByID = `-- name: GetRoleByID :one
+SELECT id, guild_id, name, permissions, position, created_at
+FROM roles
+WHERE id = $1
+`
+
+type GetRoleByIDRow struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+}
+
+func (q *Queries) GetRoleByID(ctx context.Context, id uuid.UUID) (*GetRoleByIDRow, error) {
+	row := q.db.QueryRow(ctx, getRoleByID, id)
+	var i GetRoleByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Permissions,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getRolesByGuildID = `-- name: GetRolesByGuildID :many
+SELECT id, guild_id, name, permissions, position, created_at
+FROM roles
+WHERE guild_id = $1
+ORDER BY position, created_at, id
+`
+
+type GetRolesByGuildIDRow struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+}
+
+func (q *Queries) GetRolesByGuildID(ctx context.Context, guildID uuid.UUID) ([]*GetRolesByGuildIDRow, error) {
+	rows, err := q.db.Query(ctx, getRolesByGuildID, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetRolesByGuildIDRow
+	for rows.Next() {
+		var i GetRolesByGuildIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.Name,
+			&i.Permissions,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeMemberRole = `-- name: RemoveMemberRole :execrows
+DELETE FROM member_roles
+WHERE guild_id = $1 AND user_id = $2 AND role_id = $3
+`
+
+type RemoveMemberRoleParams struct {
+	GuildID uuid.UUID
+	UserID  uuid.UUID
+	RoleID  uuid.UUID
+}
+
+func (q *Queries) RemoveMemberRole(ctx context.Context, arg RemoveMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeMemberRole, arg.GuildID, arg.UserID, arg.RoleID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const shiftRolePositions = `-- name: ShiftRolePositions :exec
+UPDATE roles
+SET position = position + 1, updated_at = NOW()
+WHERE guild_id = $1 AND position > 0
+`
+
+// @everyone(position = 0)より上のロールをすべて1つずつ上げ、position = 1を空ける
+func (q *Queries) ShiftRolePositions(ctx context.Context, guildID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, shiftRolePositions, guildID)
+	return err
+}
+
+const updateRole = `-- name: UpdateRole :one
+UPDATE roles
+SET name = $2, permissions = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, guild_id, name, permissions, position, created_at
+`
+
+type UpdateRoleParams struct {
+	ID          uuid.UUID
+	Name        string
+	Permissions int64
+}
+
+type UpdateRoleRow struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   time.Time
+}
+
+func (q *Queries) UpdateRole(ctx context.Context, arg UpdateRoleParams) (*UpdateRoleRow, error) {
+	row := q.db.QueryRow(ctx, updateRole, arg.ID, arg.Name, arg.Permissions)
+	var i UpdateRoleRow
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Name,
+		&i.Permissions,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const updateRolePositions = `-- name: UpdateRolePositions :exec
+UPDATE roles r
+SET position = o.position, updated_at = NOW()
+FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+WHERE r.id = o.id AND r.guild_id = $1
+`
+
+type UpdateRolePositionsParams struct {
+	GuildID uuid.UUID
+	RoleIds []uuid.UUID
+}
+
+// role_idsの順に1から振り直す
+func (q *Queries) UpdateRolePositions(ctx context.Context, arg UpdateRolePositionsParams) error {
+	_, err := q.db.Exec(ctx, updateRolePositions, arg.GuildID, arg.RoleIds)
+	return err
+}
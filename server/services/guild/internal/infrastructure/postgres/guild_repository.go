@@ -105,15 +105,47 @@ func (r *guildRepository) Update(ctx context.Context, guild *domain.Guild) (*dom
 	}, nil
 }
 
-func (r *guildRepository) IsOwner(ctx context.Context, guildID uuid.UUID, userID uuid.UUID) (bool, error) {
-	isOwner, err := r.queries.IsOwner(ctx, gen.IsOwnerParams{
-		ID:      guildID,
-		OwnerID: userID,
+func (r *guildRepository) UpdateOwner(ctx context.Context, id, ownerID uuid.UUID) (*domain.Guild, error) {
+	dbGuild, err := r.queries.UpdateGuildOwner(ctx, gen.UpdateGuildOwnerParams{
+		ID:      id,
+		OwnerID: &ownerID,
 	})
 	if err != nil {
-		return false, err
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrGuildNotFound
+		}
+		return nil, err
+	}
+	return &domain.Guild{
+		ID:               dbGuild.ID,
+		OwnerID:          dbGuild.OwnerID,
+		Name:             dbGuild.Name,
+		Description:      dbGuild.Description,
+		IconURL:          dbGuild.IconUrl,
+		DefaultChannelID: dbGuild.DefaultChannelID,
+		CreatedAt:        dbGuild.CreatedAt,
+	}, nil
+}
+
+func (r *guildRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.queries.LockGuildByID(ctx, id); err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrGuildNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *guildRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	rows, err := r.queries.DeleteGuild(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrGuildNotFound
 	}
-	return isOwner, nil
+	return nil
 }
 
 var _ domain.IGuildRepository = (*guildRepository)(nil)
@@ -2,12 +2,21 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"guild-service/internal/domain"
 	"guild-service/internal/infrastructure/postgres/gen"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// PostgreSQLの一意制約違反のSQLSTATE
+const uniqueViolationCode = "23505"
+
+// ギルドごとにバニティコードを1つに制限する部分インデックス
+const vanityGuildIDConstraint = "idx_invites_vanity_guild_id"
+
 type inviteRepository struct {
 	queries *gen.Queries
 }
@@ -26,9 +35,19 @@ func (r *inviteRepository) Create(ctx context.Context, invite *domain.Invite) (*
 		MaxUses:     invite.MaxUses,
 		CurrentUses: invite.CurrentUses,
 		ExpiresAt:   invite.ExpiresAt,
+		Vanity:      invite.Vanity,
 		CreatedAt:   invite.CreatedAt,
 	})
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			// 同じギルドのバニティコードが並行して設定された場合
+			if pgErr.ConstraintName == vanityGuildIDConstraint {
+				return nil, domain.ErrGuildAlreadyHasVanityCode
+			}
+			// 存在チェックの後に同じコードが作られた場合
+			return nil, domain.ErrInviteCodeAlreadyExists
+		}
 		return nil, err
 	}
 
@@ -39,6 +58,7 @@ func (r *inviteRepository) Create(ctx context.Context, invite *domain.Invite) (*
 		MaxUses:     dbInvite.MaxUses,
 		CurrentUses: dbInvite.CurrentUses,
 		ExpiresAt:   dbInvite.ExpiresAt,
+		Vanity:      dbInvite.Vanity,
 		CreatedAt:   dbInvite.CreatedAt,
 	}, nil
 }
@@ -57,6 +77,7 @@ func (r *inviteRepository) GetByGuildID(ctx context.Context, guildID uuid.UUID)
 			MaxUses:     dbInvite.MaxUses,
 			CurrentUses: dbInvite.CurrentUses,
 			ExpiresAt:   dbInvite.ExpiresAt,
+			Vanity:      dbInvite.Vanity,
 			CreatedAt:   dbInvite.CreatedAt,
 		}
 	}
@@ -75,6 +96,7 @@ func (r *inviteRepository) IncrementUses(ctx context.Context, inviteCode string)
 		MaxUses:     dbInvite.MaxUses,
 		CurrentUses: dbInvite.CurrentUses,
 		ExpiresAt:   dbInvite.ExpiresAt,
+		Vanity:      dbInvite.Vanity,
 		CreatedAt:   dbInvite.CreatedAt,
 	}, nil
 }
@@ -82,6 +104,9 @@ func (r *inviteRepository) IncrementUses(ctx context.Context, inviteCode string)
 func (r *inviteRepository) GetByInviteCode(ctx context.Context, inviteCode string) (*domain.Invite, error) {
 	dbInvite, err := r.queries.GetInviteByInviteCode(ctx, inviteCode)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrInviteNotFound
+		}
 		return nil, err
 	}
 	invite := &domain.Invite{
@@ -100,10 +125,81 @@ func (r *inviteRepository) GetByInviteCode(ctx context.Context, inviteCode strin
 		MaxUses:     dbInvite.MaxUses,
 		CurrentUses: dbInvite.CurrentUses,
 		ExpiresAt:   dbInvite.ExpiresAt,
+		Vanity:      dbInvite.Vanity,
 		CreatedAt:   dbInvite.CreatedAt,
 	}
 
 	return invite, nil
 }
 
+func (r *inviteRepository) Delete(ctx context.Context, inviteCode string) error {
+	rows, err := r.queries.DeleteInvite(ctx, inviteCode)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrInviteNotFound
+	}
+	return nil
+}
+
+func (r *inviteRepository) Exists(ctx context.Context, inviteCode string) (bool, error) {
+	return r.queries.InviteCodeExists(ctx, inviteCode)
+}
+
+func (r *inviteRepository) GetVanityByGuildID(ctx context.Context, guildID uuid.UUID) (*domain.Invite, error) {
+	dbInvite, err := r.queries.GetVanityInviteByGuildID(ctx, guildID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrInviteNotFound
+		}
+		return nil, err
+	}
+	return &domain.Invite{
+		GuildID:     dbInvite.GuildID,
+		CreatorID:   dbInvite.CreatorID,
+		InviteCode:  dbInvite.InviteCode,
+		MaxUses:     dbInvite.MaxUses,
+		CurrentUses: dbInvite.CurrentUses,
+		ExpiresAt:   dbInvite.ExpiresAt,
+		Vanity:      dbInvite.Vanity,
+		CreatedAt:   dbInvite.CreatedAt,
+	}, nil
+}
+
+func (r *inviteRepository) DeleteExpired(ctx context.Context, limit int32) (int64, error) {
+	return r.queries.DeleteExpiredInvites(ctx, limit)
+}
+
+func (r *inviteRepository) AddUse(ctx context.Context, use *domain.InviteUse) error {
+	return r.queries.CreateInviteUse(ctx, gen.CreateInviteUseParams{
+		ID:         use.ID,
+		GuildID:    use.GuildID,
+		InviteCode: use.InviteCode,
+		UserID:     use.UserID,
+		UsedAt:     use.UsedAt,
+	})
+}
+
+func (r *inviteRepository) GetUses(ctx context.Context, guildID uuid.UUID, inviteCode string) ([]*domain.InviteUse, error) {
+	dbUses, err := r.queries.GetInviteUses(ctx, gen.GetInviteUsesParams{
+		GuildID:    guildID,
+		InviteCode: inviteCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uses := make([]*domain.InviteUse, len(dbUses))
+	for i, dbUse := range dbUses {
+		uses[i] = &domain.InviteUse{
+			ID:         dbUse.ID,
+			GuildID:    dbUse.GuildID,
+			InviteCode: dbUse.InviteCode,
+			UserID:     dbUse.UserID,
+			UsedAt:     dbUse.UsedAt,
+		}
+	}
+	return uses, nil
+}
+
 var _ domain.IInviteRepository = (*inviteRepository)(nil)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"guild-service/internal/domain"
 	"guild-service/internal/infrastructure/postgres/gen"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type memberRepository struct {
@@ -34,6 +36,20 @@ func (r *memberRepository) Add(ctx context.Context, member *domain.Member) (*dom
 	}, nil
 }
 
+func (r *memberRepository) Remove(ctx context.Context, guildID, userID uuid.UUID) error {
+	rows, err := r.queries.RemoveMember(ctx, gen.RemoveMemberParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrMemberNotFound
+	}
+	return nil
+}
+
 func (r *memberRepository) GetMembersByGuildID(ctx context.Context, guildID uuid.UUID) ([]domain.Member, error) {
 	dbMembers, err := r.queries.GetMembersByGuildID(ctx, guildID)
 	if err != nil {
@@ -43,15 +59,36 @@ func (r *memberRepository) GetMembersByGuildID(ctx context.Context, guildID uuid
 	members := make([]domain.Member, len(dbMembers))
 	for i, m := range dbMembers {
 		members[i] = domain.Member{
-			GuildID:  m.GuildID,
-			UserID:   m.UserID,
-			JoinedAt: m.JoinedAt,
+			GuildID:                    m.GuildID,
+			UserID:                     m.UserID,
+			JoinedAt:                   m.JoinedAt,
+			CommunicationDisabledUntil: m.CommunicationDisabledUntil,
 		}
 	}
 
 	return members, nil
 }
 
+func (r *memberRepository) UpdateCommunicationDisabledUntil(ctx context.Context, guildID, userID uuid.UUID, until *time.Time) (*domain.Member, error) {
+	dbMember, err := r.queries.UpdateMemberCommunicationDisabledUntil(ctx, gen.UpdateMemberCommunicationDisabledUntilParams{
+		GuildID:                    guildID,
+		UserID:                     userID,
+		CommunicationDisabledUntil: until,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrMemberNotFound
+		}
+		return nil, err
+	}
+	return &domain.Member{
+		GuildID:                    dbMember.GuildID,
+		UserID:                     dbMember.UserID,
+		JoinedAt:                   dbMember.JoinedAt,
+		CommunicationDisabledUntil: dbMember.CommunicationDisabledUntil,
+	}, nil
+}
+
 // TODO: N+1の原因なので、JOINとかでほかのクエリと一緒に取得するようにする
 func (r *memberRepository) CountByGuildID(ctx context.Context, guildID uuid.UUID) (int32, error) {
 	count, err := r.queries.CountByGuildID(ctx, guildID)
@@ -72,4 +109,8 @@ func (r *memberRepository) IsMember(ctx context.Context, guildID uuid.UUID, user
 	return exists, nil
 }
 
+func (r *memberRepository) GetGuildIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.GetGuildIDsByUserID(ctx, userID)
+}
+
 var _ domain.IMemberRepository = (*memberRepository)(nil)
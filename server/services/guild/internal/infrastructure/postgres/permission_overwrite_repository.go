@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+)
+
+type permissionOverwriteRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresPermissionOverwriteRepository(queries *gen.Queries) *permissionOverwriteRepository {
+	return &permissionOverwriteRepository{
+		queries: queries,
+	}
+}
+
+func (r *permissionOverwriteRepository) Upsert(ctx context.Context, overwrite *domain.PermissionOverwrite) (*domain.PermissionOverwrite, error) {
+	params := gen.UpsertPermissionOverwriteParams{
+		ID:        overwrite.ID,
+		GuildID:   overwrite.GuildID,
+		Allow:     int64(overwrite.Allow),
+		Deny:      int64(overwrite.Deny),
+		CreatedAt: overwrite.CreatedAt,
+	}
+	switch overwrite.ScopeType {
+	case domain.OverwriteScopeChannel:
+		params.ChannelID = &overwrite.ScopeID
+	case domain.OverwriteScopeCategory:
+		params.CategoryID = &overwrite.ScopeID
+	}
+	switch overwrite.TargetType {
+	case domain.OverwriteTargetRole:
+		params.RoleID = &overwrite.TargetID
+	case domain.OverwriteTargetMember:
+		params.UserID = &overwrite.TargetID
+	}
+
+	row, err := r.queries.UpsertPermissionOverwrite(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPermissionOverwrite(gen.GetPermissionOverwritesByScopeIDRow(*row)), nil
+}
+
+func (r *permissionOverwriteRepository) GetByScopeID(ctx context.Context, scopeID uuid.UUID) ([]*domain.PermissionOverwrite, error) {
+	rows, err := r.queries.GetPermissionOverwritesByScopeID(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	overwrites := make([]*domain.PermissionOverwrite, len(rows))
+	for i, row := range rows {
+		overwrites[i] = toDomainPermissionOverwrite(*row)
+	}
+	return overwrites, nil
+}
+
+func (r *permissionOverwriteRepository) Delete(ctx context.Context, scopeID, targetID uuid.UUID) error {
+	rows, err := r.queries.DeletePermissionOverwrite(ctx, gen.DeletePermissionOverwriteParams{
+		ScopeID:  scopeID,
+		TargetID: targetID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPermissionOverwriteNotFound
+	}
+	return nil
+}
+
+func (r *permissionOverwriteRepository) GetForMember(ctx context.Context, guildID, userID uuid.UUID) ([]*domain.PermissionOverwrite, error) {
+	rows, err := r.queries.GetMemberPermissionOverwrites(ctx, gen.GetMemberPermissionOverwritesParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	overwrites := make([]*domain.PermissionOverwrite, len(rows))
+	for i, row := range rows {
+		overwrites[i] = toDomainPermissionOverwrite(gen.GetPermissionOverwritesByScopeIDRow(*row))
+	}
+	return overwrites, nil
+}
+
+// チャンネルとカテゴリー、ロールとメンバーはそれぞれどちらか一方だけが入っている
+func toDomainPermissionOverwrite(row gen.GetPermissionOverwritesByScopeIDRow) *domain.PermissionOverwrite {
+	overwrite := &domain.PermissionOverwrite{
+		ID:        row.ID,
+		GuildID:   row.GuildID,
+		Allow:     domain.Permission(row.Allow),
+		Deny:      domain.Permission(row.Deny),
+		CreatedAt: row.CreatedAt,
+	}
+	if row.ChannelID != nil {
+		overwrite.ScopeType = domain.OverwriteScopeChannel
+		overwrite.ScopeID = *row.ChannelID
+	} else if row.CategoryID != nil {
+		overwrite.ScopeType = domain.OverwriteScopeCategory
+		overwrite.ScopeID = *row.CategoryID
+	}
+	if row.RoleID != nil {
+		overwrite.TargetType = domain.OverwriteTargetRole
+		overwrite.TargetID = *row.RoleID
+	} else if row.UserID != nil {
+		overwrite.TargetType = domain.OverwriteTargetMember
+		overwrite.TargetID = *row.UserID
+	}
+	return overwrite
+}
+
+var _ domain.IPermissionOverwriteRepository = (*permissionOverwriteRepository)(nil)
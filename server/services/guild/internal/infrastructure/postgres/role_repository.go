@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"guild-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type roleRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresRoleRepository(queries *gen.Queries) *roleRepository {
+	return &roleRepository{
+		queries: queries,
+	}
+}
+
+func (r *roleRepository) Create(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	dbRole, err := r.queries.CreateRole(ctx, gen.CreateRoleParams{
+		ID:          role.ID,
+		GuildID:     role.GuildID,
+		Name:        role.Name,
+		Permissions: int64(role.Permissions),
+		Position:    role.Position,
+		CreatedAt:   role.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Role{
+		ID:          dbRole.ID,
+		GuildID:     dbRole.GuildID,
+		Name:        dbRole.Name,
+		Permissions: domain.Permission(dbRole.Permissions),
+		Position:    dbRole.Position,
+		CreatedAt:   dbRole.CreatedAt,
+	}, nil
+}
+
+func (r *roleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Role, error) {
+	dbRole, err := r.queries.GetRoleByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	return &domain.Role{
+		ID:          dbRole.ID,
+		GuildID:     dbRole.GuildID,
+		Name:        dbRole.Name,
+		Permissions: domain.Permission(dbRole.Permissions),
+		Position:    dbRole.Position,
+		CreatedAt:   dbRole.CreatedAt,
+	}, nil
+}
+
+func (r *roleRepository) GetByGuildID(ctx context.Context, guildID uuid.UUID) ([]*domain.Role, error) {
+	dbRoles, err := r.queries.GetRolesByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*domain.Role, len(dbRoles))
+	for i, dbRole := range dbRoles {
+		roles[i] = &domain.Role{
+			ID:          dbRole.ID,
+			GuildID:     dbRole.GuildID,
+			Name:        dbRole.Name,
+			Permissions: domain.Permission(dbRole.Permissions),
+			Position:    dbRole.Position,
+			CreatedAt:   dbRole.CreatedAt,
+		}
+	}
+	return roles, nil
+}
+
+func (r *roleRepository) Update(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	dbRole, err := r.queries.UpdateRole(ctx, gen.UpdateRoleParams{
+		ID:          role.ID,
+		Name:        role.Name,
+		Permissions: int64(role.Permissions),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	return &domain.Role{
+		ID:          dbRole.ID,
+		GuildID:     dbRole.GuildID,
+		Name:        dbRole.Name,
+		Permissions: domain.Permission(dbRole.Permissions),
+		Position:    dbRole.Position,
+		CreatedAt:   dbRole.CreatedAt,
+	}, nil
+}
+
+func (r *roleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	rows, err := r.queries.DeleteRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepository) ShiftPositions(ctx context.Context, guildID uuid.UUID) error {
+	return r.queries.ShiftRolePositions(ctx, guildID)
+}
+
+func (r *roleRepository) UpdatePositions(ctx context.Context, guildID uuid.UUID, roleIDs []uuid.UUID) error {
+	return r.queries.UpdateRolePositions(ctx, gen.UpdateRolePositionsParams{
+		GuildID: guildID,
+		RoleIds: roleIDs,
+	})
+}
+
+func (r *roleRepository) AddToMember(ctx context.Context, guildID, userID, roleID uuid.UUID) error {
+	return r.queries.AddMemberRole(ctx, gen.AddMemberRoleParams{
+		GuildID: guildID,
+		UserID:  userID,
+		RoleID:  roleID,
+	})
+}
+
+func (r *roleRepository) RemoveFromMember(ctx context.Context, guildID, userID, roleID uuid.UUID) error {
+	rows, err := r.queries.RemoveMemberRole(ctx, gen.RemoveMemberRoleParams{
+		GuildID: guildID,
+		UserID:  userID,
+		RoleID:  roleID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepository) GetMemberRoleIDs(ctx context.Context, guildID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := r.queries.GetMemberRolesByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		roleIDs[row.UserID] = append(roleIDs[row.UserID], row.RoleID)
+	}
+	return roleIDs, nil
+}
+
+func (r *roleRepository) GetMemberPermissions(ctx context.Context, guildID, userID uuid.UUID) (*domain.GuildPermissions, error) {
+	row, err := r.queries.GetMemberPermissions(ctx, gen.GetMemberPermissionsParams{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrGuildNotFound
+		}
+		return nil, err
+	}
+	return &domain.GuildPermissions{
+		GuildID:                    guildID,
+		IsOwner:                    row.IsOwner,
+		IsMember:                   row.IsMember,
+		Permissions:                domain.Permission(row.Permissions),
+		TopPosition:                row.TopPosition,
+		CommunicationDisabledUntil: row.CommunicationDisabledUntil,
+	}, nil
+}
+
+var _ domain.IRoleRepository = (*roleRepository)(nil)
@@ -16,6 +16,11 @@ type PostgresStore struct {
 	categories domain.ICategoryRepository
 	members    domain.IMemberRepository
 	invites    domain.IInviteRepository
+	roles      domain.IRoleRepository
+	overwrites domain.IPermissionOverwriteRepository
+	bans       domain.IBanRepository
+	auditLogs  domain.IAuditLogRepository
+	purgeJobs  domain.IChannelPurgeJobRepository
 }
 
 func NewPostgresStore(db *pgxpool.Pool) domain.IStore {
@@ -28,6 +33,11 @@ func NewPostgresStore(db *pgxpool.Pool) domain.IStore {
 		categories: NewPostgresCategoryRepository(q),
 		members:    NewPostgresMemberRepository(q),
 		invites:    NewPostgresInviteRepository(q),
+		roles:      NewPostgresRoleRepository(q),
+		overwrites: NewPostgresPermissionOverwriteRepository(q),
+		bans:       NewPostgresBanRepository(q),
+		auditLogs:  NewPostgresAuditLogRepository(q),
+		purgeJobs:  NewPostgresChannelPurgeJobRepository(q),
 	}
 }
 
@@ -51,6 +61,26 @@ func (s *PostgresStore) Invites() domain.IInviteRepository {
 	return s.invites
 }
 
+func (s *PostgresStore) Roles() domain.IRoleRepository {
+	return s.roles
+}
+
+func (s *PostgresStore) PermissionOverwrites() domain.IPermissionOverwriteRepository {
+	return s.overwrites
+}
+
+func (s *PostgresStore) Bans() domain.IBanRepository {
+	return s.bans
+}
+
+func (s *PostgresStore) AuditLogs() domain.IAuditLogRepository {
+	return s.auditLogs
+}
+
+func (s *PostgresStore) ChannelPurgeJobs() domain.IChannelPurgeJobRepository {
+	return s.purgeJobs
+}
+
 func (s *PostgresStore) ExecTx(ctx context.Context, fn func(domain.IStore) error) error {
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
@@ -66,6 +96,11 @@ func (s *PostgresStore) ExecTx(ctx context.Context, fn func(domain.IStore) error
 		categories: NewPostgresCategoryRepository(txQueries),
 		members:    NewPostgresMemberRepository(txQueries),
 		invites:    NewPostgresInviteRepository(txQueries),
+		roles:      NewPostgresRoleRepository(txQueries),
+		overwrites: NewPostgresPermissionOverwriteRepository(txQueries),
+		bans:       NewPostgresBanRepository(txQueries),
+		auditLogs:  NewPostgresAuditLogRepository(txQueries),
+		purgeJobs:  NewPostgresChannelPurgeJobRepository(txQueries),
 	}
 
 	err = fn(txStore)
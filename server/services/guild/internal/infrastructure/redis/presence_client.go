@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"guild-service/internal/domain"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// realtime-serviceが書き込むキーと同じ形式にする
+const presenceKeyPrefix = "presence:"
+
+type PresenceClient struct {
+	client *redis.Client
+}
+
+func NewPresenceClient(client *redis.Client) *PresenceClient {
+	return &PresenceClient{
+		client: client,
+	}
+}
+
+// presence:{userID}には接続中のセッションが有効期限をスコアとして入っている。
+// 期限内のセッションが1つもなければoffline、あればユーザーが選んだ状態(未設定ならonline)を返す
+func (c *PresenceClient) GetStatuses(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]domain.PresenceStatus, error) {
+	statuses := make(map[uuid.UUID]domain.PresenceStatus, len(userIDs))
+	if len(userIDs) == 0 {
+		return statuses, nil
+	}
+
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	pipe := c.client.Pipeline()
+	liveCmds := make([]*redis.IntCmd, len(userIDs))
+	statusCmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		key := presenceKeyPrefix + userID.String()
+		liveCmds[i] = pipe.ZCount(ctx, key, "("+now, "+inf")
+		statusCmds[i] = pipe.Get(ctx, key+":status")
+	}
+	// 状態を選んでいないユーザーのGETはredis.Nilになるので、コマンドごとに確認する
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	for i, userID := range userIDs {
+		if liveCmds[i].Val() == 0 {
+			statuses[userID] = domain.PresenceStatusOffline
+			continue
+		}
+		status := domain.PresenceStatus(statusCmds[i].Val())
+		switch status {
+		case domain.PresenceStatusIdle, domain.PresenceStatusDnd:
+			statuses[userID] = status
+		default:
+			statuses[userID] = domain.PresenceStatusOnline
+		}
+	}
+	return statuses, nil
+}
+
+var _ domain.IPresenceService = (*PresenceClient)(nil)
@@ -0,0 +1,254 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	RedisChannelGuildPrefix    = "guild"
+	EventTypeGuildUpdate       = "GUILD_UPDATE"
+	EventTypeGuildDelete       = "GUILD_DELETE"
+	EventTypeGuildOwnerUpdate  = "GUILD_OWNER_UPDATE"
+	EventTypeCategoryCreate    = "CATEGORY_CREATE"
+	EventTypeCategoryUpdate    = "CATEGORY_UPDATE"
+	EventTypeCategoryDelete    = "CATEGORY_DELETE"
+	EventTypeChannelCreate     = "CHANNEL_CREATE"
+	EventTypeChannelUpdate     = "CHANNEL_UPDATE"
+	EventTypeChannelDelete     = "CHANNEL_DELETE"
+	EventTypeChannelsReorder   = "CHANNELS_REORDER"
+	EventTypeGuildMemberAdd    = "GUILD_MEMBER_ADD"
+	EventTypeGuildMemberRemove = "GUILD_MEMBER_REMOVE"
+	EventTypeGuildMemberUpdate = "GUILD_MEMBER_UPDATE"
+	EventTypePermissionsUpdate = "PERMISSIONS_UPDATE"
+)
+
+type Event struct {
+	Type      string          `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type GuildData struct {
+	ID               uuid.UUID  `json:"id"`
+	OwnerID          *uuid.UUID `json:"ownerId,omitempty"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	IconURL          string     `json:"iconUrl"`
+	DefaultChannelID uuid.UUID  `json:"defaultChannelId"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+type GuildDeletedData struct {
+	GuildID uuid.UUID `json:"guildId"`
+}
+
+type GuildOwnerUpdatedData struct {
+	GuildID         uuid.UUID `json:"guildId"`
+	PreviousOwnerID uuid.UUID `json:"previousOwnerId"`
+	OwnerID         uuid.UUID `json:"ownerId"`
+}
+
+type CategoryData struct {
+	ID        uuid.UUID `json:"id"`
+	GuildID   uuid.UUID `json:"guildId"`
+	Name      string    `json:"name"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ChannelData struct {
+	ID         uuid.UUID `json:"id"`
+	GuildID    uuid.UUID `json:"guildId"`
+	CategoryID uuid.UUID `json:"categoryId"`
+	Name       string    `json:"name"`
+	Position   int32     `json:"position"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type CategoryLayoutData struct {
+	CategoryID uuid.UUID   `json:"categoryId"`
+	ChannelIDs []uuid.UUID `json:"channelIds"`
+}
+
+// カテゴリーは上から順、チャンネルはそれぞれのカテゴリー内で上から順
+type ChannelsReorderedData struct {
+	GuildID    uuid.UUID            `json:"guildId"`
+	Categories []CategoryLayoutData `json:"categories"`
+}
+
+type MemberAddedData struct {
+	GuildID  uuid.UUID `json:"guildId"`
+	UserID   uuid.UUID `json:"userId"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+type MemberRemovedData struct {
+	GuildID uuid.UUID `json:"guildId"`
+	UserID  uuid.UUID `json:"userId"`
+}
+
+type MemberUpdatedData struct {
+	GuildID                    uuid.UUID  `json:"guildId"`
+	UserID                     uuid.UUID  `json:"userId"`
+	CommunicationDisabledUntil *time.Time `json:"communicationDisabledUntil"`
+}
+
+// UserIDがnullの場合はギルドの全メンバーが対象
+type PermissionsUpdatedData struct {
+	GuildID uuid.UUID  `json:"guildId"`
+	UserID  *uuid.UUID `json:"userId"`
+}
+
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+func NewRedisPublisher(client *redis.Client) *RedisPublisher {
+	return &RedisPublisher{
+		client: client,
+	}
+}
+
+func (p *RedisPublisher) PublishGuildUpdated(ctx context.Context, guild *domain.Guild) error {
+	return p.publish(ctx, guild.ID, EventTypeGuildUpdate, GuildData{
+		ID:               guild.ID,
+		OwnerID:          guild.OwnerID,
+		Name:             guild.Name,
+		Description:      guild.Description,
+		IconURL:          guild.IconURL,
+		DefaultChannelID: guild.DefaultChannelID,
+		CreatedAt:        guild.CreatedAt,
+	})
+}
+
+func (p *RedisPublisher) PublishGuildDeleted(ctx context.Context, guildID uuid.UUID) error {
+	return p.publish(ctx, guildID, EventTypeGuildDelete, GuildDeletedData{
+		GuildID: guildID,
+	})
+}
+
+func (p *RedisPublisher) PublishGuildOwnerUpdated(ctx context.Context, guildID, previousOwnerID, ownerID uuid.UUID) error {
+	return p.publish(ctx, guildID, EventTypeGuildOwnerUpdate, GuildOwnerUpdatedData{
+		GuildID:         guildID,
+		PreviousOwnerID: previousOwnerID,
+		OwnerID:         ownerID,
+	})
+}
+
+func (p *RedisPublisher) PublishCategoryCreated(ctx context.Context, category *domain.Category) error {
+	return p.publish(ctx, category.GuildID, EventTypeCategoryCreate, newCategoryData(category))
+}
+
+func (p *RedisPublisher) PublishCategoryUpdated(ctx context.Context, category *domain.Category) error {
+	return p.publish(ctx, category.GuildID, EventTypeCategoryUpdate, newCategoryData(category))
+}
+
+func (p *RedisPublisher) PublishCategoryDeleted(ctx context.Context, category *domain.Category) error {
+	return p.publish(ctx, category.GuildID, EventTypeCategoryDelete, newCategoryData(category))
+}
+
+func (p *RedisPublisher) PublishChannelCreated(ctx context.Context, guildID uuid.UUID, channel *domain.Channel) error {
+	return p.publish(ctx, guildID, EventTypeChannelCreate, newChannelData(guildID, channel))
+}
+
+func (p *RedisPublisher) PublishChannelUpdated(ctx context.Context, guildID uuid.UUID, channel *domain.Channel) error {
+	return p.publish(ctx, guildID, EventTypeChannelUpdate, newChannelData(guildID, channel))
+}
+
+func (p *RedisPublisher) PublishChannelDeleted(ctx context.Context, guildID uuid.UUID, channel *domain.Channel) error {
+	return p.publish(ctx, guildID, EventTypeChannelDelete, newChannelData(guildID, channel))
+}
+
+func (p *RedisPublisher) PublishChannelsReordered(ctx context.Context, guildID uuid.UUID, layout []*domain.CategoryLayout) error {
+	categories := make([]CategoryLayoutData, len(layout))
+	for i, category := range layout {
+		categories[i] = CategoryLayoutData{
+			CategoryID: category.CategoryID,
+			ChannelIDs: category.ChannelIDs,
+		}
+	}
+	return p.publish(ctx, guildID, EventTypeChannelsReorder, ChannelsReorderedData{
+		GuildID:    guildID,
+		Categories: categories,
+	})
+}
+
+func (p *RedisPublisher) PublishMemberAdded(ctx context.Context, member *domain.Member) error {
+	return p.publish(ctx, member.GuildID, EventTypeGuildMemberAdd, MemberAddedData{
+		GuildID:  member.GuildID,
+		UserID:   member.UserID,
+		JoinedAt: member.JoinedAt,
+	})
+}
+
+func (p *RedisPublisher) PublishMemberRemoved(ctx context.Context, guildID, userID uuid.UUID) error {
+	return p.publish(ctx, guildID, EventTypeGuildMemberRemove, MemberRemovedData{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+}
+
+func (p *RedisPublisher) PublishMemberUpdated(ctx context.Context, member *domain.Member) error {
+	return p.publish(ctx, member.GuildID, EventTypeGuildMemberUpdate, MemberUpdatedData{
+		GuildID:                    member.GuildID,
+		UserID:                     member.UserID,
+		CommunicationDisabledUntil: member.CommunicationDisabledUntil,
+	})
+}
+
+func (p *RedisPublisher) PublishPermissionsUpdated(ctx context.Context, guildID uuid.UUID, userID *uuid.UUID) error {
+	return p.publish(ctx, guildID, EventTypePermissionsUpdate, PermissionsUpdatedData{
+		GuildID: guildID,
+		UserID:  userID,
+	})
+}
+
+func newCategoryData(category *domain.Category) CategoryData {
+	return CategoryData{
+		ID:        category.ID,
+		GuildID:   category.GuildID,
+		Name:      category.Name,
+		Position:  category.Position,
+		CreatedAt: category.CreatedAt,
+	}
+}
+
+func newChannelData(guildID uuid.UUID, channel *domain.Channel) ChannelData {
+	return ChannelData{
+		ID:         channel.ID,
+		GuildID:    guildID,
+		CategoryID: channel.CategoryID,
+		Name:       channel.Name,
+		Position:   channel.Position,
+		CreatedAt:  channel.CreatedAt,
+	}
+}
+
+func (p *RedisPublisher) publish(ctx context.Context, guildID uuid.UUID, eventType string, data any) error {
+	redisChannel := RedisChannelGuildPrefix + ":" + guildID.String()
+
+	dataJson, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	payload := Event{
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Data:      dataJson,
+	}
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return p.client.Publish(ctx, redisChannel, payloadJson).Err()
+}
+
+var _ domain.IPublisher = (*RedisPublisher)(nil)
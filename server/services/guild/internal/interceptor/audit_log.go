@@ -0,0 +1,19 @@
+package interceptor
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"shared/metadata"
+
+	"google.golang.org/grpc"
+)
+
+// api-gatewayが転送した監査ログの理由を、usecaseから取り出せるようにする
+func AuditLogReason() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if reason := metadata.GetAuditLogReasonFromMetadata(ctx); reason != "" {
+			ctx = domain.WithAuditLogReason(ctx, reason)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"guild-service/internal/domain"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+const (
+	DEFAULT_AUDIT_LOG_LIMIT = 50
+)
+
+type AuditLogUsecase interface {
+	List(ctx context.Context, params *ListAuditLogParams) (*ListAuditLogResult, error)
+}
+
+type auditLogUsecase struct {
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	validator   *validator.Validate
+}
+
+func NewAuditLogUsecase(store domain.IStore, permissions *domain.PermissionResolver, validator *validator.Validate) AuditLogUsecase {
+	return &auditLogUsecase{
+		store:       store,
+		permissions: permissions,
+		validator:   validator,
+	}
+}
+
+type ListAuditLogParams struct {
+	GuildID  uuid.UUID `validate:"required"`
+	UserID   uuid.UUID `validate:"required"`
+	ActorID  *uuid.UUID
+	Action   *domain.AuditLogAction
+	TargetID *uuid.UUID
+	// 前回の結果の最後の記録のID
+	Cursor *uuid.UUID `validate:"omitempty"`
+	// 0の場合はDEFAULT_AUDIT_LOG_LIMIT
+	Limit int32 `validate:"omitempty,min=1,max=100"`
+}
+
+type ListAuditLogResult struct {
+	Entries []*domain.AuditLog
+	// 続きがない場合はnil
+	NextCursor *uuid.UUID
+}
+
+// ギルドの管理権限を持つメンバーだけが見られる
+func (u *auditLogUsecase) List(ctx context.Context, params *ListAuditLogParams) (*ListAuditLogResult, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidAuditLogQuery
+	}
+	if params.Action != nil && !params.Action.IsValid() {
+		return nil, domain.ErrInvalidAuditLogQuery
+	}
+
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageGuild); err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = DEFAULT_AUDIT_LOG_LIMIT
+	}
+
+	query := &domain.AuditLogQuery{
+		GuildID:  params.GuildID,
+		ActorID:  params.ActorID,
+		Action:   params.Action,
+		TargetID: params.TargetID,
+		// 続きがあるかを知るために1件多く取る
+		Limit: limit + 1,
+	}
+	if params.Cursor != nil {
+		cursor, err := u.store.AuditLogs().GetByID(ctx, *params.Cursor)
+		if err != nil {
+			if err == domain.ErrAuditLogNotFound {
+				return nil, domain.ErrInvalidAuditLogQuery
+			}
+			return nil, err
+		}
+		if cursor.GuildID != params.GuildID {
+			return nil, domain.ErrInvalidAuditLogQuery
+		}
+		query.Cursor = cursor
+	}
+
+	entries, err := u.store.AuditLogs().List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListAuditLogResult{Entries: entries}
+	if int32(len(entries)) > limit {
+		result.Entries = entries[:limit]
+		nextCursor := result.Entries[len(result.Entries)-1].ID
+		result.NextCursor = &nextCursor
+	}
+	return result, nil
+}
+
+var _ AuditLogUsecase = (*auditLogUsecase)(nil)
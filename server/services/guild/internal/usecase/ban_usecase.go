@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type BanUsecase interface {
+	Ban(ctx context.Context, params *BanMemberParams) (*BanResult, error)
+	Unban(ctx context.Context, params *UnbanMemberParams) error
+	List(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Ban, error)
+}
+
+type banUsecase struct {
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	userSvc     domain.IUserService
+	messageSvc  domain.IMessageService
+	publisher   domain.IPublisher
+	validator   *validator.Validate
+}
+
+func NewBanUsecase(store domain.IStore, permissions *domain.PermissionResolver, userSvc domain.IUserService, messageSvc domain.IMessageService, publisher domain.IPublisher, validator *validator.Validate) BanUsecase {
+	return &banUsecase{
+		store:       store,
+		permissions: permissions,
+		userSvc:     userSvc,
+		messageSvc:  messageSvc,
+		publisher:   publisher,
+		validator:   validator,
+	}
+}
+
+type BanMemberParams struct {
+	GuildID      uuid.UUID  `validate:"required"`
+	UserID       uuid.UUID  `validate:"required"`
+	TargetUserID uuid.UUID  `validate:"required"`
+	Reason       string     `validate:"max=512"`
+	ExpiresAt    *time.Time `validate:"omitempty"`
+	// 0より大きい場合、その期間内に送信されたメッセージを削除する
+	DeleteMessageDuration time.Duration
+}
+
+type BanResult struct {
+	Ban *domain.Ban
+	// メッセージの削除に失敗した理由。nilでなくても、BANとメンバーの削除はコミット済み
+	MessageDeleteErr error
+}
+
+// 対象がメンバーの場合はギルドから外す。メンバーでないユーザーも事前にBANできる
+func (u *banUsecase) Ban(ctx context.Context, params *BanMemberParams) (*BanResult, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidBanData
+	}
+	if params.ExpiresAt != nil && params.ExpiresAt.Before(time.Now()) {
+		return nil, domain.ErrInvalidBanData
+	}
+	if params.DeleteMessageDuration < 0 || params.DeleteMessageDuration > domain.MaxBanDeleteMessageDuration {
+		return nil, domain.ErrInvalidBanData
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionBanMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.permissions.Resolve(ctx, params.GuildID, params.TargetUserID)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsOwner {
+		return nil, domain.ErrCannotBanOwner
+	}
+	if target.IsMember {
+		if !perms.Outranks(target.TopPosition) {
+			return nil, domain.ErrPermissionDenied
+		}
+	} else {
+		exists, err := u.userSvc.Exists(params.TargetUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, domain.ErrUserNotFound
+		}
+	}
+
+	now := time.Now()
+	var ban *domain.Ban
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		ban, err = tx.Bans().Upsert(ctx, &domain.Ban{
+			GuildID:     params.GuildID,
+			UserID:      params.TargetUserID,
+			ModeratorID: &params.UserID,
+			Reason:      params.Reason,
+			ExpiresAt:   params.ExpiresAt,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return err
+		}
+
+		if target.IsMember {
+			if err := tx.Members().Remove(ctx, params.GuildID, params.TargetUserID); err != nil {
+				return err
+			}
+		}
+
+		auditLog := domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberBan, &params.TargetUserID).
+			Change("expires_at", nil, ban.ExpiresAt)
+		if params.DeleteMessageDuration > 0 {
+			auditLog.Change("delete_message_seconds", nil, int64(params.DeleteMessageDuration.Seconds()))
+		}
+		// BANの理由を指定した場合はヘッダーの理由より優先する
+		if params.Reason != "" {
+			auditLog.Reason = params.Reason
+		}
+		return tx.AuditLogs().Create(ctx, auditLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if target.IsMember {
+		if err := u.publisher.PublishMemberRemoved(ctx, params.GuildID, params.TargetUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &BanResult{Ban: ban}
+	if params.DeleteMessageDuration > 0 {
+		result.MessageDeleteErr = u.deleteMessagesSince(ctx, params.GuildID, params.TargetUserID, now.Add(-params.DeleteMessageDuration))
+	}
+
+	return result, nil
+}
+
+// BANはコミット済みなので、ここで失敗してもBAN自体は取り消さない
+func (u *banUsecase) deleteMessagesSince(ctx context.Context, guildID, userID uuid.UUID, since time.Time) error {
+	channels, err := u.store.Channels().GetByGuildID(ctx, guildID)
+	if err != nil {
+		return err
+	}
+	channelIDs := make([]uuid.UUID, len(channels))
+	for i, channel := range channels {
+		channelIDs[i] = channel.ID
+	}
+	_, err = u.messageSvc.DeleteUserMessages(ctx, userID, channelIDs, since)
+	return err
+}
+
+type UnbanMemberParams struct {
+	GuildID      uuid.UUID `validate:"required"`
+	UserID       uuid.UUID `validate:"required"`
+	TargetUserID uuid.UUID `validate:"required"`
+}
+
+func (u *banUsecase) Unban(ctx context.Context, params *UnbanMemberParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidBanData
+	}
+
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionBanMembers); err != nil {
+		return err
+	}
+
+	return u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Bans().Delete(ctx, params.GuildID, params.TargetUserID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberUnban, &params.TargetUserID))
+	})
+}
+
+// 期限切れのものを除いたBANを新しい順に返す
+func (u *banUsecase) List(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Ban, error) {
+	if _, err := u.permissions.Require(ctx, guildID, userID, domain.PermissionBanMembers); err != nil {
+		return nil, err
+	}
+
+	bans, err := u.store.Bans().GetActiveByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bans) == 0 {
+		return bans, nil
+	}
+
+	userIDs := make([]uuid.UUID, len(bans))
+	for i, ban := range bans {
+		userIDs[i] = ban.UserID
+	}
+	users, err := u.userSvc.GetUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[uuid.UUID]*domain.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+	for _, ban := range bans {
+		ban.User = userMap[ban.UserID]
+	}
+
+	return bans, nil
+}
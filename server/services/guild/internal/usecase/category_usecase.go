@@ -11,17 +11,23 @@ import (
 
 type CategoryUsecase interface {
 	CreateCategory(ctx context.Context, params *CreateCategoryParams) (*domain.Category, error)
+	UpdateCategory(ctx context.Context, params *UpdateCategoryParams) (*domain.Category, error)
+	DeleteCategory(ctx context.Context, params *DeleteCategoryParams) error
 }
 
 type categoryUsecase struct {
-	store     domain.IStore
-	validator *validator.Validate
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	publisher   domain.IPublisher
+	validator   *validator.Validate
 }
 
-func NewCategoryUsecase(store domain.IStore, validator *validator.Validate) CategoryUsecase {
+func NewCategoryUsecase(store domain.IStore, permissions *domain.PermissionResolver, publisher domain.IPublisher, validator *validator.Validate) CategoryUsecase {
 	return &categoryUsecase{
-		store:     store,
-		validator: validator,
+		store:       store,
+		permissions: permissions,
+		publisher:   publisher,
+		validator:   validator,
 	}
 }
 
@@ -36,20 +42,163 @@ func (u *categoryUsecase) CreateCategory(ctx context.Context, params *CreateCate
 		return nil, domain.ErrInvalidCategoryData
 	}
 
-	isOwner, err := u.store.Guilds().IsOwner(ctx, params.GuildID, params.UserID)
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	var category *domain.Category
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// 並べ替えと同時に行われても位置が重ならないよう、ギルドをロックしてから末尾に追加する
+		if err := tx.Guilds().LockByID(ctx, params.GuildID); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = tx.Categories().Create(ctx, &domain.Category{
+			ID:        uuid.New(),
+			GuildID:   params.GuildID,
+			Name:      params.Name,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogCategoryCreate, &category.ID).
+			Change("name", nil, category.Name))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishCategoryCreated(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+type UpdateCategoryParams struct {
+	CategoryID uuid.UUID `validate:"required"`
+	UserID     uuid.UUID `validate:"required"`
+	Name       string    `validate:"required,min=1,max=100"`
+}
+
+func (u *categoryUsecase) UpdateCategory(ctx context.Context, params *UpdateCategoryParams) (*domain.Category, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidCategoryData
+	}
+
+	guildID, err := u.store.Categories().GetGuildIDByCategoryID(ctx, params.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	var category *domain.Category
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		before, err := tx.Categories().GetByID(ctx, params.CategoryID)
+		if err != nil {
+			return err
+		}
+
+		category, err = tx.Categories().Update(ctx, &domain.Category{
+			ID:   params.CategoryID,
+			Name: params.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogCategoryUpdate, &category.ID).
+			Change("name", before.Name, category.Name))
+	})
 	if err != nil {
 		return nil, err
 	}
-	if !isOwner {
-		return nil, domain.ErrPermissionDenied
+
+	if err := u.publisher.PublishCategoryUpdated(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+type DeleteCategoryParams struct {
+	CategoryID uuid.UUID `validate:"required"`
+	UserID     uuid.UUID `validate:"required"`
+}
+
+// カテゴリ内のチャンネルはギルドで最も古い別のカテゴリに移す。ギルドの最後のカテゴリは削除できない
+func (u *categoryUsecase) DeleteCategory(ctx context.Context, params *DeleteCategoryParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	category, err := u.store.Categories().GetByID(ctx, params.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.permissions.Require(ctx, category.GuildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return err
 	}
 
-	return u.store.Categories().Create(ctx, &domain.Category{
-		ID:        uuid.New(),
-		GuildID:   params.GuildID,
-		Name:      params.Name,
-		CreatedAt: time.Now(),
+	var moved []*domain.Channel
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Guilds().LockByID(ctx, category.GuildID); err != nil {
+			return err
+		}
+
+		// カテゴリーの上書きは一緒に消えるので、残したまま削除すると移したチャンネルが見えるようになってしまう
+		overwrites, err := tx.PermissionOverwrites().GetByScopeID(ctx, category.ID)
+		if err != nil {
+			return err
+		}
+		if len(overwrites) > 0 {
+			return domain.ErrCategoryHasOverwrites
+		}
+
+		fallback, err := tx.Categories().GetOldestExcept(ctx, category.GuildID, category.ID)
+		if err != nil {
+			if err == domain.ErrCategoryNotFound {
+				return domain.ErrLastCategoryUndeletable
+			}
+			return err
+		}
+
+		moved, err = tx.Channels().MoveToCategory(ctx, category.ID, fallback.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Categories().Delete(ctx, category.ID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, category.GuildID, params.UserID, domain.AuditLogCategoryDelete, &category.ID).
+			Change("name", category.Name, nil))
 	})
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range moved {
+		if err := u.publisher.PublishChannelUpdated(ctx, category.GuildID, channel); err != nil {
+			return err
+		}
+	}
+	if err := u.publisher.PublishCategoryDeleted(ctx, category); err != nil {
+		return err
+	}
+	// 移したチャンネルは移動先のカテゴリーの上書きを引き継ぐ
+	if len(moved) == 0 {
+		return nil
+	}
+	return u.publisher.PublishPermissionsUpdated(ctx, category.GuildID, nil)
 }
 
 var _ CategoryUsecase = (*categoryUsecase)(nil)
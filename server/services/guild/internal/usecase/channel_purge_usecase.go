@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 1回の取り出しで処理するジョブの件数
+	CHANNEL_PURGE_BATCH_SIZE = 50
+	// 取り出したジョブをほかのワーカーから隠しておく時間。CHANNEL_PURGE_CALL_TIMEOUTより長くする
+	CHANNEL_PURGE_LEASE_DURATION = 5 * time.Minute
+	// 1件の削除依頼を待つ時間
+	CHANNEL_PURGE_CALL_TIMEOUT = 2 * time.Minute
+	// 失敗したジョブの再試行までの待ち時間。失敗するたびに倍にし、最大で1時間あける
+	CHANNEL_PURGE_RETRY_BASE_DELAY = 10 * time.Second
+	CHANNEL_PURGE_RETRY_MAX_DELAY  = time.Hour
+)
+
+type ChannelPurgeUsecase interface {
+	// 実行時刻を過ぎたジョブがなくなるまで処理する
+	ProcessDue(ctx context.Context) (*ChannelPurgeResult, error)
+}
+
+type ChannelPurgeResult struct {
+	Succeeded int
+	// LastErrorに失敗の理由が入る
+	Failed []*domain.ChannelPurgeJob
+}
+
+type channelPurgeUsecase struct {
+	store      domain.IStore
+	messageSvc domain.IMessageService
+	mediaSvc   domain.IMediaService
+}
+
+func NewChannelPurgeUsecase(store domain.IStore, messageSvc domain.IMessageService, mediaSvc domain.IMediaService) ChannelPurgeUsecase {
+	return &channelPurgeUsecase{
+		store:      store,
+		messageSvc: messageSvc,
+		mediaSvc:   mediaSvc,
+	}
+}
+
+func (u *channelPurgeUsecase) ProcessDue(ctx context.Context) (*ChannelPurgeResult, error) {
+	result := &ChannelPurgeResult{}
+	for {
+		now := time.Now()
+		jobs, err := u.store.ChannelPurgeJobs().ClaimDue(ctx, now, now.Add(CHANNEL_PURGE_LEASE_DURATION), CHANNEL_PURGE_BATCH_SIZE)
+		if err != nil {
+			return result, err
+		}
+
+		for _, job := range jobs {
+			if err := u.purge(ctx, job); err != nil {
+				lastError := err.Error()
+				job.LastError = &lastError
+				job.NextRunAt = time.Now().Add(channelPurgeRetryDelay(job.Attempts))
+				if err := u.store.ChannelPurgeJobs().Reschedule(ctx, job.ID, job.NextRunAt, lastError); err != nil {
+					return result, err
+				}
+				result.Failed = append(result.Failed, job)
+				continue
+			}
+			// 削除依頼は冪等なので、ここで失敗してもリース切れ後にもう一度実行されるだけで済む
+			if err := u.store.ChannelPurgeJobs().Delete(ctx, job.ID); err != nil {
+				return result, err
+			}
+			result.Succeeded++
+		}
+
+		if len(jobs) < CHANNEL_PURGE_BATCH_SIZE {
+			return result, nil
+		}
+	}
+}
+
+func (u *channelPurgeUsecase) purge(ctx context.Context, job *domain.ChannelPurgeJob) error {
+	ctx, cancel := context.WithTimeout(ctx, CHANNEL_PURGE_CALL_TIMEOUT)
+	defer cancel()
+
+	switch job.Target {
+	case domain.ChannelPurgeTargetMessages:
+		return u.messageSvc.PurgeChannelMessages(ctx, []uuid.UUID{job.ChannelID})
+	case domain.ChannelPurgeTargetAttachments:
+		return u.mediaSvc.PurgeChannelAttachments(ctx, []uuid.UUID{job.ChannelID})
+	default:
+		return fmt.Errorf("unknown channel purge target: %s", job.Target)
+	}
+}
+
+// attemptsは取り出された回数なので1以上
+func channelPurgeRetryDelay(attempts int32) time.Duration {
+	delay := CHANNEL_PURGE_RETRY_BASE_DELAY
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= CHANNEL_PURGE_RETRY_MAX_DELAY {
+			return CHANNEL_PURGE_RETRY_MAX_DELAY
+		}
+	}
+	return delay
+}
+
+var _ ChannelPurgeUsecase = (*channelPurgeUsecase)(nil)
@@ -11,18 +11,28 @@ import (
 
 type ChannelUsecase interface {
 	Create(ctx context.Context, params *CreateChannelParams) (*domain.Channel, error)
-	CheckAccess(ctx context.Context, userID, channelID uuid.UUID) (bool, error)
+	Update(ctx context.Context, params *UpdateChannelParams) (*domain.Channel, error)
+	Delete(ctx context.Context, params *DeleteChannelParams) error
+	Reorder(ctx context.Context, params *ReorderChannelsParams) ([]*domain.CategoryLayout, error)
+	CheckAccess(ctx context.Context, userID, channelID uuid.UUID) (*domain.ChannelAccess, error)
+	FilterMentionTargets(ctx context.Context, params *FilterMentionTargetsParams) (*FilterMentionTargetsResult, error)
+	GetAccessibleIDs(ctx context.Context, userID, guildID uuid.UUID) ([]uuid.UUID, error)
+	FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error)
 }
 
 type channelUsecase struct {
-	store     domain.IStore
-	validator *validator.Validate
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	publisher   domain.IPublisher
+	validator   *validator.Validate
 }
 
-func NewChannelUsecase(store domain.IStore, validator *validator.Validate) ChannelUsecase {
+func NewChannelUsecase(store domain.IStore, permissions *domain.PermissionResolver, publisher domain.IPublisher, validator *validator.Validate) ChannelUsecase {
 	return &channelUsecase{
-		store:     store,
-		validator: validator,
+		store:       store,
+		permissions: permissions,
+		publisher:   publisher,
+		validator:   validator,
 	}
 }
 
@@ -42,28 +52,375 @@ func (u *channelUsecase) Create(ctx context.Context, params *CreateChannelParams
 		return nil, err
 	}
 
-	isOwner, err := u.store.Guilds().IsOwner(ctx, guildID, params.UserID)
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	var channel *domain.Channel
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// 並べ替えと同時に行われても位置が重ならないよう、ギルドをロックしてから末尾に追加する
+		if err := tx.Guilds().LockByID(ctx, guildID); err != nil {
+			return err
+		}
+
+		channel, err = tx.Channels().Create(ctx, &domain.Channel{
+			ID:         uuid.New(),
+			CategoryID: params.CategoryID,
+			Name:       params.Name,
+			CreatedAt:  time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogChannelCreate, &channel.ID).
+			Change("name", nil, channel.Name).
+			Change("category_id", nil, channel.CategoryID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishChannelCreated(ctx, guildID, channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+type UpdateChannelParams struct {
+	ChannelID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	Name      string    `validate:"required,min=1,max=100"`
+}
+
+func (u *channelUsecase) Update(ctx context.Context, params *UpdateChannelParams) (*domain.Channel, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidChannelData
+	}
+
+	guildID, err := u.store.Channels().GetGuildIDByChannelID(ctx, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return nil, err
+	}
+
+	var channel *domain.Channel
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		before, err := tx.Channels().GetByID(ctx, params.ChannelID)
+		if err != nil {
+			return err
+		}
+
+		channel, err = tx.Channels().Update(ctx, &domain.Channel{
+			ID:   params.ChannelID,
+			Name: params.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogChannelUpdate, &channel.ID).
+			Change("name", before.Name, channel.Name))
+	})
 	if err != nil {
 		return nil, err
 	}
-	if !isOwner {
-		return nil, domain.ErrPermissionDenied
+
+	if err := u.publisher.PublishChannelUpdated(ctx, guildID, channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+type DeleteChannelParams struct {
+	ChannelID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+// ギルドのデフォルトチャンネルは削除できない
+func (u *channelUsecase) Delete(ctx context.Context, params *DeleteChannelParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	guildID, err := u.store.Channels().GetGuildIDByChannelID(ctx, params.ChannelID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return err
+	}
+
+	guild, err := u.store.Guilds().GetByID(ctx, guildID)
+	if err != nil {
+		return err
+	}
+	if guild.DefaultChannelID == params.ChannelID {
+		return domain.ErrDefaultChannelUndeletable
+	}
+
+	channel, err := u.store.Channels().GetByID(ctx, params.ChannelID)
+	if err != nil {
+		return err
+	}
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Guilds().LockByID(ctx, guildID); err != nil {
+			return err
+		}
+		if err := tx.Channels().Delete(ctx, params.ChannelID); err != nil {
+			return err
+		}
+		// messagesはchannelsを参照していないので、メッセージと添付ファイルはコミット後にワーカーが消す
+		if err := tx.ChannelPurgeJobs().CreateMany(ctx, domain.NewChannelPurgeJobs([]uuid.UUID{channel.ID}, time.Now())); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogChannelDelete, &channel.ID).
+			Change("name", channel.Name, nil).
+			Change("category_id", channel.CategoryID, nil))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishChannelDeleted(ctx, guildID, channel)
+}
+
+type ReorderChannelsParams struct {
+	GuildID uuid.UUID `validate:"required"`
+	UserID  uuid.UUID `validate:"required"`
+	// ギルドのすべてのカテゴリーを上から順に並べ、それぞれにすべてのチャンネルを振り分けたもの
+	Categories []*domain.CategoryLayout `validate:"required"`
+}
+
+// カテゴリーとチャンネルの並びをまとめて置き換える。チャンネルのカテゴリー間の移動も同じトランザクションで行う
+func (u *channelUsecase) Reorder(ctx context.Context, params *ReorderChannelsParams) ([]*domain.CategoryLayout, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidChannelData
+	}
+
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageChannels); err != nil {
+		return nil, err
 	}
 
-	return u.store.Channels().Create(ctx, &domain.Channel{
-		ID:         uuid.New(),
-		CategoryID: params.CategoryID,
-		Name:       params.Name,
-		CreatedAt:  time.Now(),
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// 同時に行われた作成や削除、移動が並びから漏れないよう、ギルドをロックしてから今の並びを読み直す
+		if err := tx.Guilds().LockByID(ctx, params.GuildID); err != nil {
+			return err
+		}
+
+		categories, err := tx.Categories().GetByGuildID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+		channels, err := tx.Channels().GetByGuildID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+
+		// 過不足や重複があれば受け付けない
+		if len(params.Categories) != len(categories) {
+			return domain.ErrInvalidChannelData
+		}
+		remainingCategories := make(map[uuid.UUID]bool, len(categories))
+		for _, category := range categories {
+			remainingCategories[category.ID] = true
+		}
+		remainingChannels := make(map[uuid.UUID]bool, len(channels))
+		for _, channel := range channels {
+			remainingChannels[channel.ID] = true
+		}
+		categoryIDs := make([]uuid.UUID, len(params.Categories))
+		for i, layout := range params.Categories {
+			if layout == nil || !remainingCategories[layout.CategoryID] {
+				return domain.ErrInvalidChannelData
+			}
+			delete(remainingCategories, layout.CategoryID)
+			categoryIDs[i] = layout.CategoryID
+
+			for _, channelID := range layout.ChannelIDs {
+				if !remainingChannels[channelID] {
+					return domain.ErrInvalidChannelData
+				}
+				delete(remainingChannels, channelID)
+			}
+			if layout.ChannelIDs == nil {
+				layout.ChannelIDs = []uuid.UUID{}
+			}
+		}
+		if len(remainingChannels) > 0 {
+			return domain.ErrInvalidChannelData
+		}
+
+		// 監査ログには並びが変わったカテゴリーだけを残す
+		auditLog := domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogChannelsReorder, nil)
+		beforeCategoryIDs := make([]uuid.UUID, len(categories))
+		for i, category := range categories {
+			beforeCategoryIDs[i] = category.ID
+		}
+		auditLog.Change("category_ids", beforeCategoryIDs, categoryIDs)
+		beforeChannelIDs := make(map[uuid.UUID][]uuid.UUID, len(categories))
+		for _, channel := range channels {
+			beforeChannelIDs[channel.CategoryID] = append(beforeChannelIDs[channel.CategoryID], channel.ID)
+		}
+		for _, layout := range params.Categories {
+			before := beforeChannelIDs[layout.CategoryID]
+			if before == nil {
+				before = []uuid.UUID{}
+			}
+			auditLog.Change("channel_ids:"+layout.CategoryID.String(), before, layout.ChannelIDs)
+		}
+
+		if err := tx.Categories().UpdatePositions(ctx, params.GuildID, categoryIDs); err != nil {
+			return err
+		}
+		for _, layout := range params.Categories {
+			if len(layout.ChannelIDs) == 0 {
+				continue
+			}
+			if err := tx.Channels().UpdatePositions(ctx, params.GuildID, layout.CategoryID, layout.ChannelIDs); err != nil {
+				return err
+			}
+		}
+		return tx.AuditLogs().Create(ctx, auditLog)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishChannelsReordered(ctx, params.GuildID, params.Categories); err != nil {
+		return nil, err
+	}
+	// 別のカテゴリーに移ったチャンネルは、引き継ぐ権限の上書きが変わる
+	if err := u.publisher.PublishPermissionsUpdated(ctx, params.GuildID, nil); err != nil {
+		return nil, err
+	}
+
+	return params.Categories, nil
 }
 
-func (u *channelUsecase) CheckAccess(ctx context.Context, userID, channelID uuid.UUID) (bool, error) {
-	isMember, err := u.store.Channels().CheckChannelMember(ctx, userID, channelID)
+// チャンネルでの権限を返す。チャンネルが存在しない場合は何の権限も持たないものとして扱う
+func (u *channelUsecase) CheckAccess(ctx context.Context, userID, channelID uuid.UUID) (*domain.ChannelAccess, error) {
+	access, err := u.permissions.ResolveChannel(ctx, channelID, userID)
 	if err != nil {
-		return false, err
+		if err == domain.ErrChannelNotFound {
+			return &domain.ChannelAccess{}, nil
+		}
+		return nil, err
+	}
+	return access, nil
+}
+
+// ギルド内でCheckAccessがVIEW_CHANNELを返すチャンネルのIDを返す
+func (u *channelUsecase) GetAccessibleIDs(ctx context.Context, userID, guildID uuid.UUID) ([]uuid.UUID, error) {
+	channels, err := u.store.Channels().GetByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	perms, err := u.permissions.ResolveChannels(ctx, guildID, userID, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	channelIDs := make([]uuid.UUID, 0, len(channels))
+	for _, channel := range channels {
+		if perms[channel.ID].Has(domain.PermissionViewChannel) {
+			channelIDs = append(channelIDs, channel.ID)
+		}
+	}
+	return channelIDs, nil
+}
+
+// channelIDsのうちCheckAccessがVIEW_CHANNELを返すものだけを、元の順序で返す
+func (u *channelUsecase) FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(channelIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	channelsByGuild, err := u.store.Channels().GroupByGuildID(ctx, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make([]uuid.UUID, 0, len(channelIDs))
+	for guildID, channels := range channelsByGuild {
+		perms, err := u.permissions.ResolveChannels(ctx, guildID, userID, channels)
+		if err != nil {
+			return nil, err
+		}
+		for _, channel := range channels {
+			if perms[channel.ID].Has(domain.PermissionViewChannel) {
+				accessible = append(accessible, channel.ID)
+			}
+		}
+	}
+	return keepOrder(channelIDs, accessible), nil
+}
+
+type FilterMentionTargetsParams struct {
+	ChannelID  uuid.UUID   `validate:"required"`
+	UserIDs    []uuid.UUID `validate:"max=100"`
+	ChannelIDs []uuid.UUID `validate:"max=100"`
+}
+
+type FilterMentionTargetsResult struct {
+	UserIDs    []uuid.UUID
+	ChannelIDs []uuid.UUID
+}
+
+// メンション先のうち、channelIDと同じギルドに属するものだけを入力の順序を保って返す
+func (u *channelUsecase) FilterMentionTargets(ctx context.Context, params *FilterMentionTargetsParams) (*FilterMentionTargetsResult, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidArgument
+	}
+
+	result := &FilterMentionTargetsResult{
+		UserIDs:    []uuid.UUID{},
+		ChannelIDs: []uuid.UUID{},
+	}
+
+	if len(params.UserIDs) > 0 {
+		members, err := u.store.Channels().FilterGuildMembers(ctx, params.ChannelID, params.UserIDs)
+		if err != nil {
+			return nil, err
+		}
+		result.UserIDs = keepOrder(params.UserIDs, members)
+	}
+
+	if len(params.ChannelIDs) > 0 {
+		channels, err := u.store.Channels().FilterSameGuildChannels(ctx, params.ChannelID, params.ChannelIDs)
+		if err != nil {
+			return nil, err
+		}
+		result.ChannelIDs = keepOrder(params.ChannelIDs, channels)
+	}
+
+	return result, nil
+}
+
+// idsのうちallowedに含まれるものをidsの順序で返す
+func keepOrder(ids, allowed []uuid.UUID) []uuid.UUID {
+	allowedSet := make(map[uuid.UUID]struct{}, len(allowed))
+	for _, id := range allowed {
+		allowedSet[id] = struct{}{}
+	}
+	filtered := make([]uuid.UUID, 0, len(allowed))
+	for _, id := range ids {
+		if _, ok := allowedSet[id]; ok {
+			filtered = append(filtered, id)
+		}
 	}
-	return isMember, nil
+	return filtered
 }
 
 var _ ChannelUsecase = (*channelUsecase)(nil)
@@ -13,22 +13,33 @@ import (
 type GuildUsecase interface {
 	Create(ctx context.Context, params *CreateGuildParams) (*domain.Guild, error)
 	Update(ctx context.Context, params *UpdateGuildParams) (*domain.Guild, error)
-	GetByID(ctx context.Context, userID, guildID uuid.UUID) (*GetByIDResult, error)
+	Delete(ctx context.Context, params *DeleteGuildParams) error
+	TransferOwnership(ctx context.Context, params *TransferOwnershipParams) (*domain.Guild, error)
+	GetByID(ctx context.Context, params *GetGuildByIDParams) (*GetByIDResult, error)
 	GetGuildOverview(ctx context.Context, userID, guildID uuid.UUID) (*domain.GuildOverview, error)
 	GetMyGuilds(ctx context.Context, userID uuid.UUID) ([]*domain.Guild, error)
+	ListGuildIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 }
 
 type guildUsecase struct {
-	store     domain.IStore
-	userSvc   domain.IUserService
-	validator *validator.Validate
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	userSvc     domain.IUserService
+	messageSvc  domain.IMessageService
+	presenceSvc domain.IPresenceService
+	publisher   domain.IPublisher
+	validator   *validator.Validate
 }
 
-func NewGuildUsecase(store domain.IStore, userSvc domain.IUserService, validator *validator.Validate) GuildUsecase {
+func NewGuildUsecase(store domain.IStore, permissions *domain.PermissionResolver, userSvc domain.IUserService, messageSvc domain.IMessageService, presenceSvc domain.IPresenceService, publisher domain.IPublisher, validator *validator.Validate) GuildUsecase {
 	return &guildUsecase{
-		store:     store,
-		userSvc:   userSvc,
-		validator: validator,
+		store:       store,
+		permissions: permissions,
+		userSvc:     userSvc,
+		messageSvc:  messageSvc,
+		presenceSvc: presenceSvc,
+		publisher:   publisher,
+		validator:   validator,
 	}
 }
 
@@ -56,7 +67,7 @@ func (u *guildUsecase) Create(ctx context.Context, params *CreateGuildParams) (*
 
 	guild := &domain.Guild{
 		ID:               uuid.New(),
-		OwnerID:          params.OwnerID,
+		OwnerID:          &params.OwnerID,
 		Name:             params.Name,
 		Description:      params.Description,
 		IconURL:          params.IconURL,
@@ -85,13 +96,18 @@ func (u *guildUsecase) Create(ctx context.Context, params *CreateGuildParams) (*
 	}
 
 	var createdGuild *domain.Guild
-	// ギルド作成時にデフォルトのカテゴリとチャンネルを作成する
+	// ギルド作成時にデフォルトのカテゴリとチャンネル、@everyoneロールを作成する
 	err = u.store.ExecTx(ctx, func(store domain.IStore) error {
 		createdGuild, err = store.Guilds().Create(ctx, guild)
 		if err != nil {
 			return err
 		}
 
+		_, err = store.Roles().Create(ctx, domain.NewEveryoneRole(guild.ID, guild.CreatedAt))
+		if err != nil {
+			return err
+		}
+
 		_, err = store.Categories().Create(ctx, category)
 		if err != nil {
 			return err
@@ -129,21 +145,159 @@ func (u *guildUsecase) Update(ctx context.Context, params *UpdateGuildParams) (*
 	if err := u.validator.Struct(params); err != nil {
 		return nil, domain.ErrInvalidGuildData
 	}
-	isOwner, err := u.store.Guilds().IsOwner(ctx, params.ID, params.UserID)
+	if _, err := u.permissions.Require(ctx, params.ID, params.UserID, domain.PermissionManageGuild); err != nil {
+		return nil, err
+	}
+
+	var guild *domain.Guild
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		before, err := tx.Guilds().GetByID(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		guild, err = tx.Guilds().Update(ctx, &domain.Guild{
+			ID:               params.ID,
+			Name:             params.Name,
+			Description:      params.Description,
+			IconURL:          params.IconURL,
+			DefaultChannelID: params.DefaultChannelID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guild.ID, params.UserID, domain.AuditLogGuildUpdate, &guild.ID).
+			Change("name", before.Name, guild.Name).
+			Change("description", before.Description, guild.Description).
+			Change("icon_url", before.IconURL, guild.IconURL).
+			Change("default_channel_id", before.DefaultChannelID, guild.DefaultChannelID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishGuildUpdated(ctx, guild); err != nil {
+		return nil, err
+	}
+
+	return guild, nil
+}
+
+type DeleteGuildParams struct {
+	GuildID uuid.UUID `validate:"required"`
+	UserID  uuid.UUID `validate:"required"`
+}
+
+// オーナーだけが削除できる。ギルドに紐づく行はON DELETE CASCADEで消え、
+// 別のサービスが持つメッセージと添付ファイルは同じトランザクションで削除ジョブを積み、コミット後にワーカーが消す
+func (u *guildUsecase) Delete(ctx context.Context, params *DeleteGuildParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		guild, err := tx.Guilds().GetByID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+		if !guild.IsOwnedBy(params.UserID) {
+			return domain.ErrPermissionDenied
+		}
+
+		channels, err := tx.Channels().GetByGuildID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+		channelIDs := make([]uuid.UUID, len(channels))
+		for i, channel := range channels {
+			channelIDs[i] = channel.ID
+		}
+
+		if err := tx.ChannelPurgeJobs().CreateMany(ctx, domain.NewChannelPurgeJobs(channelIDs, time.Now())); err != nil {
+			return err
+		}
+
+		return tx.Guilds().Delete(ctx, params.GuildID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishGuildDeleted(ctx, params.GuildID)
+}
+
+type TransferOwnershipParams struct {
+	GuildID    uuid.UUID `validate:"required"`
+	UserID     uuid.UUID `validate:"required"`
+	NewOwnerID uuid.UUID `validate:"required"`
+	// 本人確認のために再入力させた現在のオーナーのパスワード
+	Password string `validate:"required"`
+}
+
+// オーナーだけが、ギルドの他のメンバーにオーナー権限を譲れる
+func (u *guildUsecase) TransferOwnership(ctx context.Context, params *TransferOwnershipParams) (*domain.Guild, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidArgument
+	}
+	if params.NewOwnerID == params.UserID {
+		return nil, domain.ErrCannotTransferToSelf
+	}
+
+	guild, err := u.store.Guilds().GetByID(ctx, params.GuildID)
 	if err != nil {
 		return nil, err
 	}
-	if !isOwner {
+	if !guild.IsOwnedBy(params.UserID) {
 		return nil, domain.ErrPermissionDenied
 	}
 
-	return u.store.Guilds().Update(ctx, &domain.Guild{
-		ID:               params.ID,
-		Name:             params.Name,
-		Description:      params.Description,
-		IconURL:          params.IconURL,
-		DefaultChannelID: params.DefaultChannelID,
+	valid, err := u.userSvc.VerifyPassword(params.UserID, params.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, domain.ErrInvalidCredential
+	}
+
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// パスワードの確認中にオーナーが変わっていないかをトランザクション内で確かめ直す
+		current, err := tx.Guilds().GetByID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+		if !current.IsOwnedBy(params.UserID) {
+			return domain.ErrPermissionDenied
+		}
+
+		isMember, err := tx.Members().IsMember(ctx, params.GuildID, params.NewOwnerID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return domain.ErrMemberNotFound
+		}
+
+		guild, err = tx.Guilds().UpdateOwner(ctx, params.GuildID, params.NewOwnerID)
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guild.ID, params.UserID, domain.AuditLogGuildOwnerUpdate, &params.NewOwnerID).
+			Change("owner_id", params.UserID, params.NewOwnerID))
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishGuildOwnerUpdated(ctx, guild.ID, params.UserID, params.NewOwnerID); err != nil {
+		return nil, err
+	}
+	if err := u.publisher.PublishGuildUpdated(ctx, guild); err != nil {
+		return nil, err
+	}
+
+	return guild, nil
 }
 
 type GetByIDResult struct {
@@ -152,7 +306,15 @@ type GetByIDResult struct {
 	Members     []domain.Member
 }
 
-func (u *guildUsecase) GetByID(ctx context.Context, userID, guildID uuid.UUID) (*GetByIDResult, error) {
+type GetGuildByIDParams struct {
+	UserID  uuid.UUID
+	GuildID uuid.UUID
+	// trueの場合、メンバーごとにオンライン状態を付ける
+	WithPresence bool
+}
+
+func (u *guildUsecase) GetByID(ctx context.Context, params *GetGuildByIDParams) (*GetByIDResult, error) {
+	userID, guildID := params.UserID, params.GuildID
 	isMember, err := u.store.Members().IsMember(ctx, guildID, userID)
 	if err != nil {
 		return nil, err
@@ -163,10 +325,11 @@ func (u *guildUsecase) GetByID(ctx context.Context, userID, guildID uuid.UUID) (
 
 	var guild *domain.Guild
 	var members []domain.Member
-	var errGuild, errMembers error
+	var memberRoleIDs map[uuid.UUID][]uuid.UUID
+	var errGuild, errMembers, errRoles error
 
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -178,6 +341,11 @@ func (u *guildUsecase) GetByID(ctx context.Context, userID, guildID uuid.UUID) (
 		members, errMembers = u.store.Members().GetMembersByGuildID(ctx, guildID)
 	}()
 
+	go func() {
+		defer wg.Done()
+		memberRoleIDs, errRoles = u.store.Roles().GetMemberRoleIDs(ctx, guildID)
+	}()
+
 	wg.Wait()
 
 	if errGuild != nil {
@@ -186,6 +354,9 @@ func (u *guildUsecase) GetByID(ctx context.Context, userID, guildID uuid.UUID) (
 	if errMembers != nil {
 		return nil, errMembers
 	}
+	if errRoles != nil {
+		return nil, errRoles
+	}
 
 	memberUserIDs := make([]uuid.UUID, 0, len(members))
 	for _, member := range members {
@@ -206,6 +377,20 @@ func (u *guildUsecase) GetByID(ctx context.Context, userID, guildID uuid.UUID) (
 		if user, ok := userMap[member.UserID]; ok {
 			members[i].User = user
 		}
+		members[i].RoleIDs = memberRoleIDs[member.UserID]
+		if members[i].RoleIDs == nil {
+			members[i].RoleIDs = []uuid.UUID{}
+		}
+	}
+
+	if params.WithPresence {
+		statuses, err := u.presenceSvc.GetStatuses(ctx, memberUserIDs)
+		if err != nil {
+			return nil, err
+		}
+		for i, member := range members {
+			members[i].Status = statuses[member.UserID]
+		}
 	}
 
 	return &GetByIDResult{
@@ -236,20 +421,53 @@ func (u *guildUsecase) GetGuildOverview(ctx context.Context, userID, guildID uui
 		return nil, err
 	}
 
+	channels, err := u.store.Channels().GetByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := u.permissions.ResolveChannels(ctx, guildID, userID, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	// 見られないチャンネルは一覧に含めない
+	channelsByCategory := make(map[uuid.UUID][]*domain.Channel)
+	for _, channel := range channels {
+		if perms[channel.ID].Has(domain.PermissionViewChannel) {
+			channelsByCategory[channel.CategoryID] = append(channelsByCategory[channel.CategoryID], channel)
+		}
+	}
+
+	channelIDs := make([]uuid.UUID, 0)
 	for _, category := range categories {
-		channels, err := u.store.Channels().GetByCategoryID(ctx, category.ID)
-		if err != nil {
-			return nil, err
+		channels := channelsByCategory[category.ID]
+		channelsOverview := make([]*domain.ChannelOverview, len(channels))
+		for i, channel := range channels {
+			channelsOverview[i] = &domain.ChannelOverview{Channel: channel}
+			channelIDs = append(channelIDs, channel.ID)
 		}
 		categoriesOverview = append(categoriesOverview, &domain.CategoryOverview{
 			Category: category,
-			Channels: channels,
+			Channels: channelsOverview,
 		})
 	}
 
+	// 未読数が取れなくてもギルドは表示できるので、未読なしとして返す
+	unreads, unreadErr := u.messageSvc.GetUnreadCounts(ctx, userID, channelIDs)
+	for _, category := range categoriesOverview {
+		for _, channel := range category.Channels {
+			if unread, ok := unreads[channel.ID]; ok {
+				channel.Unread = unread
+			} else {
+				channel.Unread = &domain.ChannelUnread{}
+			}
+		}
+	}
+
 	return &domain.GuildOverview{
 		Guild:      guild,
 		Categories: categoriesOverview,
+		UnreadErr:  unreadErr,
 	}, nil
 }
 
@@ -270,4 +488,9 @@ func (u *guildUsecase) GetMyGuilds(ctx context.Context, userID uuid.UUID) ([]*do
 	return guilds, nil
 }
 
+// realtime-serviceが、ユーザーに届けるギルドのイベントを決めるために使う
+func (u *guildUsecase) ListGuildIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return u.store.Members().GetGuildIDsByUserID(ctx, userID)
+}
+
 var _ GuildUsecase = (*guildUsecase)(nil)
@@ -11,6 +11,10 @@ import (
 
 const (
 	INITIAL_INVITE_USES = 0
+	// 生成したコードが既存のコードと重なった場合に作り直す回数
+	MAX_INVITE_CODE_ATTEMPTS = 5
+	// 期限切れの招待を1回の削除で消す件数
+	INVITE_SWEEP_BATCH_SIZE = 500
 )
 
 type InviteUsecase interface {
@@ -18,19 +22,27 @@ type InviteUsecase interface {
 	GetByGuildID(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Invite, error)
 	GetByInviteCode(ctx context.Context, inviteCode string) (*domain.Invite, error)
 	JoinGuild(ctx context.Context, params *JoinGuildParams) (*domain.Member, error)
+	Delete(ctx context.Context, userID uuid.UUID, inviteCode string) error
+	SetVanity(ctx context.Context, params *SetVanityInviteParams) (*domain.Invite, error)
+	ListUses(ctx context.Context, params *ListInviteUsesParams) ([]*domain.InviteUse, error)
+	SweepExpired(ctx context.Context) (int64, error)
 }
 
 type inviteUsecase struct {
-	store     domain.IStore
-	userSvc   domain.IUserService
-	validator *validator.Validate
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	userSvc     domain.IUserService
+	publisher   domain.IPublisher
+	validator   *validator.Validate
 }
 
-func NewInviteUsecase(store domain.IStore, userSvc domain.IUserService, validator *validator.Validate) InviteUsecase {
+func NewInviteUsecase(store domain.IStore, permissions *domain.PermissionResolver, userSvc domain.IUserService, publisher domain.IPublisher, validator *validator.Validate) InviteUsecase {
 	return &inviteUsecase{
-		store:     store,
-		userSvc:   userSvc,
-		validator: validator,
+		store:       store,
+		permissions: permissions,
+		userSvc:     userSvc,
+		publisher:   publisher,
+		validator:   validator,
 	}
 }
 
@@ -46,21 +58,17 @@ func (u *inviteUsecase) Create(ctx context.Context, params *CreateInviteParams)
 		return nil, domain.ErrInvalidInviteData
 	}
 
-	isOwner, err := u.store.Guilds().IsOwner(ctx, params.GuildID, params.CreatorID)
-	if err != nil {
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.CreatorID, domain.PermissionManageInvites); err != nil {
 		return nil, err
 	}
-	if !isOwner {
-		return nil, domain.ErrPermissionDenied
-	}
 
-	inviteCode, err := domain.GenerateInviteCode()
-	if err != nil {
-		return nil, err
-	}
 	if params.ExpiresAt != nil && params.ExpiresAt.Before(time.Now()) {
 		return nil, domain.ErrInvalidInviteData
 	}
+	inviteCode, err := u.generateUniqueInviteCode(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	invite := &domain.Invite{
 		InviteCode:  inviteCode,
@@ -72,7 +80,41 @@ func (u *inviteUsecase) Create(ctx context.Context, params *CreateInviteParams)
 		CreatedAt:   time.Now(),
 	}
 
-	return u.store.Invites().Create(ctx, invite)
+	var created *domain.Invite
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		created, err = tx.Invites().Create(ctx, invite)
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.CreatorID, domain.AuditLogInviteCreate, nil).
+			Change("code", nil, created.InviteCode).
+			Change("max_uses", nil, created.MaxUses).
+			Change("expires_at", nil, created.ExpiresAt))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// バニティURLと同じ文字列が生成されることもあるので、使われていないコードが出るまで作り直す
+func (u *inviteUsecase) generateUniqueInviteCode(ctx context.Context) (string, error) {
+	for range MAX_INVITE_CODE_ATTEMPTS {
+		inviteCode, err := domain.GenerateInviteCode()
+		if err != nil {
+			return "", err
+		}
+		exists, err := u.store.Invites().Exists(ctx, inviteCode)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return inviteCode, nil
+		}
+	}
+	return "", domain.ErrInviteCodeAlreadyExists
 }
 
 func (u *inviteUsecase) GetByGuildID(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Invite, error) {
@@ -132,6 +174,15 @@ func (u *inviteUsecase) JoinGuild(ctx context.Context, params *JoinGuildParams)
 			return err
 		}
 
+		// BANされている場合は使用回数の加算ごとロールバックする
+		banned, err := tx.Bans().IsBanned(ctx, invite.GuildID, params.UserID)
+		if err != nil {
+			return err
+		}
+		if banned {
+			return domain.ErrUserBanned
+		}
+
 		member, err = tx.Members().Add(
 			ctx,
 			&domain.Member{
@@ -144,12 +195,22 @@ func (u *inviteUsecase) JoinGuild(ctx context.Context, params *JoinGuildParams)
 			return err
 		}
 
-		return nil
+		return tx.Invites().AddUse(ctx, &domain.InviteUse{
+			ID:         uuid.New(),
+			GuildID:    invite.GuildID,
+			InviteCode: invite.InviteCode,
+			UserID:     params.UserID,
+			UsedAt:     member.JoinedAt,
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if err := u.publisher.PublishMemberAdded(ctx, member); err != nil {
+		return nil, err
+	}
+
 	return member, nil
 }
 
@@ -178,4 +239,160 @@ func (u *inviteUsecase) GetByInviteCode(ctx context.Context, inviteCode string)
 	return invite, nil
 }
 
+func (u *inviteUsecase) Delete(ctx context.Context, userID uuid.UUID, inviteCode string) error {
+	if !domain.ValidateInviteCode(inviteCode) {
+		return domain.ErrInvalidInviteCode
+	}
+
+	invite, err := u.store.Invites().GetByInviteCode(ctx, inviteCode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.permissions.Require(ctx, invite.GuildID, userID, domain.PermissionManageInvites); err != nil {
+		return err
+	}
+
+	return u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Invites().Delete(ctx, inviteCode); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, invite.GuildID, userID, domain.AuditLogInviteDelete, nil).
+			Change("code", invite.InviteCode, nil).
+			Change("uses", invite.CurrentUses, nil).
+			Change("max_uses", invite.MaxUses, nil).
+			Change("expires_at", invite.ExpiresAt, nil))
+	})
+}
+
+type SetVanityInviteParams struct {
+	GuildID    uuid.UUID `validate:"required"`
+	UserID     uuid.UUID `validate:"required"`
+	InviteCode string    `validate:"required"`
+}
+
+// ギルドのバニティURLを設定する。既にある場合は古いコードを削除して置き換える
+func (u *inviteUsecase) SetVanity(ctx context.Context, params *SetVanityInviteParams) (*domain.Invite, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidInviteData
+	}
+	if !domain.ValidateVanityInviteCode(params.InviteCode) {
+		return nil, domain.ErrInvalidInviteCode
+	}
+
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageGuild); err != nil {
+		return nil, err
+	}
+
+	var vanity *domain.Invite
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		current, err := tx.Invites().GetVanityByGuildID(ctx, params.GuildID)
+		if err != nil && err != domain.ErrInviteNotFound {
+			return err
+		}
+		if current != nil && current.InviteCode == params.InviteCode {
+			vanity = current
+			return nil
+		}
+
+		exists, err := tx.Invites().Exists(ctx, params.InviteCode)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return domain.ErrInviteCodeAlreadyExists
+		}
+
+		var currentCode *string
+		if current != nil {
+			if err := tx.Invites().Delete(ctx, current.InviteCode); err != nil {
+				return err
+			}
+			currentCode = &current.InviteCode
+		}
+
+		vanity, err = tx.Invites().Create(ctx, &domain.Invite{
+			InviteCode:  params.InviteCode,
+			GuildID:     params.GuildID,
+			CreatorID:   params.UserID,
+			CurrentUses: INITIAL_INVITE_USES,
+			Vanity:      true,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogGuildUpdate, &params.GuildID).
+			Change("vanity_code", currentCode, vanity.InviteCode))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vanity, nil
+}
+
+type ListInviteUsesParams struct {
+	GuildID    uuid.UUID `validate:"required"`
+	UserID     uuid.UUID `validate:"required"`
+	InviteCode string    `validate:"required"`
+}
+
+// 招待を管理できるメンバーだけが、誰がどの招待で参加したかを見られる
+func (u *inviteUsecase) ListUses(ctx context.Context, params *ListInviteUsesParams) ([]*domain.InviteUse, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidInviteData
+	}
+	if !domain.ValidateInviteCode(params.InviteCode) {
+		return nil, domain.ErrInvalidInviteCode
+	}
+
+	if _, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageInvites); err != nil {
+		return nil, err
+	}
+
+	uses, err := u.store.Invites().GetUses(ctx, params.GuildID, params.InviteCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(uses) == 0 {
+		return uses, nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(uses))
+	for _, use := range uses {
+		userIDs = append(userIDs, use.UserID)
+	}
+	users, err := u.userSvc.GetUsersByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[uuid.UUID]*domain.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+	for _, use := range uses {
+		use.User = userMap[use.UserID]
+	}
+
+	return uses, nil
+}
+
+// 期限切れか使用回数の上限に達した招待を、INVITE_SWEEP_BATCH_SIZE件ずつすべて削除する
+func (u *inviteUsecase) SweepExpired(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		deleted, err := u.store.Invites().DeleteExpired(ctx, INVITE_SWEEP_BATCH_SIZE)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < INVITE_SWEEP_BATCH_SIZE {
+			return total, nil
+		}
+	}
+}
+
 var _ InviteUsecase = (*inviteUsecase)(nil)
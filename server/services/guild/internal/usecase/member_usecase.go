@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type MemberUsecase interface {
+	Remove(ctx context.Context, params *RemoveMemberParams) error
+	Leave(ctx context.Context, userID, guildID uuid.UUID) error
+	Timeout(ctx context.Context, params *TimeoutMemberParams) (*domain.Member, error)
+}
+
+type memberUsecase struct {
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	publisher   domain.IPublisher
+	validator   *validator.Validate
+}
+
+func NewMemberUsecase(store domain.IStore, permissions *domain.PermissionResolver, publisher domain.IPublisher, validator *validator.Validate) MemberUsecase {
+	return &memberUsecase{
+		store:       store,
+		permissions: permissions,
+		publisher:   publisher,
+		validator:   validator,
+	}
+}
+
+type RemoveMemberParams struct {
+	GuildID      uuid.UUID `validate:"required"`
+	UserID       uuid.UUID `validate:"required"`
+	TargetUserID uuid.UUID `validate:"required"`
+}
+
+// メンバーをギルドから外す。オーナーと、自分と同じかより上のロールを持つメンバーは外せない
+func (u *memberUsecase) Remove(ctx context.Context, params *RemoveMemberParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionKickMembers)
+	if err != nil {
+		return err
+	}
+
+	target, err := u.permissions.Resolve(ctx, params.GuildID, params.TargetUserID)
+	if err != nil {
+		return err
+	}
+	if !target.IsMember {
+		return domain.ErrMemberNotFound
+	}
+	if target.IsOwner {
+		return domain.ErrCannotRemoveOwner
+	}
+	if !perms.Outranks(target.TopPosition) {
+		return domain.ErrPermissionDenied
+	}
+
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Members().Remove(ctx, params.GuildID, params.TargetUserID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberKick, &params.TargetUserID))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishMemberRemoved(ctx, params.GuildID, params.TargetUserID)
+}
+
+// オーナーは所有権を譲るまで抜けられない
+func (u *memberUsecase) Leave(ctx context.Context, userID, guildID uuid.UUID) error {
+	guild, err := u.store.Guilds().GetByID(ctx, guildID)
+	if err != nil {
+		return err
+	}
+	if guild.IsOwnedBy(userID) {
+		return domain.ErrOwnerCannotLeave
+	}
+
+	if err := u.store.Members().Remove(ctx, guildID, userID); err != nil {
+		if err == domain.ErrMemberNotFound {
+			return domain.ErrGuildNotFound
+		}
+		return err
+	}
+
+	return u.publisher.PublishMemberRemoved(ctx, guildID, userID)
+}
+
+var _ MemberUsecase = (*memberUsecase)(nil)
+
+type TimeoutMemberParams struct {
+	GuildID      uuid.UUID `validate:"required"`
+	UserID       uuid.UUID `validate:"required"`
+	TargetUserID uuid.UUID `validate:"required"`
+	// nilの場合はタイムアウトを解除する
+	Until *time.Time `validate:"omitempty"`
+}
+
+// 解除時刻までメッセージの送信、リアクション、入力中の通知をできなくする
+func (u *memberUsecase) Timeout(ctx context.Context, params *TimeoutMemberParams) (*domain.Member, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidArgument
+	}
+	if params.Until != nil {
+		now := time.Now()
+		if !params.Until.After(now) || params.Until.After(now.Add(domain.MaxMemberTimeoutDuration)) {
+			return nil, domain.ErrInvalidArgument
+		}
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionModerateMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.permissions.Resolve(ctx, params.GuildID, params.TargetUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.IsMember {
+		return nil, domain.ErrMemberNotFound
+	}
+	if target.IsOwner || target.Permissions&domain.PermissionAdministrator != 0 {
+		return nil, domain.ErrCannotTimeoutMember
+	}
+	if !perms.Outranks(target.TopPosition) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	var member *domain.Member
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		member, err = tx.Members().UpdateCommunicationDisabledUntil(ctx, params.GuildID, params.TargetUserID, params.Until)
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberTimeout, &params.TargetUserID).
+			Change("communication_disabled_until", target.TimedOutUntil(time.Now()), member.CommunicationDisabledUntil))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishMemberUpdated(ctx, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
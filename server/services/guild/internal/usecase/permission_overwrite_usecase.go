@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type PermissionOverwriteUsecase interface {
+	List(ctx context.Context, params *ListPermissionOverwritesParams) ([]*domain.PermissionOverwrite, error)
+	Set(ctx context.Context, params *SetPermissionOverwriteParams) (*domain.PermissionOverwrite, error)
+	Delete(ctx context.Context, params *DeletePermissionOverwriteParams) error
+}
+
+type permissionOverwriteUsecase struct {
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	publisher   domain.IPublisher
+	validator   *validator.Validate
+}
+
+func NewPermissionOverwriteUsecase(store domain.IStore, permissions *domain.PermissionResolver, publisher domain.IPublisher, validator *validator.Validate) PermissionOverwriteUsecase {
+	return &permissionOverwriteUsecase{
+		store:       store,
+		permissions: permissions,
+		publisher:   publisher,
+		validator:   validator,
+	}
+}
+
+type ListPermissionOverwritesParams struct {
+	UserID    uuid.UUID                 `validate:"required"`
+	ScopeType domain.OverwriteScopeType `validate:"required"`
+	ScopeID   uuid.UUID                 `validate:"required"`
+}
+
+func (u *permissionOverwriteUsecase) List(ctx context.Context, params *ListPermissionOverwritesParams) ([]*domain.PermissionOverwrite, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidArgument
+	}
+
+	guildID, err := u.getGuildID(ctx, params.ScopeType, params.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageRoles); err != nil {
+		return nil, err
+	}
+
+	return u.store.PermissionOverwrites().GetByScopeID(ctx, params.ScopeID)
+}
+
+type SetPermissionOverwriteParams struct {
+	UserID     uuid.UUID                  `validate:"required"`
+	ScopeType  domain.OverwriteScopeType  `validate:"required"`
+	ScopeID    uuid.UUID                  `validate:"required"`
+	TargetType domain.OverwriteTargetType `validate:"required"`
+	TargetID   uuid.UUID                  `validate:"required"`
+	Allow      domain.Permission
+	Deny       domain.Permission
+}
+
+// 自分が持っている権限だけを許可・拒否でき、ロールを対象にする場合は自分より下のロールに限る
+func (u *permissionOverwriteUsecase) Set(ctx context.Context, params *SetPermissionOverwriteParams) (*domain.PermissionOverwrite, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidPermissionOverwriteData
+	}
+
+	guildID, err := u.getGuildID(ctx, params.ScopeType, params.ScopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	overwrite := &domain.PermissionOverwrite{
+		ID:         uuid.New(),
+		GuildID:    guildID,
+		ScopeType:  params.ScopeType,
+		ScopeID:    params.ScopeID,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+		Allow:      params.Allow,
+		Deny:       params.Deny,
+		CreatedAt:  time.Now(),
+	}
+	if !overwrite.IsValid() {
+		return nil, domain.ErrInvalidPermissionOverwriteData
+	}
+
+	perms, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.CanGrant(params.Allow | params.Deny) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	switch params.TargetType {
+	case domain.OverwriteTargetRole:
+		role, err := u.store.Roles().GetByID(ctx, params.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		if role.GuildID != guildID {
+			return nil, domain.ErrRoleNotFound
+		}
+		if !role.IsEveryone() && !perms.Outranks(role.Position) {
+			return nil, domain.ErrPermissionDenied
+		}
+	case domain.OverwriteTargetMember:
+		isMember, err := u.store.Members().IsMember(ctx, guildID, params.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, domain.ErrMemberNotFound
+		}
+	default:
+		return nil, domain.ErrInvalidPermissionOverwriteData
+	}
+
+	var upserted *domain.PermissionOverwrite
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		before, err := u.findOverwrite(ctx, tx, params.ScopeID, params.TargetID)
+		if err != nil {
+			return err
+		}
+
+		upserted, err = tx.PermissionOverwrites().Upsert(ctx, overwrite)
+		if err != nil {
+			return err
+		}
+
+		auditLog := domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogOverwriteUpdate, &params.ScopeID).
+			Change("target_type", nil, upserted.TargetType).
+			Change("target_id", nil, upserted.TargetID)
+		if before != nil {
+			auditLog.Change("allow", before.Allow, upserted.Allow).Change("deny", before.Deny, upserted.Deny)
+		} else {
+			auditLog.Change("allow", nil, upserted.Allow).Change("deny", nil, upserted.Deny)
+		}
+		return tx.AuditLogs().Create(ctx, auditLog)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publishPermissionsUpdated(ctx, guildID, upserted.TargetType, upserted.TargetID); err != nil {
+		return nil, err
+	}
+
+	return upserted, nil
+}
+
+type DeletePermissionOverwriteParams struct {
+	UserID    uuid.UUID                 `validate:"required"`
+	ScopeType domain.OverwriteScopeType `validate:"required"`
+	ScopeID   uuid.UUID                 `validate:"required"`
+	TargetID  uuid.UUID                 `validate:"required"`
+}
+
+func (u *permissionOverwriteUsecase) Delete(ctx context.Context, params *DeletePermissionOverwriteParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	guildID, err := u.getGuildID(ctx, params.ScopeType, params.ScopeID)
+	if err != nil {
+		return err
+	}
+	if _, err := u.permissions.Require(ctx, guildID, params.UserID, domain.PermissionManageRoles); err != nil {
+		return err
+	}
+
+	var deleted *domain.PermissionOverwrite
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		before, err := u.findOverwrite(ctx, tx, params.ScopeID, params.TargetID)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return domain.ErrPermissionOverwriteNotFound
+		}
+
+		if err := tx.PermissionOverwrites().Delete(ctx, params.ScopeID, params.TargetID); err != nil {
+			return err
+		}
+		deleted = before
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, guildID, params.UserID, domain.AuditLogOverwriteDelete, &params.ScopeID).
+			Change("target_type", before.TargetType, nil).
+			Change("target_id", before.TargetID, nil).
+			Change("allow", before.Allow, nil).
+			Change("deny", before.Deny, nil))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publishPermissionsUpdated(ctx, guildID, deleted.TargetType, deleted.TargetID)
+}
+
+// メンバーが対象の上書きはそのメンバーだけ、ロールが対象の上書きはギルドの全メンバーに知らせる
+func (u *permissionOverwriteUsecase) publishPermissionsUpdated(ctx context.Context, guildID uuid.UUID, targetType domain.OverwriteTargetType, targetID uuid.UUID) error {
+	if targetType == domain.OverwriteTargetMember {
+		return u.publisher.PublishPermissionsUpdated(ctx, guildID, &targetID)
+	}
+	return u.publisher.PublishPermissionsUpdated(ctx, guildID, nil)
+}
+
+// 見つからない場合はnilを返す
+func (u *permissionOverwriteUsecase) findOverwrite(ctx context.Context, store domain.IStore, scopeID, targetID uuid.UUID) (*domain.PermissionOverwrite, error) {
+	overwrites, err := store.PermissionOverwrites().GetByScopeID(ctx, scopeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, overwrite := range overwrites {
+		if overwrite.TargetID == targetID {
+			return overwrite, nil
+		}
+	}
+	return nil, nil
+}
+
+func (u *permissionOverwriteUsecase) getGuildID(ctx context.Context, scopeType domain.OverwriteScopeType, scopeID uuid.UUID) (uuid.UUID, error) {
+	switch scopeType {
+	case domain.OverwriteScopeChannel:
+		return u.store.Channels().GetGuildIDByChannelID(ctx, scopeID)
+	case domain.OverwriteScopeCategory:
+		return u.store.Categories().GetGuildIDByCategoryID(ctx, scopeID)
+	}
+	return uuid.Nil, domain.ErrInvalidArgument
+}
+
+var _ PermissionOverwriteUsecase = (*permissionOverwriteUsecase)(nil)
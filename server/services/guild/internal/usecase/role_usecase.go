@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"guild-service/internal/domain"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type RoleUsecase interface {
+	List(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Role, error)
+	Create(ctx context.Context, params *CreateRoleParams) (*domain.Role, error)
+	Update(ctx context.Context, params *UpdateRoleParams) (*domain.Role, error)
+	Delete(ctx context.Context, params *DeleteRoleParams) error
+	Reorder(ctx context.Context, params *ReorderRolesParams) ([]*domain.Role, error)
+	AddToMember(ctx context.Context, params *MemberRoleParams) error
+	RemoveFromMember(ctx context.Context, params *MemberRoleParams) error
+}
+
+type roleUsecase struct {
+	store       domain.IStore
+	permissions *domain.PermissionResolver
+	publisher   domain.IPublisher
+	validator   *validator.Validate
+}
+
+func NewRoleUsecase(store domain.IStore, permissions *domain.PermissionResolver, publisher domain.IPublisher, validator *validator.Validate) RoleUsecase {
+	return &roleUsecase{
+		store:       store,
+		permissions: permissions,
+		publisher:   publisher,
+		validator:   validator,
+	}
+}
+
+func (u *roleUsecase) List(ctx context.Context, userID, guildID uuid.UUID) ([]*domain.Role, error) {
+	perms, err := u.permissions.Resolve(ctx, guildID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.IsMember {
+		return nil, domain.ErrGuildNotFound
+	}
+
+	return u.store.Roles().GetByGuildID(ctx, guildID)
+}
+
+type CreateRoleParams struct {
+	GuildID     uuid.UUID `validate:"required"`
+	UserID      uuid.UUID `validate:"required"`
+	Name        string    `validate:"required,min=1,max=100"`
+	Permissions domain.Permission
+}
+
+// 新しいロールは@everyoneのすぐ上に作る
+func (u *roleUsecase) Create(ctx context.Context, params *CreateRoleParams) (*domain.Role, error) {
+	if err := u.validator.Struct(params); err != nil || !params.Permissions.IsValid() {
+		return nil, domain.ErrInvalidRoleData
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.CanGrant(params.Permissions) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	var role *domain.Role
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// 同時に作られたロールが同じ位置に入らないよう、ずらしてから挿入するまでギルドをロックする
+		if err := tx.Guilds().LockByID(ctx, params.GuildID); err != nil {
+			return err
+		}
+		if err := tx.Roles().ShiftPositions(ctx, params.GuildID); err != nil {
+			return err
+		}
+
+		role, err = tx.Roles().Create(ctx, &domain.Role{
+			ID:          uuid.New(),
+			GuildID:     params.GuildID,
+			Name:        params.Name,
+			Permissions: params.Permissions,
+			Position:    domain.EveryoneRolePosition + 1,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogRoleCreate, &role.ID).
+			Change("name", nil, role.Name).
+			Change("permissions", nil, role.Permissions))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishPermissionsUpdated(ctx, params.GuildID, nil); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+type UpdateRoleParams struct {
+	RoleID      uuid.UUID `validate:"required"`
+	UserID      uuid.UUID `validate:"required"`
+	Name        string    `validate:"required,min=1,max=100"`
+	Permissions domain.Permission
+}
+
+// 自分より下のロールだけを変更でき、新しく付ける権限は自分が持っているものに限る
+func (u *roleUsecase) Update(ctx context.Context, params *UpdateRoleParams) (*domain.Role, error) {
+	if err := u.validator.Struct(params); err != nil || !params.Permissions.IsValid() {
+		return nil, domain.ErrInvalidRoleData
+	}
+
+	role, err := u.store.Roles().GetByID(ctx, params.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsEveryone() && params.Name != role.Name {
+		return nil, domain.ErrEveryoneRoleImmutable
+	}
+
+	perms, err := u.permissions.Require(ctx, role.GuildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.Outranks(role.Position) || !perms.CanGrant(params.Permissions&^role.Permissions) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	var updated *domain.Role
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		updated, err = tx.Roles().Update(ctx, &domain.Role{
+			ID:          role.ID,
+			Name:        params.Name,
+			Permissions: params.Permissions,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, role.GuildID, params.UserID, domain.AuditLogRoleUpdate, &role.ID).
+			Change("name", role.Name, updated.Name).
+			Change("permissions", role.Permissions, updated.Permissions))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.publisher.PublishPermissionsUpdated(ctx, role.GuildID, nil); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+type DeleteRoleParams struct {
+	RoleID uuid.UUID `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+func (u *roleUsecase) Delete(ctx context.Context, params *DeleteRoleParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	role, err := u.store.Roles().GetByID(ctx, params.RoleID)
+	if err != nil {
+		return err
+	}
+	if role.IsEveryone() {
+		return domain.ErrEveryoneRoleImmutable
+	}
+
+	perms, err := u.permissions.Require(ctx, role.GuildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return err
+	}
+	if !perms.Outranks(role.Position) {
+		return domain.ErrPermissionDenied
+	}
+
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Roles().Delete(ctx, role.ID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, role.GuildID, params.UserID, domain.AuditLogRoleDelete, &role.ID).
+			Change("name", role.Name, nil).
+			Change("permissions", role.Permissions, nil))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishPermissionsUpdated(ctx, role.GuildID, nil)
+}
+
+type ReorderRolesParams struct {
+	GuildID uuid.UUID `validate:"required"`
+	UserID  uuid.UUID `validate:"required"`
+	// @everyone以外のすべてのロールを下から上の順に並べたもの
+	RoleIDs []uuid.UUID `validate:"required"`
+}
+
+// 位置が変わるロールは、変わる前も後も自分より下でなければならない
+func (u *roleUsecase) Reorder(ctx context.Context, params *ReorderRolesParams) ([]*domain.Role, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidRoleData
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		// 同時に作られたロールが並べ替えから漏れないよう、ギルドをロックしてから今のロールを読み直す
+		if err := tx.Guilds().LockByID(ctx, params.GuildID); err != nil {
+			return err
+		}
+
+		roles, err := tx.Roles().GetByGuildID(ctx, params.GuildID)
+		if err != nil {
+			return err
+		}
+		current := make(map[uuid.UUID]*domain.Role, len(roles))
+		beforeRoleIDs := make([]uuid.UUID, 0, len(roles))
+		for _, role := range roles {
+			if !role.IsEveryone() {
+				current[role.ID] = role
+				beforeRoleIDs = append(beforeRoleIDs, role.ID)
+			}
+		}
+		if len(params.RoleIDs) != len(current) {
+			return domain.ErrInvalidRoleData
+		}
+
+		seen := make(map[uuid.UUID]bool, len(params.RoleIDs))
+		for i, roleID := range params.RoleIDs {
+			role, ok := current[roleID]
+			if !ok || seen[roleID] {
+				return domain.ErrInvalidRoleData
+			}
+			seen[roleID] = true
+
+			position := int32(i) + domain.EveryoneRolePosition + 1
+			if position != role.Position && (!perms.Outranks(role.Position) || !perms.Outranks(position)) {
+				return domain.ErrPermissionDenied
+			}
+		}
+
+		if err := tx.Roles().UpdatePositions(ctx, params.GuildID, params.RoleIDs); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogRolesReorder, nil).
+			Change("role_ids", beforeRoleIDs, params.RoleIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.store.Roles().GetByGuildID(ctx, params.GuildID)
+}
+
+type MemberRoleParams struct {
+	GuildID      uuid.UUID `validate:"required"`
+	UserID       uuid.UUID `validate:"required"`
+	TargetUserID uuid.UUID `validate:"required"`
+	RoleID       uuid.UUID `validate:"required"`
+}
+
+func (u *roleUsecase) AddToMember(ctx context.Context, params *MemberRoleParams) error {
+	if err := u.checkMemberRole(ctx, params); err != nil {
+		return err
+	}
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Roles().AddToMember(ctx, params.GuildID, params.TargetUserID, params.RoleID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberRoleAdd, &params.TargetUserID).
+			Change("role_id", nil, params.RoleID))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishPermissionsUpdated(ctx, params.GuildID, &params.TargetUserID)
+}
+
+func (u *roleUsecase) RemoveFromMember(ctx context.Context, params *MemberRoleParams) error {
+	if err := u.checkMemberRole(ctx, params); err != nil {
+		return err
+	}
+	err := u.store.ExecTx(ctx, func(tx domain.IStore) error {
+		if err := tx.Roles().RemoveFromMember(ctx, params.GuildID, params.TargetUserID, params.RoleID); err != nil {
+			return err
+		}
+
+		return tx.AuditLogs().Create(ctx, domain.NewAuditLog(ctx, params.GuildID, params.UserID, domain.AuditLogMemberRoleRemove, &params.TargetUserID).
+			Change("role_id", params.RoleID, nil))
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.publisher.PublishPermissionsUpdated(ctx, params.GuildID, &params.TargetUserID)
+}
+
+// 自分より下のロールだけを、ギルドのメンバーに付け外しできる
+func (u *roleUsecase) checkMemberRole(ctx context.Context, params *MemberRoleParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidArgument
+	}
+
+	role, err := u.store.Roles().GetByID(ctx, params.RoleID)
+	if err != nil {
+		return err
+	}
+	if role.GuildID != params.GuildID {
+		return domain.ErrRoleNotFound
+	}
+	if role.IsEveryone() {
+		return domain.ErrEveryoneRoleImmutable
+	}
+
+	perms, err := u.permissions.Require(ctx, params.GuildID, params.UserID, domain.PermissionManageRoles)
+	if err != nil {
+		return err
+	}
+	if !perms.Outranks(role.Position) {
+		return domain.ErrPermissionDenied
+	}
+
+	isMember, err := u.store.Members().IsMember(ctx, params.GuildID, params.TargetUserID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return domain.ErrMemberNotFound
+	}
+	return nil
+}
+
+var _ RoleUsecase = (*roleUsecase)(nil)
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"guild-service/internal/usecase"
+	"log/slog"
+	"time"
+)
+
+const (
+	CHANNEL_PURGE_POLL_INTERVAL = 10 * time.Second
+)
+
+// 削除されたチャンネルのメッセージと添付ファイルを消すジョブを、成功するまで再試行しながら処理する。
+// ジョブはリースで取り出すので、複数のレプリカで動いても同じジョブを同時に処理することはない
+type ChannelPurger struct {
+	channelPurgeUsecase usecase.ChannelPurgeUsecase
+	interval            time.Duration
+	logger              *slog.Logger
+}
+
+func NewChannelPurger(channelPurgeUsecase usecase.ChannelPurgeUsecase, logger *slog.Logger) *ChannelPurger {
+	return &ChannelPurger{
+		channelPurgeUsecase: channelPurgeUsecase,
+		interval:            CHANNEL_PURGE_POLL_INTERVAL,
+		logger:              logger,
+	}
+}
+
+// 起動直後に1回処理し、その後はctxがキャンセルされるまで定期的に処理する
+func (p *ChannelPurger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.process(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.process(ctx)
+		}
+	}
+}
+
+func (p *ChannelPurger) process(ctx context.Context) {
+	result, err := p.channelPurgeUsecase.ProcessDue(ctx)
+	for _, job := range result.Failed {
+		p.logger.Warn("Channel purge job failed, will retry", "job_id", job.ID, "channel_id", job.ChannelID, "target", job.Target, "attempts", job.Attempts, "next_run_at", job.NextRunAt, "error", *job.LastError)
+	}
+	if err != nil {
+		p.logger.Error("Failed to process channel purge jobs", "succeeded_count", result.Succeeded, "error", err)
+		return
+	}
+	if result.Succeeded > 0 {
+		p.logger.Info("Processed channel purge jobs", "succeeded_count", result.Succeeded, "failed_count", len(result.Failed))
+	}
+}
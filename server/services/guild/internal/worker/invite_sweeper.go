@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"guild-service/internal/usecase"
+	"log/slog"
+	"time"
+)
+
+const (
+	INVITE_SWEEP_INTERVAL = 10 * time.Minute
+)
+
+// 期限切れか使用回数の上限に達した招待を定期的に削除する。
+// 複数のレプリカで同時に動いても、削除が重なるだけで問題はない
+type InviteSweeper struct {
+	inviteUsecase usecase.InviteUsecase
+	interval      time.Duration
+	logger        *slog.Logger
+}
+
+func NewInviteSweeper(inviteUsecase usecase.InviteUsecase, logger *slog.Logger) *InviteSweeper {
+	return &InviteSweeper{
+		inviteUsecase: inviteUsecase,
+		interval:      INVITE_SWEEP_INTERVAL,
+		logger:        logger,
+	}
+}
+
+// 起動直後に1回削除し、その後はctxがキャンセルされるまで定期的に削除する
+func (s *InviteSweeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.sweep(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+func (s *InviteSweeper) sweep(ctx context.Context) {
+	deleted, err := s.inviteUsecase.SweepExpired(ctx)
+	if err != nil {
+		s.logger.Error("Failed to sweep expired invites", "deleted_count", deleted, "error", err)
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Swept expired invites", "deleted_count", deleted)
+	}
+}
@@ -0,0 +1,21 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (id, guild_id, actor_id, action_type, target_id, changes, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetAuditLogByID :one
+SELECT id, guild_id, actor_id, action_type, target_id, changes, reason, created_at
+FROM audit_logs
+WHERE id = $1;
+
+-- name: ListAuditLogs :many
+-- idx_audit_logs_guild_created_atを使い、同時刻の記録はidで順序を安定させる
+SELECT id, guild_id, actor_id, action_type, target_id, changes, reason, created_at
+FROM audit_logs
+WHERE guild_id = @guild_id
+  AND (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id')::uuid)
+  AND (sqlc.narg('action_type')::varchar IS NULL OR action_type = sqlc.narg('action_type')::varchar)
+  AND (sqlc.narg('target_id')::uuid IS NULL OR target_id = sqlc.narg('target_id')::uuid)
+  AND (sqlc.narg('cursor_id')::uuid IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
@@ -0,0 +1,23 @@
+-- name: UpsertBan :one
+-- 再BANの場合は理由・実行者・期限を上書きする
+INSERT INTO bans (guild_id, user_id, moderator_id, reason, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (guild_id, user_id) DO UPDATE
+SET moderator_id = EXCLUDED.moderator_id, reason = EXCLUDED.reason, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+RETURNING guild_id, user_id, moderator_id, reason, expires_at, created_at;
+
+-- name: GetActiveBansByGuildID :many
+SELECT guild_id, user_id, moderator_id, reason, expires_at, created_at
+FROM bans
+WHERE guild_id = $1 AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC;
+
+-- name: IsBanned :one
+SELECT EXISTS (
+  SELECT 1 FROM bans
+  WHERE guild_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+);
+
+-- name: DeleteBan :execrows
+DELETE FROM bans
+WHERE guild_id = $1 AND user_id = $2;
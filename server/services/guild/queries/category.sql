@@ -1,14 +1,50 @@
 -- name: CreateCategory :one
-INSERT INTO categories (id, guild_id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW())
-RETURNING id, guild_id, name, created_at;
+-- ギルドの一番下に追加する
+INSERT INTO categories (id, guild_id, name, position, created_at, updated_at)
+VALUES (
+  $1, $2, $3,
+  (SELECT COALESCE(MAX(c.position) + 1, 0)::integer FROM categories c WHERE c.guild_id = $2),
+  $4, NOW()
+)
+RETURNING id, guild_id, name, position, created_at;
 
 -- name: GetByGuildID :many
-SELECT id, guild_id, name, created_at
+SELECT id, guild_id, name, position, created_at
 FROM categories
-WHERE guild_id = $1;
+WHERE guild_id = $1
+ORDER BY position, created_at, id;
 
 -- name: GetGuildIDByCategoryID :one
 SELECT guild_id
 FROM categories
 WHERE id = $1;
+
+-- name: GetCategoryByID :one
+SELECT id, guild_id, name, position, created_at
+FROM categories
+WHERE id = $1;
+
+-- name: UpdateCategory :one
+UPDATE categories
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, guild_id, name, position, created_at;
+
+-- name: DeleteCategory :execrows
+DELETE FROM categories
+WHERE id = $1;
+
+-- name: GetOldestCategoryExcept :one
+-- カテゴリを削除するときに、チャンネルの移動先として使う
+SELECT id, guild_id, name, position, created_at
+FROM categories
+WHERE guild_id = $1 AND id <> $2
+ORDER BY created_at, id
+LIMIT 1;
+
+-- name: UpdateCategoryPositions :exec
+-- category_idsの順に0から振り直す
+UPDATE categories c
+SET position = o.position - 1, updated_at = NOW()
+FROM unnest(@category_ids::uuid[]) WITH ORDINALITY AS o(id, position)
+WHERE c.id = o.id AND c.guild_id = @guild_id;
@@ -1,18 +1,86 @@
 -- name: CreateChannel :one
-INSERT INTO channels (id, category_id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4, NOW())
-RETURNING id, category_id, name, created_at;
+-- カテゴリーの一番下に追加する
+INSERT INTO channels (id, category_id, name, position, created_at, updated_at)
+VALUES (
+  $1, $2, $3,
+  (SELECT COALESCE(MAX(ch.position) + 1, 0)::integer FROM channels ch WHERE ch.category_id = $2),
+  $4, NOW()
+)
+RETURNING id, category_id, name, position, created_at;
 
 -- name: GetByCategoryID :many
-SELECT id, category_id, name, created_at
+SELECT id, category_id, name, position, created_at
 FROM channels
-WHERE category_id = $1;
-
--- name: CheckChannelMember :one
-SELECT EXISTS (
-    SELECT 1
-    FROM members m
-    JOIN categories c ON m.guild_id = c.guild_id
-    JOIN channels ch ON c.id = ch.category_id
-    WHERE m.user_id = $1 AND ch.id = $2
-);
+WHERE category_id = $1
+ORDER BY position, created_at, id;
+
+-- name: FilterChannelGuildMembers :many
+SELECT m.user_id
+FROM members m
+JOIN categories c ON m.guild_id = c.guild_id
+JOIN channels ch ON c.id = ch.category_id
+WHERE ch.id = @channel_id AND m.user_id = ANY(@user_ids::uuid[]);
+
+-- name: FilterSameGuildChannels :many
+SELECT target.id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+JOIN categories target_c ON target_c.guild_id = c.guild_id
+JOIN channels target ON target.category_id = target_c.id
+WHERE ch.id = @channel_id AND target.id = ANY(@channel_ids::uuid[]);
+
+-- name: GetGuildIDByChannelID :one
+SELECT c.guild_id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE ch.id = $1;
+
+-- name: GetChannelByID :one
+SELECT id, category_id, name, position, created_at
+FROM channels
+WHERE id = $1;
+
+-- name: UpdateChannel :one
+UPDATE channels
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, category_id, name, position, created_at;
+
+-- name: DeleteChannel :execrows
+DELETE FROM channels
+WHERE id = $1;
+
+-- name: MoveChannelsToCategory :many
+-- 並び順を保ったまま移動先の一番下に追加する
+UPDATE channels moved
+SET
+  category_id = @to_category_id,
+  position = moved.position + (SELECT COALESCE(MAX(ch.position) + 1, 0)::integer FROM channels ch WHERE ch.category_id = @to_category_id),
+  updated_at = NOW()
+WHERE moved.category_id = @from_category_id
+RETURNING moved.id, moved.category_id, moved.name, moved.position, moved.created_at;
+
+-- name: GetChannelsByGuildID :many
+-- サイドバーに表示する順
+SELECT ch.id, ch.category_id, ch.name, ch.position, ch.created_at
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE c.guild_id = $1
+ORDER BY c.position, c.created_at, c.id, ch.position, ch.created_at, ch.id;
+
+-- name: GetChannelsWithGuildIDByIDs :many
+SELECT ch.id, ch.category_id, ch.name, ch.position, ch.created_at, c.guild_id
+FROM channels ch
+JOIN categories c ON c.id = ch.category_id
+WHERE ch.id = ANY(@channel_ids::uuid[]);
+
+-- name: UpdateChannelPositions :exec
+-- channel_idsをcategory_idに移し、その順に0から振り直す。移す元と先のカテゴリーはどちらもguild_idのものに限る
+UPDATE channels ch
+SET category_id = sqlc.arg(category_id)::uuid, position = o.position - 1, updated_at = NOW()
+FROM unnest(sqlc.arg(channel_ids)::uuid[]) WITH ORDINALITY AS o(id, position), categories src
+WHERE ch.id = o.id
+  AND src.id = ch.category_id AND src.guild_id = sqlc.arg(guild_id)::uuid
+  AND EXISTS (
+    SELECT 1 FROM categories dst WHERE dst.id = sqlc.arg(category_id)::uuid AND dst.guild_id = sqlc.arg(guild_id)::uuid
+  );
@@ -0,0 +1,26 @@
+-- name: CreateChannelPurgeJob :exec
+INSERT INTO channel_purge_jobs (id, channel_id, target, attempts, next_run_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ClaimDueChannelPurgeJobs :many
+-- 取り出したジョブはnext_run_atをリース期限まで進め、ほかのレプリカが同時に処理しないようにする
+UPDATE channel_purge_jobs
+SET attempts = attempts + 1, next_run_at = sqlc.arg(lease_until)::timestamp
+WHERE id IN (
+  SELECT j.id
+  FROM channel_purge_jobs j
+  WHERE j.next_run_at <= sqlc.arg(now)::timestamp
+  ORDER BY j.next_run_at
+  LIMIT sqlc.arg(row_limit)
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, channel_id, target, attempts, next_run_at, last_error, created_at;
+
+-- name: DeleteChannelPurgeJob :exec
+DELETE FROM channel_purge_jobs
+WHERE id = $1;
+
+-- name: RescheduleChannelPurgeJob :exec
+UPDATE channel_purge_jobs
+SET next_run_at = $2, last_error = $3
+WHERE id = $1;
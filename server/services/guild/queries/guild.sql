@@ -12,6 +12,10 @@ RETURNING id, owner_id, name, description, icon_url, default_channel_id, created
 -- name: GetGuildByID :one
 SELECT id, owner_id, name, description, icon_url, default_channel_id, created_at FROM guilds WHERE id = $1;
 
+-- name: LockGuildByID :one
+-- 同じギルドへの操作を直列にするため、ギルドの行をトランザクションの終わりまでロックする
+SELECT id FROM guilds WHERE id = $1 FOR UPDATE;
+
 -- name: GetMyGuilds :many
 SELECT g.id, g.owner_id, g.name, g.description, g.icon_url, g.default_channel_id, g.created_at
 FROM guilds g
@@ -19,9 +23,12 @@ JOIN members m ON g.id = m.guild_id
 WHERE m.user_id = $1
 ORDER BY g.created_at DESC;
 
--- name: IsOwner :one
-SELECT EXISTS (
-    SELECT 1
-    FROM guilds
-    WHERE id = $1 AND owner_id = $2
-);
+
+-- name: UpdateGuildOwner :one
+UPDATE guilds
+SET owner_id = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, name, description, icon_url, default_channel_id, created_at;
+
+-- name: DeleteGuild :execrows
+DELETE FROM guilds WHERE id = $1;
@@ -1,10 +1,10 @@
 -- name: CreateGuildInvite :one
-INSERT INTO invites (guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at;
+INSERT INTO invites (guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at;
 
 -- name: GetGuildInvitesByGuildID :many
-SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at
+SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
 FROM invites
 WHERE guild_id = $1;
 
@@ -12,12 +12,44 @@ WHERE guild_id = $1;
 UPDATE invites
 SET current_uses = current_uses + 1
 WHERE invite_code = $1 AND (max_uses IS NULL OR current_uses < max_uses) AND (expires_at IS NULL OR expires_at > NOW())
-RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, created_at;
+RETURNING guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at;
 
 -- name: GetInviteByInviteCode :one
 -- GuildをJoinして取得
 SELECT
-  i.guild_id, i.creator_id, i.invite_code, i.max_uses, i.current_uses, i.expires_at, i.created_at, g.name AS "guild.name", g.description AS "guild.description", g.icon_url AS "guild.icon_url", g.owner_id AS "guild.owner_id", g.default_channel_id AS "guild.default_channel_id", g.created_at AS "guild.created_at"
+  i.guild_id, i.creator_id, i.invite_code, i.max_uses, i.current_uses, i.expires_at, i.vanity, i.created_at, g.name AS "guild.name", g.description AS "guild.description", g.icon_url AS "guild.icon_url", g.owner_id AS "guild.owner_id", g.default_channel_id AS "guild.default_channel_id", g.created_at AS "guild.created_at"
 FROM invites i
 JOIN guilds g ON i.guild_id = g.id
 WHERE i.invite_code = $1;
+
+-- name: InviteCodeExists :one
+SELECT EXISTS(SELECT 1 FROM invites WHERE invite_code = $1);
+
+-- name: GetVanityInviteByGuildID :one
+SELECT guild_id, creator_id, invite_code, max_uses, current_uses, expires_at, vanity, created_at
+FROM invites
+WHERE guild_id = $1 AND vanity;
+
+-- name: DeleteInvite :execrows
+DELETE FROM invites
+WHERE invite_code = $1;
+
+-- name: DeleteExpiredInvites :execrows
+-- バニティURLは期限も上限もないので対象にならない
+DELETE FROM invites
+WHERE invite_code IN (
+  SELECT invite_code FROM invites
+  WHERE (expires_at IS NOT NULL AND expires_at <= NOW())
+     OR (max_uses IS NOT NULL AND current_uses >= max_uses)
+  LIMIT @row_limit
+);
+
+-- name: CreateInviteUse :exec
+INSERT INTO invite_uses (id, guild_id, invite_code, user_id, used_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetInviteUses :many
+SELECT id, guild_id, invite_code, user_id, used_at
+FROM invite_uses
+WHERE guild_id = $1 AND invite_code = $2
+ORDER BY used_at DESC;
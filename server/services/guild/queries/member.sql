@@ -4,7 +4,7 @@ VALUES ($1, $2, $3, NOW())
 RETURNING guild_id, user_id, joined_at;
 
 -- name: GetMembersByGuildID :many
-SELECT guild_id, user_id, joined_at
+SELECT guild_id, user_id, joined_at, communication_disabled_until
 FROM members
 WHERE guild_id = $1;
 
@@ -19,3 +19,18 @@ SELECT EXISTS (
     FROM members
     WHERE guild_id = $1 AND user_id = $2
 );
+
+-- name: GetGuildIDsByUserID :many
+SELECT guild_id
+FROM members
+WHERE user_id = $1;
+
+-- name: RemoveMember :execrows
+DELETE FROM members
+WHERE guild_id = $1 AND user_id = $2;
+
+-- name: UpdateMemberCommunicationDisabledUntil :one
+UPDATE members
+SET communication_disabled_until = $3, updated_at = NOW()
+WHERE guild_id = $1 AND user_id = $2
+RETURNING guild_id, user_id, joined_at, communication_disabled_until;
@@ -0,0 +1,28 @@
+-- name: UpsertPermissionOverwrite :one
+INSERT INTO permission_overwrites (id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+ON CONFLICT ((COALESCE(channel_id, category_id)), (COALESCE(role_id, user_id)))
+DO UPDATE SET allow = EXCLUDED.allow, deny = EXCLUDED.deny, updated_at = NOW()
+RETURNING id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at;
+
+-- name: GetPermissionOverwritesByScopeID :many
+SELECT id, guild_id, channel_id, category_id, role_id, user_id, allow, deny, created_at
+FROM permission_overwrites
+WHERE COALESCE(channel_id, category_id) = @scope_id::uuid
+ORDER BY created_at, id;
+
+-- name: DeletePermissionOverwrite :execrows
+DELETE FROM permission_overwrites
+WHERE COALESCE(channel_id, category_id) = @scope_id::uuid AND COALESCE(role_id, user_id) = @target_id::uuid;
+
+-- name: GetMemberPermissionOverwrites :many
+-- ギルド内の上書きのうち、@everyone・user_idが持っているロール・user_id本人を対象にするもの
+SELECT o.id, o.guild_id, o.channel_id, o.category_id, o.role_id, o.user_id, o.allow, o.deny, o.created_at
+FROM permission_overwrites o
+WHERE o.guild_id = @guild_id::uuid AND (
+  o.role_id = @guild_id::uuid
+  OR o.user_id = @user_id::uuid
+  OR o.role_id IN (
+    SELECT mr.role_id FROM member_roles mr WHERE mr.guild_id = @guild_id::uuid AND mr.user_id = @user_id::uuid
+  )
+);
@@ -0,0 +1,79 @@
+-- name: CreateRole :one
+INSERT INTO roles (id, guild_id, name, permissions, position, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())
+RETURNING id, guild_id, name, permissions, position, created_at;
+
+-- name: GetRoleByID :one
+SELECT id, guild_id, name, permissions, position, created_at
+FROM roles
+WHERE id = $1;
+
+-- name: GetRolesByGuildID :many
+SELECT id, guild_id, name, permissions, position, created_at
+FROM roles
+WHERE guild_id = $1
+ORDER BY position, created_at, id;
+
+-- name: UpdateRole :one
+UPDATE roles
+SET name = $2, permissions = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, guild_id, name, permissions, position, created_at;
+
+-- name: DeleteRole :execrows
+DELETE FROM roles
+WHERE id = $1;
+
+-- name: ShiftRolePositions :exec
+-- @everyone(position = 0)より上のロールをすべて1つずつ上げ、position = 1を空ける
+UPDATE roles
+SET position = position + 1, updated_at = NOW()
+WHERE guild_id = $1 AND position > 0;
+
+-- name: UpdateRolePositions :exec
+-- role_idsの順に1から振り直す
+UPDATE roles r
+SET position = o.position, updated_at = NOW()
+FROM unnest(@role_ids::uuid[]) WITH ORDINALITY AS o(id, position)
+WHERE r.id = o.id AND r.guild_id = @guild_id;
+
+-- name: AddMemberRole :exec
+INSERT INTO member_roles (guild_id, user_id, role_id, created_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveMemberRole :execrows
+DELETE FROM member_roles
+WHERE guild_id = $1 AND user_id = $2 AND role_id = $3;
+
+-- name: GetMemberRolesByGuildID :many
+SELECT user_id, role_id
+FROM member_roles
+WHERE guild_id = $1;
+
+-- name: GetMemberPermissions :one
+-- ギルドのオーナーか、メンバーか、@everyoneと持っているロールの権限の和、持っているロールの最も高いposition、タイムアウトの解除時刻
+SELECT
+  COALESCE(g.owner_id = @user_id::uuid, false)::boolean AS is_owner,
+  EXISTS (
+    SELECT 1 FROM members m WHERE m.guild_id = g.id AND m.user_id = @user_id::uuid
+  )::boolean AS is_member,
+  COALESCE((
+    SELECT bit_or(r.permissions)
+    FROM roles r
+    WHERE r.guild_id = g.id AND (
+      r.id = g.id OR r.id IN (
+        SELECT mr.role_id FROM member_roles mr WHERE mr.guild_id = g.id AND mr.user_id = @user_id::uuid
+      )
+    )
+  ), 0)::bigint AS permissions,
+  COALESCE((
+    SELECT MAX(r.position)
+    FROM member_roles mr
+    JOIN roles r ON r.id = mr.role_id
+    WHERE mr.guild_id = g.id AND mr.user_id = @user_id::uuid
+  ), 0)::integer AS top_position,
+  tm.communication_disabled_until
+FROM guilds g
+LEFT JOIN members tm ON tm.guild_id = g.id AND tm.user_id = @user_id::uuid
+WHERE g.id = @guild_id;
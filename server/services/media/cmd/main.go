@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"media-service/internal/handler"
+	guildclient "media-service/internal/infrastructure/grpc"
 	"media-service/internal/infrastructure/rustfs"
 	"media-service/internal/seeder"
 	"net"
@@ -30,6 +31,7 @@ import (
 	_ "net/http/pprof"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -98,7 +100,22 @@ func main() {
 	})
 
 	mediaRepo := rustfs.NewRustFSMediaRepository(s3Client, BUCKET_NAME)
-	mediaHandler := handler.NewMediaHandler(mediaRepo)
+
+	guildServiceURL := os.Getenv("GUILD_SERVICE_URL")
+	guildConn, err := grpc.NewClient(guildServiceURL, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to connect to guild service", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := guildConn.Close(); err != nil {
+			log.Error("Failed to close guild service connection", "error", err)
+		}
+	}()
+	log.Info("Connected to guild service", "url", guildServiceURL)
+
+	guildSvc := guildclient.NewGuildServiceClient(guildConn)
+	mediaHandler := handler.NewMediaHandler(mediaRepo, guildSvc, log)
 
 	grpcSrv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
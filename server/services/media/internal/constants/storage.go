@@ -3,4 +3,6 @@ package constants
 const (
 	GUILD_ICON_PATH = "icons/guilds/"
 	USER_ICON_PATH  = "icons/users/"
+	// attachments/{channelID}/{uuid}/{filename} の形式で保存する
+	ATTACHMENT_PATH = "attachments/"
 )
@@ -4,9 +4,16 @@ import (
 	pb "chat-app-proto/gen/media"
 	"context"
 	"errors"
+	"log/slog"
 	"media-service/internal/constants"
 	"net/url"
+	"path"
+	"shared/metadata"
 	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -19,18 +26,38 @@ type GeneratePresignedURLParams struct {
 	Expires   time.Duration
 }
 
+type ObjectStat struct {
+	Exists      bool
+	Size        int64
+	ContentType string
+	URL         string
+}
+
 type MediaRepository interface {
 	GeneratePresignedURL(context.Context, GeneratePresignedURLParams) (string, error)
+	StatObject(ctx context.Context, objectKey string) (*ObjectStat, error)
+	// prefixから始まるオブジェクトをすべて削除し、削除した件数を返す
+	DeleteByPrefix(ctx context.Context, prefix string) (int, error)
+}
+
+// 添付ファイルのアップロード可否をguild-serviceに問い合わせる
+type ChannelPermissionChecker interface {
+	// チャンネルを閲覧でき、かつATTACH_FILESを持つ場合にtrueを返す
+	CanAttachFiles(ctx context.Context, userID, channelID uuid.UUID) (bool, error)
 }
 
 type MediaHandler struct {
 	pb.UnimplementedMediaServiceServer
-	mediaRepo MediaRepository
+	mediaRepo   MediaRepository
+	permissions ChannelPermissionChecker
+	logger      *slog.Logger
 }
 
-func NewMediaHandler(mediaRepo MediaRepository) *MediaHandler {
+func NewMediaHandler(mediaRepo MediaRepository, permissions ChannelPermissionChecker, logger *slog.Logger) *MediaHandler {
 	return &MediaHandler{
-		mediaRepo: mediaRepo,
+		mediaRepo:   mediaRepo,
+		permissions: permissions,
+		logger:      logger,
 	}
 }
 
@@ -43,6 +70,20 @@ func (h *MediaHandler) GetPresignedUploadURL(ctx context.Context, req *pb.GetPre
 		objectKey = constants.GUILD_ICON_PATH + req.Filename
 	case pb.MediaType_MEDIA_TYPE_USER_ICON:
 		objectKey = constants.USER_ICON_PATH + req.Filename
+	case pb.MediaType_MEDIA_TYPE_ATTACHMENT:
+		channelID, err := uuid.Parse(req.GetChannelId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid channel ID")
+		}
+		if err := h.authorizeAttachmentUpload(ctx, channelID); err != nil {
+			return nil, err
+		}
+		// 同名のファイルが上書きされないようにランダムなディレクトリを挟む
+		filename := path.Base(req.Filename)
+		if filename == "." || filename == "/" {
+			return nil, status.Error(codes.InvalidArgument, "invalid filename")
+		}
+		objectKey = constants.ATTACHMENT_PATH + channelID.String() + "/" + uuid.NewString() + "/" + filename
 	}
 
 	presignedURL, err := h.mediaRepo.GeneratePresignedURL(ctx, GeneratePresignedURLParams{
@@ -60,7 +101,77 @@ func (h *MediaHandler) GetPresignedUploadURL(ctx context.Context, req *pb.GetPre
 
 	return &pb.GetPresignedUploadURLResponse{
 		UploadUrl: parsedPresignedURL.String(),
+		ObjectKey: objectKey,
 	}, nil
 }
 
+// メッセージ送信と同じく、チャンネルのATTACH_FILESを持たないユーザーには署名付きURLを発行しない
+func (h *MediaHandler) authorizeAttachmentUpload(ctx context.Context, channelID uuid.UUID) error {
+	userIDStr, err := metadata.GetUserIDFromMetadata(ctx)
+	if err != nil {
+		h.logger.Warn("Failed to get user ID from metadata", "error", err)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", userIDStr, "error", err)
+		return status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	allowed, err := h.permissions.CanAttachFiles(ctx, userID, channelID)
+	if err != nil {
+		h.logger.Error("Failed to check channel permissions", "channel_id", channelID, "error", err)
+		return err
+	}
+	if !allowed {
+		h.logger.Warn("Attachment upload not allowed", "user_id", userID, "channel_id", channelID)
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (h *MediaHandler) StatObjects(ctx context.Context, req *pb.StatObjectsRequest) (*pb.StatObjectsResponse, error) {
+	objects := make([]*pb.ObjectStat, len(req.ObjectKeys))
+	for i, objectKey := range req.ObjectKeys {
+		stat, err := h.mediaRepo.StatObject(ctx, objectKey)
+		if err != nil {
+			return nil, err
+		}
+		objects[i] = &pb.ObjectStat{
+			ObjectKey:   objectKey,
+			Exists:      stat.Exists,
+			Size:        stat.Size,
+			ContentType: stat.ContentType,
+			Url:         stat.URL,
+		}
+	}
+
+	return &pb.StatObjectsResponse{Objects: objects}, nil
+}
+
+// 削除されたチャンネルの添付ファイルを消す
+func (h *MediaHandler) PurgeChannelAttachments(ctx context.Context, req *pb.PurgeChannelAttachmentsRequest) (*pb.PurgeChannelAttachmentsResponse, error) {
+	channelIDs := make([]uuid.UUID, len(req.ChannelIds))
+	for i, idStr := range req.ChannelIds {
+		channelID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid channel ID")
+		}
+		channelIDs[i] = channelID
+	}
+
+	// guild-serviceのワーカーが成功するまで再試行するので、削除が終わるまで待ってから返す。
+	// 既に消えたオブジェクトは一覧に出てこないため、同じチャンネルで何度呼ばれても問題ない
+	for _, channelID := range channelIDs {
+		deleted, err := h.mediaRepo.DeleteByPrefix(ctx, constants.ATTACHMENT_PATH+channelID.String()+"/")
+		if err != nil {
+			h.logger.Error("Purge channel attachments failed", "channel_id", channelID, "deleted_count", deleted, "error", err)
+			return nil, status.Error(codes.Internal, "failed to purge channel attachments")
+		}
+		h.logger.Info("Purged channel attachments", "channel_id", channelID, "deleted_count", deleted)
+	}
+
+	return &pb.PurgeChannelAttachmentsResponse{}, nil
+}
+
 var _ pb.MediaServiceServer = (*MediaHandler)(nil)
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	pb "chat-app-proto/gen/guild"
+	"context"
+	"media-service/internal/handler"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type guildServiceClient struct {
+	client pb.GuildServiceClient
+}
+
+func NewGuildServiceClient(conn *grpc.ClientConn) *guildServiceClient {
+	return &guildServiceClient{
+		client: pb.NewGuildServiceClient(conn),
+	}
+}
+
+func (c *guildServiceClient) CanAttachFiles(ctx context.Context, userID, channelID uuid.UUID) (bool, error) {
+	resp, err := c.client.CheckChannelAccess(ctx, &pb.CheckChannelAccessRequest{
+		UserId:    userID.String(),
+		ChannelId: channelID.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	perms := resp.GetPermissions()
+	return perms.GetViewChannel() && perms.GetAttachFiles(), nil
+}
+
+var _ handler.ChannelPermissionChecker = (*guildServiceClient)(nil)
@@ -2,10 +2,13 @@ package rustfs
 
 import (
 	"context"
+	"errors"
 	"media-service/internal/handler"
+	"net/url"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 type RustFSMediaRepository struct {
@@ -35,4 +38,69 @@ func (r *RustFSMediaRepository) GeneratePresignedURL(ctx context.Context, params
 	return request.URL, nil
 }
 
+func (r *RustFSMediaRepository) StatObject(ctx context.Context, objectKey string) (*handler.ObjectStat, error) {
+	output, err := r.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return &handler.ObjectStat{Exists: false}, nil
+		}
+		return nil, err
+	}
+
+	return &handler.ObjectStat{
+		Exists:      true,
+		Size:        aws.ToInt64(output.ContentLength),
+		ContentType: aws.ToString(output.ContentType),
+		URL:         r.objectURL(objectKey),
+	}, nil
+}
+
+// ListObjectsV2が返す1ページ分(最大1000件)ずつDeleteObjectsで削除する
+func (r *RustFSMediaRepository) DeleteByPrefix(ctx context.Context, prefix string) (int, error) {
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucketName),
+		Prefix: aws.String(prefix),
+	})
+
+	deleted := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return deleted, err
+		}
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]types.ObjectIdentifier, len(page.Contents))
+		for i, object := range page.Contents {
+			objects[i] = types.ObjectIdentifier{Key: object.Key}
+		}
+		output, err := r.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(r.bucketName),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return deleted, err
+		}
+		if len(output.Errors) > 0 {
+			return deleted + len(objects) - len(output.Errors), errors.New(aws.ToString(output.Errors[0].Message))
+		}
+		deleted += len(objects)
+	}
+	return deleted, nil
+}
+
+// パススタイルで公開されているオブジェクトのURLを返す
+func (r *RustFSMediaRepository) objectURL(objectKey string) string {
+	return aws.ToString(r.client.Options().BaseEndpoint) + "/" + r.bucketName + "/" + (&url.URL{Path: objectKey}).EscapedPath()
+}
+
 var _ handler.MediaRepository = (*RustFSMediaRepository)(nil)
@@ -6,7 +6,6 @@ import (
 	"message-service/internal/handler"
 	user "message-service/internal/infrastructure/grpc"
 	"message-service/internal/infrastructure/postgres"
-	"message-service/internal/infrastructure/postgres/gen"
 	rds "message-service/internal/infrastructure/redis"
 	"message-service/internal/usecase"
 	"net"
@@ -145,23 +144,57 @@ func main() {
 	}()
 	log.Info("Connected to guild service", "url", guildServiceURL)
 
-	messageRepo := postgres.NewPostgresMessageRepository(gen.New(db))
+	mediaServiceURL := os.Getenv("MEDIA_SERVICE_URL")
+	mediaConn, err := grpc.NewClient(mediaServiceURL, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to connect to media service", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := mediaConn.Close(); err != nil {
+			log.Error("Failed to close media service connection", "error", err)
+		}
+	}()
+	log.Info("Connected to media service", "url", mediaServiceURL)
+
+	store := postgres.NewPostgresStore(db)
 	userSvc := rds.NewCachedUserClient(redisClient, user.NewUserServiceClient(userConn))
 
 	guildSvc := user.NewGuildServiceClient(guildConn)
+	mediaSvc := user.NewMediaServiceClient(mediaConn)
 	redisPub := rds.NewRedisPublisher(redisClient)
 
 	validate := validator.New()
 
 	messageUsecase := usecase.NewMessageUsecase(usecase.MessageUsecaseParams{
-		MessageRepo: messageRepo,
-		UserSvc:     userSvc,
-		GuildSvc:    guildSvc,
-		Publisher:   redisPub,
-		Validator:   validate,
+		Store:     store,
+		UserSvc:   userSvc,
+		GuildSvc:  guildSvc,
+		MediaSvc:  mediaSvc,
+		Publisher: redisPub,
+		Validator: validate,
+	})
+	reactionUsecase := usecase.NewReactionUsecase(usecase.ReactionUsecaseParams{
+		Store:     store,
+		UserSvc:   userSvc,
+		GuildSvc:  guildSvc,
+		Publisher: redisPub,
+		Validator: validate,
 	})
 
-	messageHandler := handler.NewMessageHandler(messageUsecase, log)
+	readStateUsecase := usecase.NewReadStateUsecase(usecase.ReadStateUsecaseParams{
+		Store:     store,
+		GuildSvc:  guildSvc,
+		Publisher: redisPub,
+		Validator: validate,
+	})
+
+	messageHandler := handler.NewMessageHandler(&handler.NewMessageHandlerParams{
+		MessageUsecase:   messageUsecase,
+		ReactionUsecase:  reactionUsecase,
+		ReadStateUsecase: readStateUsecase,
+		Logger:           log,
+	})
 
 	grpcSrv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
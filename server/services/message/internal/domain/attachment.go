@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 1つのメッセージに添付できるファイルの上限
+	MaxAttachmentsPerMessage = 10
+	// 添付ファイル1つあたりの最大サイズ(25MB)
+	MaxAttachmentSize = 25 * 1024 * 1024
+)
+
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	MessageID   uuid.UUID `json:"messageId"`
+	ObjectKey   string    `json:"-"`
+	Filename    string    `json:"filename"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"-"`
+}
+
+// 添付ファイルはメディアサービスで attachments/{channelID}/ 以下にアップロードされる
+func AttachmentObjectKeyPrefix(channelID uuid.UUID) string {
+	return "attachments/" + channelID.String() + "/"
+}
+
+type IAttachmentRepository interface {
+	// 渡された順序で保存する。既に他のメッセージに添付されているオブジェクトの場合はErrInvalidAttachmentを返す
+	CreateMany(ctx context.Context, attachments []*Attachment) error
+	// メッセージIDをキーに添付ファイルを返す
+	GetByMessageIDs(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]*Attachment, error)
+}
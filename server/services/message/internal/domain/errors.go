@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrInvalidMessageData  = errors.New("invalid message data")
@@ -8,4 +11,27 @@ var (
 	ErrInternalServerError = errors.New("internal server error")
 	ErrInvalidCredentials  = errors.New("invalid credentials")
 	ErrChannelNotFound     = errors.New("channel not found")
+	ErrMessageNotFound     = errors.New("message not found")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrInvalidReplyTarget  = errors.New("invalid reply target")
+	ErrInvalidReactionData = errors.New("invalid reaction data")
+	ErrTooManyReactions    = errors.New("too many reactions")
+	ErrTooManyPins         = errors.New("too many pinned messages")
+	ErrInvalidAttachment   = errors.New("invalid attachment")
+
+	// 権限不足とは区別し、クライアントが解除時刻まで待てるようにする
+	ErrCommunicationDisabled = errors.New("communication disabled by guild timeout")
 )
+
+// タイムアウト中のメンバーの操作を拒否したときに返す。errors.IsでErrCommunicationDisabledと比較できる
+type CommunicationDisabledError struct {
+	Until time.Time
+}
+
+func (e *CommunicationDisabledError) Error() string {
+	return ErrCommunicationDisabled.Error()
+}
+
+func (e *CommunicationDisabledError) Unwrap() error {
+	return ErrCommunicationDisabled
+}
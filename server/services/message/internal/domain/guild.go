@@ -2,10 +2,26 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// 上書きを適用した、チャンネルでのユーザーの権限。チャンネルが存在しないか見られない場合はすべてfalse
+type ChannelPermissions struct {
+	ViewChannel    bool
+	SendMessages   bool
+	AttachFiles    bool
+	AddReactions   bool
+	ManageMessages bool
+	// タイムアウト中の場合のみ設定される。解除されるまで送信とリアクションはできない
+	CommunicationDisabledUntil *time.Time
+}
+
 type IGuildService interface {
-	CheckChannelAccess(ctx context.Context, userID, channelID uuid.UUID) (bool, error)
+	GetChannelPermissions(ctx context.Context, userID, channelID uuid.UUID) (*ChannelPermissions, error)
+	// channelIDと同じギルドに属するメンバーとチャンネルだけを絞り込んで返す
+	FilterMentionTargets(ctx context.Context, channelID uuid.UUID, userIDs, channelIDs []uuid.UUID) ([]uuid.UUID, []uuid.UUID, error)
+	// ギルド内でユーザーがアクセスできるチャンネルのIDを返す。メンバーでない場合は空
+	ListAccessibleChannelIDs(ctx context.Context, userID, guildID uuid.UUID) ([]uuid.UUID, error)
 }
@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+type ObjectStat struct {
+	ObjectKey   string
+	Exists      bool
+	Size        int64
+	ContentType string
+	URL         string
+}
+
+type IMediaService interface {
+	// objectKeysと同じ順序で返す
+	StatObjects(ctx context.Context, objectKeys []string) ([]*ObjectStat, error)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+type MentionType string
+
+const (
+	MentionTypeUser    MentionType = "user"
+	MentionTypeChannel MentionType = "channel"
+)
+
+// <@userID> 形式のユーザーメンションと <#channelID> 形式のチャンネルメンション
+var mentionPattern = regexp.MustCompile(`<([@#])([0-9a-fA-F-]{36})>`)
+
+type Mention struct {
+	Type MentionType `json:"type"`
+	ID   uuid.UUID   `json:"id"`
+	// 本文で最初に出てきた位置(バイト単位)。並び順にだけ使う
+	Position int32 `json:"-"`
+}
+
+// contentに含まれるメンションを出現順に重複を除いて抽出する
+func ParseMentions(content string) []*Mention {
+	type key struct {
+		mentionType MentionType
+		id          uuid.UUID
+	}
+	seen := make(map[key]struct{})
+	var mentions []*Mention
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		id, err := uuid.Parse(content[match[4]:match[5]])
+		if err != nil {
+			continue
+		}
+		mentionType := MentionTypeUser
+		if content[match[2]:match[3]] == "#" {
+			mentionType = MentionTypeChannel
+		}
+		k := key{mentionType: mentionType, id: id}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		mentions = append(mentions, &Mention{Type: mentionType, ID: id, Position: int32(match[0])})
+	}
+	return mentions
+}
+
+type IMentionRepository interface {
+	CreateMany(ctx context.Context, messageID uuid.UUID, mentions []*Mention) error
+	DeleteByMessageID(ctx context.Context, messageID uuid.UUID) error
+	// メッセージIDをキーにメンションを返す
+	GetByMessageIDs(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]*Mention, error)
+}
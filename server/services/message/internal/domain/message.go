@@ -7,6 +7,13 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// リプライ先として埋め込むときのcontentの最大文字数
+	ReferencedMessageContentMaxLength = 100
+	// 1つのチャンネルにピン留めできるメッセージの上限
+	MaxPinnedMessagesPerChannel = 50
+)
+
 type Message struct {
 	ID        uuid.UUID  `json:"id"`
 	ChannelID uuid.UUID  `json:"channelId"`
@@ -15,9 +22,66 @@ type Message struct {
 	Content   string     `json:"content"`
 	ReplyID   *uuid.UUID `json:"replyId"`
 	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt"`
+	PinnedAt  *time.Time `json:"pinnedAt"`
+
+	ReferencedMessage *ReferencedMessage `json:"referencedMessage"`
+	Reactions         []*ReactionCount   `json:"reactions"`
+	Mentions          []*Mention         `json:"mentions"`
+	Attachments       []*Attachment      `json:"attachments"`
+}
+
+type ReferencedMessage struct {
+	ID       uuid.UUID  `json:"id"`
+	SenderID *uuid.UUID `json:"senderId"`
+	Sender   *User      `json:"sender"`
+	Content  string     `json:"content"`
+	Deleted  bool       `json:"deleted"`
+}
+
+func NewReferencedMessage(message *Message) *ReferencedMessage {
+	senderID := message.SenderID
+	content := []rune(message.Content)
+	if len(content) > ReferencedMessageContentMaxLength {
+		content = append(content[:ReferencedMessageContentMaxLength], '…')
+	}
+	return &ReferencedMessage{
+		ID:       message.ID,
+		SenderID: &senderID,
+		Content:  string(content),
+	}
+}
+
+func NewDeletedReferencedMessage(id uuid.UUID) *ReferencedMessage {
+	return &ReferencedMessage{
+		ID:      id,
+		Deleted: true,
+	}
 }
 
 type IMessageRepository interface {
 	Create(ctx context.Context, message *Message) (*Message, error)
-	GetByChannelID(ctx context.Context, channelID uuid.UUID) ([]*Message, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Message, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Message, error)
+	// 新しい順にlimit件返す
+	GetByChannelID(ctx context.Context, channelID uuid.UUID, limit int32) ([]*Message, error)
+	// cursorより古いメッセージを新しい順にlimit件返す
+	GetByChannelIDBefore(ctx context.Context, channelID uuid.UUID, cursor *Message, limit int32) ([]*Message, error)
+	// cursorより新しいメッセージを古い順にlimit件返す
+	GetByChannelIDAfter(ctx context.Context, channelID uuid.UUID, cursor *Message, limit int32) ([]*Message, error)
+	Update(ctx context.Context, message *Message) (*Message, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// since以降に送信されたメッセージを削除し、削除したメッセージのIDとチャンネルIDを返す
+	DeleteBySenderSince(ctx context.Context, senderID uuid.UUID, channelIDs []uuid.UUID, since time.Time) ([]*Message, error)
+	// channelIDsのメッセージを最大limit件削除し、削除した件数を返す
+	DeleteByChannelIDs(ctx context.Context, channelIDs []uuid.UUID, limit int32) (int64, error)
+	// pinnedAtがnilの場合はピン留めを解除する
+	UpdatePinnedAt(ctx context.Context, id uuid.UUID, pinnedAt *time.Time) error
+	// ピン留めされた順に新しいものから返す
+	GetPinnedByChannelID(ctx context.Context, channelID uuid.UUID) ([]*Message, error)
+	CountPinnedByChannelID(ctx context.Context, channelID uuid.UUID) (int, error)
+	// トランザクションが終わるまで、同じチャンネルでほかのピン留めを待たせる
+	LockPinsByChannelID(ctx context.Context, channelID uuid.UUID) error
+	// 本文が一致するメッセージを新しい順に返す
+	Search(ctx context.Context, query *MessageSearchQuery) ([]*MessageSearchHit, error)
 }
@@ -2,8 +2,19 @@ package domain
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type IPublisher interface {
-	Publish(ctx context.Context, message *Message) error
+	PublishMessageCreated(ctx context.Context, message *Message) error
+	PublishMessageUpdated(ctx context.Context, message *Message) error
+	PublishMessageDeleted(ctx context.Context, message *Message) error
+	// 同じチャンネルの複数メッセージの削除をまとめて通知する
+	PublishMessagesDeleted(ctx context.Context, channelID uuid.UUID, messageIDs []uuid.UUID) error
+	PublishReactionAdded(ctx context.Context, channelID uuid.UUID, reaction *Reaction) error
+	PublishReactionRemoved(ctx context.Context, channelID uuid.UUID, reaction *Reaction) error
+	PublishChannelPinsUpdated(ctx context.Context, message *Message) error
+	// 同じユーザーの他のセッションに既読位置を同期する
+	PublishChannelAcked(ctx context.Context, state *ReadState) error
 }
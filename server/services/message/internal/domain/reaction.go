@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 1つのメッセージに付けられる絵文字の種類の上限
+const MaxReactionEmojisPerMessage = 20
+
+type Reaction struct {
+	MessageID uuid.UUID `json:"messageId"`
+	UserID    uuid.UUID `json:"userId"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// メッセージごとに絵文字単位で集計したリアクション。Meはリクエストしたユーザーがリアクション済みかどうか
+type ReactionCount struct {
+	Emoji string `json:"emoji"`
+	Count int32  `json:"count"`
+	Me    bool   `json:"me"`
+}
+
+type IReactionRepository interface {
+	// 既にリアクション済みの場合はfalseを返す
+	Create(ctx context.Context, reaction *Reaction) (bool, error)
+	// トランザクションが終わるまで、同じメッセージへのほかのリアクションの追加を待たせる
+	LockByMessageID(ctx context.Context, messageID uuid.UUID) error
+	// リアクションが存在しなかった場合はfalseを返す
+	Delete(ctx context.Context, messageID, userID uuid.UUID, emoji string) (bool, error)
+	// メッセージIDをキーに、最初にリアクションされた順で集計結果を返す
+	GetCountsByMessageIDs(ctx context.Context, messageIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]*ReactionCount, error)
+	// ユーザーIDの昇順でafterより後のユーザーをlimit件返す
+	GetReactorIDs(ctx context.Context, messageID uuid.UUID, emoji string, after *uuid.UUID, limit int32) ([]uuid.UUID, error)
+}
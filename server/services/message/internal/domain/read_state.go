@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 未読件数とメンション件数はこの値で打ち切る
+const MaxUnreadCount = 100
+
+// チャンネルごとの既読位置。LastReadAtは既読にしたメッセージのcreated_at
+type ReadState struct {
+	UserID            uuid.UUID `json:"userId"`
+	ChannelID         uuid.UUID `json:"channelId"`
+	LastReadMessageID uuid.UUID `json:"lastReadMessageId"`
+	LastReadAt        time.Time `json:"lastReadAt"`
+}
+
+type ChannelUnread struct {
+	ChannelID uuid.UUID
+	// 一度も既読にしていない場合はnil
+	LastReadMessageID *uuid.UUID
+	UnreadCount       int32
+	MentionCount      int32
+}
+
+type IReadStateRepository interface {
+	// 既読位置を進める。既により新しいメッセージまで既読の場合はfalseを返す
+	Upsert(ctx context.Context, state *ReadState) (bool, error)
+	// channelIDsと同じ順で返す
+	GetUnreadCounts(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]*ChannelUnread, error)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 検索結果のスニペットで一致箇所を囲むタグ
+const (
+	SearchHighlightStart = "<mark>"
+	SearchHighlightEnd   = "</mark>"
+)
+
+// nilのフィールドは絞り込みに使わない
+type MessageSearchQuery struct {
+	Query         string
+	ChannelIDs    []uuid.UUID
+	SenderID      *uuid.UUID
+	HasAttachment *bool
+	Before        *time.Time
+	After         *time.Time
+	// このメッセージより古いものを返す
+	Cursor *Message
+	Limit  int32
+}
+
+// SnippetはHTMLエスケープ済みで、一致箇所だけが<mark>で囲まれている
+type MessageSearchHit struct {
+	Message *Message
+	Snippet string
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+)
+
+type IStore interface {
+	Messages() IMessageRepository
+	Reactions() IReactionRepository
+	Mentions() IMentionRepository
+	Attachments() IAttachmentRepository
+	ReadStates() IReadStateRepository
+	ExecTx(ctx context.Context, fn func(IStore) error) error
+}
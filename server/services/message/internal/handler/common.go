@@ -5,12 +5,17 @@ import (
 	"log/slog"
 	"message-service/internal/domain"
 	"shared/metadata"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// タイムアウト中であることを示すErrorInfoのreason
+const communicationDisabledReason = "COMMUNICATION_DISABLED"
+
 func getUserID(ctx context.Context, logger *slog.Logger) (uuid.UUID, error) {
 	userIDStr, err := metadata.GetUserIDFromMetadata(ctx)
 	if err != nil {
@@ -25,3 +30,30 @@ func getUserID(ctx context.Context, logger *slog.Logger) (uuid.UUID, error) {
 	}
 	return userID, nil
 }
+
+func parseOptionalUUID(s *string) (*uuid.UUID, error) {
+	if s == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// 権限不足と区別できるよう、解除される時刻をErrorInfoに載せて返す
+func communicationDisabledError(until time.Time) error {
+	st := status.New(codes.PermissionDenied, domain.ErrCommunicationDisabled.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: communicationDisabledReason,
+		Domain: "message-service",
+		Metadata: map[string]string{
+			"communication_disabled_until": until.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"message-service/internal/domain"
 	"message-service/internal/usecase"
@@ -11,19 +12,31 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MessageHandler struct {
 	pb.UnimplementedMessageServiceServer
-	messageUsecase usecase.MessageUsecase
-	logger         *slog.Logger
+	messageUsecase   usecase.MessageUsecase
+	reactionUsecase  usecase.ReactionUsecase
+	readStateUsecase usecase.ReadStateUsecase
+	logger           *slog.Logger
 }
 
-func NewMessageHandler(messageUsecase usecase.MessageUsecase, logger *slog.Logger) *MessageHandler {
+type NewMessageHandlerParams struct {
+	MessageUsecase   usecase.MessageUsecase
+	ReactionUsecase  usecase.ReactionUsecase
+	ReadStateUsecase usecase.ReadStateUsecase
+	Logger           *slog.Logger
+}
+
+func NewMessageHandler(params *NewMessageHandlerParams) *MessageHandler {
 	return &MessageHandler{
-		messageUsecase: messageUsecase,
-		logger:         logger,
+		messageUsecase:   params.MessageUsecase,
+		reactionUsecase:  params.ReactionUsecase,
+		readStateUsecase: params.ReadStateUsecase,
+		logger:           params.Logger,
 	}
 }
 
@@ -49,15 +62,31 @@ func (h *MessageHandler) Create(ctx context.Context, req *pb.CreateRequest) (*pb
 		replyID = &parsedReplyID
 	}
 
+	var attachments []*usecase.CreateAttachmentParams
+	for _, attachment := range req.Attachments {
+		attachments = append(attachments, &usecase.CreateAttachmentParams{
+			ObjectKey:   attachment.ObjectKey,
+			Filename:    attachment.Filename,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		})
+	}
+
 	usecaseParams := &usecase.CreateParams{
-		ChannelID: channelID,
-		SenderID:  senderID,
-		Content:   req.Content,
-		ReplyID:   replyID,
+		ChannelID:   channelID,
+		SenderID:    senderID,
+		Content:     req.Content,
+		ReplyID:     replyID,
+		Attachments: attachments,
 	}
 
 	message, err := h.messageUsecase.Create(ctx, usecaseParams)
 	if err != nil {
+		var disabledErr *domain.CommunicationDisabledError
+		if errors.As(err, &disabledErr) {
+			h.logger.Warn("Create message failed: member is timed out", "channel_id", channelID, "user_id", senderID)
+			return nil, communicationDisabledError(disabledErr.Until)
+		}
 		switch err {
 		case domain.ErrInvalidMessageData:
 			h.logger.Warn("Create message failed: invalid message data")
@@ -65,26 +94,22 @@ func (h *MessageHandler) Create(ctx context.Context, req *pb.CreateRequest) (*pb
 		case domain.ErrChannelNotFound:
 			h.logger.Warn("Create message failed: channel not found or access denied", "channel_id", channelID, "user_id", senderID)
 			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Create message failed: permission denied", "channel_id", channelID, "user_id", senderID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrInvalidReplyTarget:
+			h.logger.Warn("Create message failed: invalid reply target", "reply_id", replyID, "channel_id", channelID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReplyTarget.Error())
+		case domain.ErrInvalidAttachment:
+			h.logger.Warn("Create message failed: invalid attachment", "channel_id", channelID, "user_id", senderID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidAttachment.Error())
 		default:
 			h.logger.Error("Create message failed: unexpected error", "error", err)
 			return nil, status.Error(codes.Internal, "failed to create message")
 		}
 	}
 
-	pbMessage := &pb.Message{
-		Id:        message.ID.String(),
-		ChannelId: message.ChannelID.String(),
-		SenderId:  message.SenderID.String(),
-		Content:   message.Content,
-		CreatedAt: timestamppb.New(message.CreatedAt),
-	}
-
-	if message.ReplyID != nil {
-		replyIDStr := message.ReplyID.String()
-		pbMessage.ReplyId = &replyIDStr
-	}
-
-	return &pb.CreateResponse{Message: pbMessage}, nil
+	return &pb.CreateResponse{Message: toPbMessage(message)}, nil
 }
 
 func (h *MessageHandler) GetByChannelID(ctx context.Context, req *pb.GetByChannelIDRequest) (*pb.GetByChannelIDResponse, error) {
@@ -98,9 +123,36 @@ func (h *MessageHandler) GetByChannelID(ctx context.Context, req *pb.GetByChanne
 		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
 		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
 	}
-	messages, err := h.messageUsecase.GetByChannelID(ctx, userID, channelID)
+
+	before, err := parseOptionalUUID(req.Before)
+	if err != nil {
+		h.logger.Warn("Invalid before cursor format", "before", req.GetBefore(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+	after, err := parseOptionalUUID(req.After)
+	if err != nil {
+		h.logger.Warn("Invalid after cursor format", "after", req.GetAfter(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+	around, err := parseOptionalUUID(req.Around)
+	if err != nil {
+		h.logger.Warn("Invalid around cursor format", "around", req.GetAround(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	result, err := h.messageUsecase.GetByChannelID(ctx, &usecase.GetByChannelIDParams{
+		UserID:    userID,
+		ChannelID: channelID,
+		Before:    before,
+		After:     after,
+		Around:    around,
+		Limit:     req.GetLimit(),
+	})
 	if err != nil {
 		switch err {
+		case domain.ErrInvalidMessageData:
+			h.logger.Warn("Get messages failed: invalid query", "channel_id", channelID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
 		case domain.ErrChannelNotFound:
 			h.logger.Warn("Get messages failed: channel not found or access denied", "channel_id", channelID, "user_id", userID)
 			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
@@ -110,33 +162,439 @@ func (h *MessageHandler) GetByChannelID(ctx context.Context, req *pb.GetByChanne
 		}
 	}
 
+	pbMessages := make([]*pb.Message, len(result.Messages))
+	for i, message := range result.Messages {
+		pbMessages[i] = toPbMessage(message)
+	}
+
+	res := &pb.GetByChannelIDResponse{
+		Messages: pbMessages,
+		HasMore:  result.HasMore(),
+	}
+	if result.NextBefore != nil {
+		nextBefore := result.NextBefore.String()
+		res.NextBefore = &nextBefore
+	}
+	if result.NextAfter != nil {
+		nextAfter := result.NextAfter.String()
+		res.NextAfter = &nextAfter
+	}
+
+	return res, nil
+}
+
+func (h *MessageHandler) UpdateByMessageID(ctx context.Context, req *pb.UpdateByMessageIDRequest) (*pb.UpdateByMessageIDResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	message, err := h.messageUsecase.Update(ctx, &usecase.UpdateParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Content:   req.Content,
+	})
+	if err != nil {
+		var disabledErr *domain.CommunicationDisabledError
+		if errors.As(err, &disabledErr) {
+			h.logger.Warn("Update message failed: member is timed out", "message_id", messageID, "user_id", userID)
+			return nil, communicationDisabledError(disabledErr.Until)
+		}
+		switch err {
+		case domain.ErrInvalidMessageData:
+			h.logger.Warn("Update message failed: invalid message data", "message_id", messageID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Update message failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Update message failed: not the author or cannot send messages", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Update message failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to update message")
+		}
+	}
+
+	return &pb.UpdateByMessageIDResponse{Message: toPbMessage(message)}, nil
+}
+
+func (h *MessageHandler) DeleteByMessageID(ctx context.Context, req *pb.DeleteByMessageIDRequest) (*pb.DeleteByMessageIDResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	err = h.messageUsecase.Delete(ctx, &usecase.DeleteParams{
+		MessageID: messageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Delete message failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Delete message failed: not the author", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Delete message failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to delete message")
+		}
+	}
+
+	return &pb.DeleteByMessageIDResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+// guild-serviceがBAN時に呼び出す。権限は呼び出し元で確認済み
+func (h *MessageHandler) DeleteUserMessages(ctx context.Context, req *pb.DeleteUserMessagesRequest) (*pb.DeleteUserMessagesResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidUserID.Error())
+	}
+
+	channelIDs := make([]uuid.UUID, len(req.ChannelIds))
+	for i, idStr := range req.ChannelIds {
+		channelIDs[i], err = uuid.Parse(idStr)
+		if err != nil {
+			h.logger.Warn("Invalid channel ID format", "channel_id", idStr, "error", err)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		}
+	}
+
+	if req.Since == nil {
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	deleted, err := h.messageUsecase.DeleteBySender(ctx, &usecase.DeleteBySenderParams{
+		SenderID:   userID,
+		ChannelIDs: channelIDs,
+		Since:      req.Since.AsTime(),
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMessageData:
+			h.logger.Warn("Delete user messages failed: invalid request", "user_id", userID, "channel_count", len(channelIDs))
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		default:
+			h.logger.Error("Delete user messages failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to delete user messages")
+		}
+	}
+
+	return &pb.DeleteUserMessagesResponse{DeletedCount: int32(deleted)}, nil
+}
+
+// guild-serviceがチャンネルやギルドの削除後に呼び出す。削除は呼び出し元を待たせずに裏で行う
+func (h *MessageHandler) PurgeChannelMessages(ctx context.Context, req *pb.PurgeChannelMessagesRequest) (*pb.PurgeChannelMessagesResponse, error) {
+	channelIDs := make([]uuid.UUID, len(req.ChannelIds))
+	for i, idStr := range req.ChannelIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			h.logger.Warn("Invalid channel ID format", "channel_id", idStr, "error", err)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		}
+		channelIDs[i] = id
+	}
+
+	// guild-serviceのワーカーが成功するまで再試行するので、削除が終わるまで待ってから返す。
+	// 既に消えたメッセージは対象にならないため、同じチャンネルで何度呼ばれても問題ない
+	deleted, err := h.messageUsecase.PurgeChannels(ctx, channelIDs)
+	if err != nil {
+		h.logger.Error("Purge channel messages failed", "channel_count", len(channelIDs), "deleted_count", deleted, "error", err)
+		return nil, status.Error(codes.Internal, "failed to purge channel messages")
+	}
+	h.logger.Info("Purged channel messages", "channel_count", len(channelIDs), "deleted_count", deleted)
+
+	return &pb.PurgeChannelMessagesResponse{}, nil
+}
+
+func (h *MessageHandler) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.PinMessageResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	err = h.messageUsecase.Pin(ctx, &usecase.PinParams{
+		MessageID: messageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Pin message failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Pin message failed: not the guild owner", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrTooManyPins:
+			h.logger.Warn("Pin message failed: too many pinned messages", "message_id", messageID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrTooManyPins.Error())
+		default:
+			h.logger.Error("Pin message failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to pin message")
+		}
+	}
+
+	return &pb.PinMessageResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *MessageHandler) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*pb.UnpinMessageResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	err = h.messageUsecase.Unpin(ctx, &usecase.PinParams{
+		MessageID: messageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Unpin message failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Unpin message failed: not the guild owner", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		default:
+			h.logger.Error("Unpin message failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to unpin message")
+		}
+	}
+
+	return &pb.UnpinMessageResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *MessageHandler) ListPinnedMessages(ctx context.Context, req *pb.ListPinnedMessagesRequest) (*pb.ListPinnedMessagesResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	messages, err := h.messageUsecase.GetPinned(ctx, &usecase.GetPinnedParams{
+		ChannelID: channelID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrChannelNotFound:
+			h.logger.Warn("List pinned messages failed: channel not found or access denied", "channel_id", channelID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		default:
+			h.logger.Error("List pinned messages failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list pinned messages")
+		}
+	}
+
 	pbMessages := make([]*pb.Message, len(messages))
 	for i, message := range messages {
-		pbMessage := &pb.Message{
-			Id:        message.ID.String(),
-			ChannelId: message.ChannelID.String(),
-			SenderId:  message.SenderID.String(),
-			Content:   message.Content,
-			CreatedAt: timestamppb.New(message.CreatedAt),
+		pbMessages[i] = toPbMessage(message)
+	}
+
+	return &pb.ListPinnedMessagesResponse{Messages: pbMessages}, nil
+}
+
+func (h *MessageHandler) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	guildID, err := uuid.Parse(req.GuildId)
+	if err != nil {
+		h.logger.Warn("Invalid guild ID format", "guild_id", req.GuildId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	authorID, err := parseOptionalUUID(req.AuthorId)
+	if err != nil {
+		h.logger.Warn("Invalid author ID format", "author_id", req.GetAuthorId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+	channelID, err := parseOptionalUUID(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.GetChannelId(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+	cursor, err := parseOptionalUUID(req.Cursor)
+	if err != nil {
+		h.logger.Warn("Invalid cursor format", "cursor", req.GetCursor(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	params := &usecase.SearchParams{
+		UserID:        userID,
+		GuildID:       guildID,
+		Query:         req.Query,
+		AuthorID:      authorID,
+		ChannelID:     channelID,
+		HasAttachment: req.HasAttachment,
+		Cursor:        cursor,
+		Limit:         req.GetLimit(),
+	}
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		params.Before = &before
+	}
+	if req.After != nil {
+		after := req.After.AsTime()
+		params.After = &after
+	}
+
+	result, err := h.messageUsecase.Search(ctx, params)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMessageData:
+			h.logger.Warn("Search messages failed: invalid query", "guild_id", guildID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		case domain.ErrChannelNotFound:
+			h.logger.Warn("Search messages failed: channel not found or access denied", "guild_id", guildID, "channel_id", channelID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		default:
+			h.logger.Error("Search messages failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to search messages")
 		}
+	}
 
-		if message.Sender != nil {
-			pbMessage.Sender = &pb.User{
-				Id:        message.Sender.ID.String(),
-				DisplayId: message.Sender.DisplayId,
-				Name:      message.Sender.Name,
-				IconUrl:   message.Sender.IconURL,
-				CreatedAt: timestamppb.New(message.Sender.CreatedAt),
-			}
+	pbResults := make([]*pb.SearchResult, len(result.Hits))
+	for i, hit := range result.Hits {
+		pbResults[i] = &pb.SearchResult{
+			Message: toPbMessage(hit.Message),
+			Snippet: hit.Snippet,
 		}
+	}
 
-		if message.ReplyID != nil {
-			replyIDStr := message.ReplyID.String()
-			pbMessage.ReplyId = &replyIDStr
+	res := &pb.SearchMessagesResponse{
+		Results: pbResults,
+		HasMore: result.NextCursor != nil,
+	}
+	if result.NextCursor != nil {
+		nextCursor := result.NextCursor.String()
+		res.NextCursor = &nextCursor
+	}
+
+	return res, nil
+}
+
+func toPbMessage(message *domain.Message) *pb.Message {
+	pbMessage := &pb.Message{
+		Id:        message.ID.String(),
+		ChannelId: message.ChannelID.String(),
+		SenderId:  message.SenderID.String(),
+		Content:   message.Content,
+		CreatedAt: timestamppb.New(message.CreatedAt),
+	}
+
+	if message.Sender != nil {
+		pbMessage.Sender = toPbUser(message.Sender)
+	}
+
+	if message.ReplyID != nil {
+		replyIDStr := message.ReplyID.String()
+		pbMessage.ReplyId = &replyIDStr
+	}
+
+	if message.EditedAt != nil {
+		pbMessage.EditedAt = timestamppb.New(*message.EditedAt)
+	}
+
+	if message.PinnedAt != nil {
+		pbMessage.PinnedAt = timestamppb.New(*message.PinnedAt)
+	}
+
+	if ref := message.ReferencedMessage; ref != nil {
+		pbRef := &pb.ReferencedMessage{
+			Id:      ref.ID.String(),
+			Content: ref.Content,
+			Deleted: ref.Deleted,
+		}
+		if ref.SenderID != nil {
+			senderIDStr := ref.SenderID.String()
+			pbRef.SenderId = &senderIDStr
 		}
+		if ref.Sender != nil {
+			pbRef.Sender = toPbUser(ref.Sender)
+		}
+		pbMessage.ReferencedMessage = pbRef
+	}
+
+	for _, reaction := range message.Reactions {
+		pbMessage.Reactions = append(pbMessage.Reactions, &pb.Reaction{
+			Emoji: reaction.Emoji,
+			Count: reaction.Count,
+			Me:    reaction.Me,
+		})
+	}
+
+	for _, mention := range message.Mentions {
+		pbMessage.Mentions = append(pbMessage.Mentions, &pb.Mention{
+			Type: toPbMentionType(mention.Type),
+			Id:   mention.ID.String(),
+		})
+	}
 
-		pbMessages[i] = pbMessage
+	for _, attachment := range message.Attachments {
+		pbMessage.Attachments = append(pbMessage.Attachments, &pb.Attachment{
+			Id:          attachment.ID.String(),
+			Filename:    attachment.Filename,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+			Url:         attachment.URL,
+		})
 	}
 
-	return &pb.GetByChannelIDResponse{Messages: pbMessages}, nil
+	return pbMessage
+}
+
+func toPbMentionType(mentionType domain.MentionType) pb.MentionType {
+	switch mentionType {
+	case domain.MentionTypeUser:
+		return pb.MentionType_MENTION_TYPE_USER
+	case domain.MentionTypeChannel:
+		return pb.MentionType_MENTION_TYPE_CHANNEL
+	default:
+		return pb.MentionType_MENTION_TYPE_UNSPECIFIED
+	}
+}
+
+func toPbUser(user *domain.User) *pb.User {
+	return &pb.User{
+		Id:        user.ID.String(),
+		DisplayId: user.DisplayId,
+		Name:      user.Name,
+		IconUrl:   user.IconURL,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+	}
 }
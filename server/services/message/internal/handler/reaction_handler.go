@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"message-service/internal/domain"
+	"message-service/internal/usecase"
+
+	pb "chat-app-proto/gen/message"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *MessageHandler) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+	}
+
+	err = h.reactionUsecase.Add(ctx, &usecase.AddReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     req.Emoji,
+	})
+	if err != nil {
+		var disabledErr *domain.CommunicationDisabledError
+		if errors.As(err, &disabledErr) {
+			h.logger.Warn("Add reaction failed: member is timed out", "message_id", messageID, "user_id", userID)
+			return nil, communicationDisabledError(disabledErr.Until)
+		}
+		switch err {
+		case domain.ErrInvalidReactionData:
+			h.logger.Warn("Add reaction failed: invalid reaction data", "message_id", messageID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Add reaction failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		case domain.ErrPermissionDenied:
+			h.logger.Warn("Add reaction failed: permission denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.PermissionDenied, domain.ErrPermissionDenied.Error())
+		case domain.ErrTooManyReactions:
+			h.logger.Warn("Add reaction failed: too many reactions", "message_id", messageID)
+			return nil, status.Error(codes.FailedPrecondition, domain.ErrTooManyReactions.Error())
+		default:
+			h.logger.Error("Add reaction failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to add reaction")
+		}
+	}
+
+	return &pb.AddReactionResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *MessageHandler) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+	}
+
+	err = h.reactionUsecase.Remove(ctx, &usecase.RemoveReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     req.Emoji,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidReactionData:
+			h.logger.Warn("Remove reaction failed: invalid reaction data", "message_id", messageID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Remove reaction failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		default:
+			h.logger.Error("Remove reaction failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to remove reaction")
+		}
+	}
+
+	return &pb.RemoveReactionResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *MessageHandler) ListReactors(ctx context.Context, req *pb.ListReactorsRequest) (*pb.ListReactorsResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+	}
+
+	after, err := parseOptionalUUID(req.After)
+	if err != nil {
+		h.logger.Warn("Invalid after cursor format", "after", req.GetAfter(), "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+	}
+
+	users, err := h.reactionUsecase.ListReactors(ctx, &usecase.ListReactorsParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     req.Emoji,
+		After:     after,
+		Limit:     req.GetLimit(),
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidReactionData:
+			h.logger.Warn("List reactors failed: invalid query", "message_id", messageID)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidReactionData.Error())
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("List reactors failed: message not found or access denied", "message_id", messageID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		default:
+			h.logger.Error("List reactors failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list reactors")
+		}
+	}
+
+	pbUsers := make([]*pb.User, len(users))
+	for i, user := range users {
+		pbUsers[i] = toPbUser(user)
+	}
+
+	return &pb.ListReactorsResponse{Users: pbUsers}, nil
+}
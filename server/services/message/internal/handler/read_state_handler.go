@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"message-service/internal/domain"
+	"message-service/internal/usecase"
+
+	pb "chat-app-proto/gen/message"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *MessageHandler) AckChannel(ctx context.Context, req *pb.AckChannelRequest) (*pb.AckChannelResponse, error) {
+	userID, err := getUserID(ctx, h.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(req.ChannelId)
+	if err != nil {
+		h.logger.Warn("Invalid channel ID format", "channel_id", req.ChannelId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	messageID, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		h.logger.Warn("Invalid message ID format", "message_id", req.MessageId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+	}
+
+	err = h.readStateUsecase.Ack(ctx, &usecase.AckParams{
+		UserID:    userID,
+		ChannelID: channelID,
+		MessageID: messageID,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrChannelNotFound:
+			h.logger.Warn("Ack channel failed: channel not found or access denied", "channel_id", channelID, "user_id", userID)
+			return nil, status.Error(codes.NotFound, domain.ErrChannelNotFound.Error())
+		case domain.ErrMessageNotFound:
+			h.logger.Warn("Ack channel failed: message not found", "channel_id", channelID, "message_id", messageID)
+			return nil, status.Error(codes.NotFound, domain.ErrMessageNotFound.Error())
+		default:
+			h.logger.Error("Ack channel failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to ack channel")
+		}
+	}
+
+	return &pb.AckChannelResponse{Empty: &emptypb.Empty{}}, nil
+}
+
+func (h *MessageHandler) GetUnreadCounts(ctx context.Context, req *pb.GetUnreadCountsRequest) (*pb.GetUnreadCountsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidUserID.Error())
+	}
+
+	channelIDs := make([]uuid.UUID, len(req.ChannelIds))
+	for i, idStr := range req.ChannelIds {
+		channelIDs[i], err = uuid.Parse(idStr)
+		if err != nil {
+			h.logger.Warn("Invalid channel ID format", "channel_id", idStr, "error", err)
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		}
+	}
+
+	unreads, err := h.readStateUsecase.GetUnreadCounts(ctx, &usecase.GetUnreadCountsParams{
+		UserID:     userID,
+		ChannelIDs: channelIDs,
+	})
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMessageData:
+			h.logger.Warn("Get unread counts failed: invalid request", "user_id", userID, "channel_count", len(channelIDs))
+			return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidMessageData.Error())
+		default:
+			h.logger.Error("Get unread counts failed: unexpected error", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get unread counts")
+		}
+	}
+
+	pbUnreads := make([]*pb.ChannelUnread, len(unreads))
+	for i, unread := range unreads {
+		pbUnread := &pb.ChannelUnread{
+			ChannelId:    unread.ChannelID.String(),
+			UnreadCount:  unread.UnreadCount,
+			MentionCount: unread.MentionCount,
+		}
+		if unread.LastReadMessageID != nil {
+			lastReadMessageID := unread.LastReadMessageID.String()
+			pbUnread.LastReadMessageId = &lastReadMessageID
+		}
+		pbUnreads[i] = pbUnread
+	}
+
+	return &pb.GetUnreadCountsResponse{Unreads: pbUnreads}, nil
+}
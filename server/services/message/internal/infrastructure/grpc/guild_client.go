@@ -19,15 +19,79 @@ func NewGuildServiceClient(conn *grpc.ClientConn) *guildServiceClient {
 	}
 }
 
-func (c *guildServiceClient) CheckChannelAccess(ctx context.Context, userID, channelID uuid.UUID) (bool, error) {
+func (c *guildServiceClient) GetChannelPermissions(ctx context.Context, userID, channelID uuid.UUID) (*domain.ChannelPermissions, error) {
 	resp, err := c.client.CheckChannelAccess(ctx, &pb.CheckChannelAccessRequest{
 		UserId:    userID.String(),
 		ChannelId: channelID.String(),
 	})
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return resp.HasAccess, nil
+	perms := resp.GetPermissions()
+	result := &domain.ChannelPermissions{
+		ViewChannel:    perms.GetViewChannel(),
+		SendMessages:   perms.GetSendMessages(),
+		AttachFiles:    perms.GetAttachFiles(),
+		AddReactions:   perms.GetAddReactions(),
+		ManageMessages: perms.GetManageMessages(),
+	}
+	if resp.CommunicationDisabledUntil != nil {
+		until := resp.CommunicationDisabledUntil.AsTime()
+		result.CommunicationDisabledUntil = &until
+	}
+	return result, nil
+}
+
+func (c *guildServiceClient) FilterMentionTargets(ctx context.Context, channelID uuid.UUID, userIDs, channelIDs []uuid.UUID) ([]uuid.UUID, []uuid.UUID, error) {
+	resp, err := c.client.FilterMentionTargets(ctx, &pb.FilterMentionTargetsRequest{
+		ChannelId:  channelID.String(),
+		UserIds:    toStrings(userIDs),
+		ChannelIds: toStrings(channelIDs),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validUserIDs, err := parseUUIDs(resp.UserIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	validChannelIDs, err := parseUUIDs(resp.ChannelIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	return validUserIDs, validChannelIDs, nil
+}
+
+func (c *guildServiceClient) ListAccessibleChannelIDs(ctx context.Context, userID, guildID uuid.UUID) ([]uuid.UUID, error) {
+	resp, err := c.client.ListAccessibleChannelIDs(ctx, &pb.ListAccessibleChannelIDsRequest{
+		UserId:  userID.String(),
+		GuildId: guildID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseUUIDs(resp.ChannelIds)
+}
+
+func toStrings(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return strs
+}
+
+func parseUUIDs(strs []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(strs))
+	for i, s := range strs {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
 }
 
 var _ domain.IGuildService = (*guildServiceClient)(nil)
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	pb "chat-app-proto/gen/media"
+	"context"
+	"message-service/internal/domain"
+
+	"google.golang.org/grpc"
+)
+
+type mediaServiceClient struct {
+	client pb.MediaServiceClient
+}
+
+func NewMediaServiceClient(conn *grpc.ClientConn) *mediaServiceClient {
+	return &mediaServiceClient{
+		client: pb.NewMediaServiceClient(conn),
+	}
+}
+
+func (c *mediaServiceClient) StatObjects(ctx context.Context, objectKeys []string) ([]*domain.ObjectStat, error) {
+	res, err := c.client.StatObjects(ctx, &pb.StatObjectsRequest{
+		ObjectKeys: objectKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*domain.ObjectStat, len(res.Objects))
+	for i, object := range res.Objects {
+		stats[i] = &domain.ObjectStat{
+			ObjectKey:   object.ObjectKey,
+			Exists:      object.Exists,
+			Size:        object.Size,
+			ContentType: object.ContentType,
+			URL:         object.Url,
+		}
+	}
+	return stats, nil
+}
+
+var _ domain.IMediaService = (*mediaServiceClient)(nil)
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"message-service/internal/domain"
+	"message-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const uniqueViolationCode = "23505"
+
+type attachmentRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresAttachmentRepository(queries *gen.Queries) *attachmentRepository {
+	return &attachmentRepository{
+		queries: queries,
+	}
+}
+
+func (r *attachmentRepository) CreateMany(ctx context.Context, attachments []*domain.Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	params := make([]gen.CreateAttachmentsParams, len(attachments))
+	for i, attachment := range attachments {
+		params[i] = gen.CreateAttachmentsParams{
+			ID:          attachment.ID,
+			MessageID:   attachment.MessageID,
+			ObjectKey:   attachment.ObjectKey,
+			Filename:    attachment.Filename,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+			Url:         attachment.URL,
+			Position:    int32(i),
+			CreatedAt:   pgtype.Timestamp{Time: attachment.CreatedAt, Valid: true},
+		}
+	}
+	if _, err := r.queries.CreateAttachments(ctx, params); err != nil {
+		// 同じオブジェクトを複数のメッセージに添付することはできない
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.ErrInvalidAttachment
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *attachmentRepository) GetByMessageIDs(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+	rows, err := r.queries.GetAttachmentsByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make(map[uuid.UUID][]*domain.Attachment)
+	for _, row := range rows {
+		attachments[row.MessageID] = append(attachments[row.MessageID], &domain.Attachment{
+			ID:          row.ID,
+			MessageID:   row.MessageID,
+			ObjectKey:   row.ObjectKey,
+			Filename:    row.Filename,
+			Size:        row.Size,
+			ContentType: row.ContentType,
+			URL:         row.Url,
+		})
+	}
+	return attachments, nil
+}
+
+var _ domain.IAttachmentRepository = (*attachmentRepository)(nil)
@@ -0,0 +1,70 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: attachment.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateAttachmentsParams struct {
+	ID          uuid.UUID
+	MessageID   uuid.UUID
+	ObjectKey   string
+	Filename    string
+	Size        int64
+	ContentType string
+	Url         string
+	Position    int32
+	CreatedAt   pgtype.Timestamp
+}
+
+const getAttachmentsByMessageIDs = `-- name: GetAttachmentsByMessageIDs :many
+SELECT id, message_id, object_key, filename, size, content_type, url
+FROM message_attachments
+WHERE message_id = ANY($1::uuid[])
+ORDER BY message_id, position
+`
+
+type GetAttachmentsByMessageIDsRow struct {
+	ID          uuid.UUID
+	MessageID   uuid.UUID
+	ObjectKey   string
+	Filename    string
+	Size        int64
+	ContentType string
+	Url         string
+}
+
+func (q *Queries) GetAttachmentsByMessageIDs(ctx context.Context, messageIds []uuid.UUID) ([]*GetAttachmentsByMessageIDsRow, error) {
+	rows, err := q.db.Query(ctx, getAttachmentsByMessageIDs, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetAttachmentsByMessageIDsRow
+	for rows.Next() {
+		var i GetAttachmentsByMessageIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.ObjectKey,
+			&i.Filename,
+			&i.Size,
+			&i.ContentType,
+			&i.Url,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: copyfrom.go
+
+package gen
+
+import (
+	"context"
+)
+
+// iteratorForCreateAttachments implements pgx.CopyFromSource.
+type iteratorForCreateAttachments struct {
+	rows                 []CreateAttachmentsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateAttachments) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateAttachments) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].MessageID,
+		r.rows[0].ObjectKey,
+		r.rows[0].Filename,
+		r.rows[0].Size,
+		r.rows[0].ContentType,
+		r.rows[0].Url,
+		r.rows[0].Position,
+		r.rows[0].CreatedAt,
+	}, nil
+}
+
+func (r iteratorForCreateAttachments) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateAttachments(ctx context.Context, arg []CreateAttachmentsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"message_attachments"}, []string{"id", "message_id", "object_key", "filename", "size", "content_type", "url", "position", "created_at"}, &iteratorForCreateAttachments{rows: arg})
+}
+
+// iteratorForCreateMentions implements pgx.CopyFromSource.
+type iteratorForCreateMentions struct {
+	rows                 []CreateMentionsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateMentions) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateMentions) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].MessageID,
+		r.rows[0].Type,
+		r.rows[0].TargetID,
+		r.rows[0].Position,
+	}, nil
+}
+
+func (r iteratorForCreateMentions) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateMentions(ctx context.Context, arg []CreateMentionsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"message_mentions"}, []string{"message_id", "type", "target_id", "position"}, &iteratorForCreateMentions{rows: arg})
+}
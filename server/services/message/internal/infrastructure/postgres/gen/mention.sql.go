@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mention.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CreateMentionsParams struct {
+	MessageID uuid.UUID
+	Type      string
+	TargetID  uuid.UUID
+	Position  int32
+}
+
+const deleteMentionsByMessageID = `-- name: DeleteMentionsByMessageID :exec
+DELETE FROM message_mentions
+WHERE message_id = $1
+`
+
+func (q *Queries) DeleteMentionsByMessageID(ctx context.Context, messageID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMentionsByMessageID, messageID)
+	return err
+}
+
+const getMentionsByMessageIDs = `-- name: GetMentionsByMessageIDs :many
+SELECT message_id, type, target_id, position
+FROM message_mentions
+WHERE message_id = ANY($1::uuid[])
+ORDER BY message_id, position, type, target_id
+`
+
+// 本文に出てきた順に並べる。positionを持たない古い行は種類とIDの順になる
+func (q *Queries) GetMentionsByMessageIDs(ctx context.Context, messageIds []uuid.UUID) ([]*MessageMention, error) {
+	rows, err := q.db.Query(ctx, getMentionsByMessageIDs, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MessageMention
+	for rows.Next() {
+		var i MessageMention
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.Type,
+			&i.TargetID,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
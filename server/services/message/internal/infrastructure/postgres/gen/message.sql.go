@@ -12,6 +12,19 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countPinnedMessagesByChannelID = `-- name: CountPinnedMessagesByChannelID :one
+SELECT COUNT(*)::int
+FROM messages
+WHERE channel_id = $1 AND pinned_at IS NOT NULL
+`
+
+func (q *Queries) CountPinnedMessagesByChannelID(ctx context.Context, channelID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countPinnedMessagesByChannelID, channelID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const createMessage = `-- name: CreateMessage :one
 INSERT INTO messages (id, channel_id, sender_id, content, reply_id, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5, $6, NOW())
@@ -57,31 +70,361 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (*
 	return &i, err
 }
 
-const getMessagesByChannelID = `-- name: GetMessagesByChannelID :many
-SELECT id, channel_id, sender_id, content, reply_id, created_at
+const deleteMessage = `-- name: DeleteMessage :exec
+DELETE FROM messages
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMessage(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMessage, id)
+	return err
+}
+
+const deleteMessagesByChannelIDs = `-- name: DeleteMessagesByChannelIDs :execrows
+DELETE FROM messages
+WHERE id IN (
+  SELECT id FROM messages
+  WHERE channel_id = ANY($1::uuid[])
+  LIMIT $2
+)
+`
+
+type DeleteMessagesByChannelIDsParams struct {
+	ChannelIds []uuid.UUID
+	RowLimit   int32
+}
+
+func (q *Queries) DeleteMessagesByChannelIDs(ctx context.Context, arg DeleteMessagesByChannelIDsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMessagesByChannelIDs, arg.ChannelIds, arg.RowLimit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMessagesBySenderSince = `-- name: DeleteMessagesBySenderSince :many
+DELETE FROM messages
+WHERE sender_id = $1
+  AND channel_id = ANY($2::uuid[])
+  AND created_at >= $3
+RETURNING id, channel_id
+`
+
+type DeleteMessagesBySenderSinceParams struct {
+	SenderID   uuid.UUID
+	ChannelIds []uuid.UUID
+	Since      pgtype.Timestamp
+}
+
+type DeleteMessagesBySenderSinceRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+}
+
+func (q *Queries) DeleteMessagesBySenderSince(ctx context.Context, arg DeleteMessagesBySenderSinceParams) ([]*DeleteMessagesBySenderSinceRow, error) {
+	rows, err := q.db.Query(ctx, deleteMessagesBySenderSince, arg.SenderID, arg.ChannelIds, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeleteMessagesBySenderSinceRow
+	for rows.Next() {
+		var i DeleteMessagesBySenderSinceRow
+		if err := rows.Scan(&i.ID, &i.ChannelID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestMessagesByChannelID = `-- name: GetLatestMessagesByChannelID :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
 FROM messages
 WHERE channel_id = $1
-ORDER BY created_at ASC
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type GetLatestMessagesByChannelIDParams struct {
+	ChannelID uuid.UUID
+	RowLimit  int32
+}
+
+type GetLatestMessagesByChannelIDRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+func (q *Queries) GetLatestMessagesByChannelID(ctx context.Context, arg GetLatestMessagesByChannelIDParams) ([]*GetLatestMessagesByChannelIDRow, error) {
+	rows, err := q.db.Query(ctx, getLatestMessagesByChannelID, arg.ChannelID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetLatestMessagesByChannelIDRow
+	for rows.Next() {
+		var i GetLatestMessagesByChannelIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.SenderID,
+			&i.Content,
+			&i.ReplyID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessageByID = `-- name: GetMessageByID :one
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE id = $1
+`
+
+type GetMessageByIDRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+func (q *Queries) GetMessageByID(ctx context.Context, id uuid.UUID) (*GetMessageByIDRow, error) {
+	row := q.db.QueryRow(ctx, getMessageByID, id)
+	var i GetMessageByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.SenderID,
+		&i.Content,
+		&i.ReplyID,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.PinnedAt,
+	)
+	return &i, err
+}
+
+const getMessagesAfterCursor = `-- name: GetMessagesAfterCursor :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = $1
+  AND created_at >= $2
+  AND (created_at, id) > ($2::timestamp, $3::uuid)
+ORDER BY created_at ASC, id ASC
+LIMIT $4
+`
+
+type GetMessagesAfterCursorParams struct {
+	ChannelID       uuid.UUID
+	CursorCreatedAt pgtype.Timestamp
+	CursorID        uuid.UUID
+	RowLimit        int32
+}
+
+type GetMessagesAfterCursorRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+func (q *Queries) GetMessagesAfterCursor(ctx context.Context, arg GetMessagesAfterCursorParams) ([]*GetMessagesAfterCursorRow, error) {
+	rows, err := q.db.Query(ctx, getMessagesAfterCursor,
+		arg.ChannelID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetMessagesAfterCursorRow
+	for rows.Next() {
+		var i GetMessagesAfterCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.SenderID,
+			&i.Content,
+			&i.ReplyID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesBeforeCursor = `-- name: GetMessagesBeforeCursor :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = $1
+  AND created_at <= $2
+  AND (created_at, id) < ($2::timestamp, $3::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type GetMessagesBeforeCursorParams struct {
+	ChannelID       uuid.UUID
+	CursorCreatedAt pgtype.Timestamp
+	CursorID        uuid.UUID
+	RowLimit        int32
+}
+
+type GetMessagesBeforeCursorRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+// created_atの範囲条件でidx_channel_created_atを使い、同時刻のメッセージはidで順序を安定させる
+func (q *Queries) GetMessagesBeforeCursor(ctx context.Context, arg GetMessagesBeforeCursorParams) ([]*GetMessagesBeforeCursorRow, error) {
+	rows, err := q.db.Query(ctx, getMessagesBeforeCursor,
+		arg.ChannelID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetMessagesBeforeCursorRow
+	for rows.Next() {
+		var i GetMessagesBeforeCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.SenderID,
+			&i.Content,
+			&i.ReplyID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessagesByIDs = `-- name: GetMessagesByIDs :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE id = ANY($1::uuid[])
+`
+
+type GetMessagesByIDsRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+func (q *Queries) GetMessagesByIDs(ctx context.Context, ids []uuid.UUID) ([]*GetMessagesByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getMessagesByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetMessagesByIDsRow
+	for rows.Next() {
+		var i GetMessagesByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.SenderID,
+			&i.Content,
+			&i.ReplyID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPinnedMessagesByChannelID = `-- name: GetPinnedMessagesByChannelID :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = $1 AND pinned_at IS NOT NULL
+ORDER BY pinned_at DESC
 `
 
-type GetMessagesByChannelIDRow struct {
+type GetPinnedMessagesByChannelIDRow struct {
 	ID        uuid.UUID
 	ChannelID uuid.UUID
 	SenderID  uuid.UUID
 	Content   string
 	ReplyID   *uuid.UUID
 	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
 }
 
-func (q *Queries) GetMessagesByChannelID(ctx context.Context, channelID uuid.UUID) ([]*GetMessagesByChannelIDRow, error) {
-	rows, err := q.db.Query(ctx, getMessagesByChannelID, channelID)
+func (q *Queries) GetPinnedMessagesByChannelID(ctx context.Context, channelID uuid.UUID) ([]*GetPinnedMessagesByChannelIDRow, error) {
+	rows, err := q.db.Query(ctx, getPinnedMessagesByChannelID, channelID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*GetMessagesByChannelIDRow
+	var items []*GetPinnedMessagesByChannelIDRow
 	for rows.Next() {
-		var i GetMessagesByChannelIDRow
+		var i GetPinnedMessagesByChannelIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.ChannelID,
@@ -89,6 +432,8 @@ func (q *Queries) GetMessagesByChannelID(ctx context.Context, channelID uuid.UUI
 			&i.Content,
 			&i.ReplyID,
 			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -99,3 +444,153 @@ func (q *Queries) GetMessagesByChannelID(ctx context.Context, channelID uuid.UUI
 	}
 	return items, nil
 }
+
+const lockChannelPins = `-- name: LockChannelPins :exec
+SELECT pg_advisory_xact_lock(hashtextextended('message_pins:' || CAST($1::uuid AS text), 0))
+`
+
+// チャンネルの行はこのサービスにないので、チャンネルIDをキーにしたアドバイザリロックを取る
+func (q *Queries) LockChannelPins(ctx context.Context, channelID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockChannelPins, channelID)
+	return err
+}
+
+const searchMessages = `-- name: SearchMessages :many
+SELECT m.id, m.channel_id, m.sender_id, m.content, m.reply_id, m.created_at, m.edited_at, m.pinned_at,
+  ts_headline('simple', translate(m.content, chr(2) || chr(3), ''), websearch_to_tsquery('simple', $1::text),
+    'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=35, MinWords=15, MaxFragments=2')::text AS snippet
+FROM messages m
+WHERE m.channel_id = ANY($2::uuid[])
+  AND m.content_tsv @@ websearch_to_tsquery('simple', $1::text)
+  AND ($3::uuid IS NULL OR m.sender_id = $3::uuid)
+  AND ($4::timestamp IS NULL OR m.created_at < $4::timestamp)
+  AND ($5::timestamp IS NULL OR m.created_at > $5::timestamp)
+  AND ($6::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM message_attachments a WHERE a.message_id = m.id) = $6::boolean)
+  AND ($7::uuid IS NULL
+    OR (m.created_at, m.id) < ($8::timestamp, $7::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $9
+`
+
+type SearchMessagesParams struct {
+	Query           string
+	ChannelIds      []uuid.UUID
+	SenderID        *uuid.UUID
+	Before          pgtype.Timestamp
+	After           pgtype.Timestamp
+	HasAttachment   *bool
+	CursorID        *uuid.UUID
+	CursorCreatedAt pgtype.Timestamp
+	RowLimit        int32
+}
+
+type SearchMessagesRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+	Snippet   string
+}
+
+// ts_headlineの強調部分は制御文字で囲み、HTMLエスケープ後に<mark>へ置き換える。
+// 本文に同じ制御文字が含まれていると<mark>を差し込めてしまうので、先に取り除いておく
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]*SearchMessagesRow, error) {
+	rows, err := q.db.Query(ctx, searchMessages,
+		arg.Query,
+		arg.ChannelIds,
+		arg.SenderID,
+		arg.Before,
+		arg.After,
+		arg.HasAttachment,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SearchMessagesRow
+	for rows.Next() {
+		var i SearchMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.SenderID,
+			&i.Content,
+			&i.ReplyID,
+			&i.CreatedAt,
+			&i.EditedAt,
+			&i.PinnedAt,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateMessageContent = `-- name: UpdateMessageContent :one
+UPDATE messages
+SET content = $2, edited_at = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+`
+
+type UpdateMessageContentParams struct {
+	ID       uuid.UUID
+	Content  string
+	EditedAt pgtype.Timestamp
+}
+
+type UpdateMessageContentRow struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	SenderID  uuid.UUID
+	Content   string
+	ReplyID   *uuid.UUID
+	CreatedAt pgtype.Timestamp
+	EditedAt  pgtype.Timestamp
+	PinnedAt  pgtype.Timestamp
+}
+
+func (q *Queries) UpdateMessageContent(ctx context.Context, arg UpdateMessageContentParams) (*UpdateMessageContentRow, error) {
+	row := q.db.QueryRow(ctx, updateMessageContent, arg.ID, arg.Content, arg.EditedAt)
+	var i UpdateMessageContentRow
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.SenderID,
+		&i.Content,
+		&i.ReplyID,
+		&i.CreatedAt,
+		&i.EditedAt,
+		&i.PinnedAt,
+	)
+	return &i, err
+}
+
+const updateMessagePinnedAt = `-- name: UpdateMessagePinnedAt :exec
+UPDATE messages
+SET pinned_at = $2
+WHERE id = $1
+`
+
+type UpdateMessagePinnedAtParams struct {
+	ID       uuid.UUID
+	PinnedAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateMessagePinnedAt(ctx context.Context, arg UpdateMessagePinnedAtParams) error {
+	_, err := q.db.Exec(ctx, updateMessagePinnedAt, arg.ID, arg.PinnedAt)
+	return err
+}
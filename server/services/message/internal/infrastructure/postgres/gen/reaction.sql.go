@@ -0,0 +1,156 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reaction.sql
+
+package gen
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createReaction = `-- name: CreateReaction :execrows
+INSERT INTO message_reactions (message_id, user_id, emoji, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+`
+
+type CreateReactionParams struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+	Emoji     string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateReaction(ctx context.Context, arg CreateReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createReaction,
+		arg.MessageID,
+		arg.UserID,
+		arg.Emoji,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReaction = `-- name: DeleteReaction :execrows
+DELETE FROM message_reactions
+WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+`
+
+type DeleteReactionParams struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+	Emoji     string
+}
+
+func (q *Queries) DeleteReaction(ctx context.Context, arg DeleteReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteReaction, arg.MessageID, arg.UserID, arg.Emoji)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getReactionCountsByMessageIDs = `-- name: GetReactionCountsByMessageIDs :many
+SELECT message_id, emoji, COUNT(*)::int AS count, BOOL_OR(user_id = $1::uuid)::boolean AS me
+FROM message_reactions
+WHERE message_id = ANY($2::uuid[])
+GROUP BY message_id, emoji
+ORDER BY message_id, MIN(created_at)
+`
+
+type GetReactionCountsByMessageIDsParams struct {
+	UserID     uuid.UUID
+	MessageIds []uuid.UUID
+}
+
+type GetReactionCountsByMessageIDsRow struct {
+	MessageID uuid.UUID
+	Emoji     string
+	Count     int32
+	Me        bool
+}
+
+// 絵文字ごとに集計し、最初にリアクションされた順に並べる
+func (q *Queries) GetReactionCountsByMessageIDs(ctx context.Context, arg GetReactionCountsByMessageIDsParams) ([]*GetReactionCountsByMessageIDsRow, error) {
+	rows, err := q.db.Query(ctx, getReactionCountsByMessageIDs, arg.UserID, arg.MessageIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetReactionCountsByMessageIDsRow
+	for rows.Next() {
+		var i GetReactionCountsByMessageIDsRow
+		if err := rows.Scan(
+			&i.MessageID,
+			&i.Emoji,
+			&i.Count,
+			&i.Me,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReactorIDs = `-- name: GetReactorIDs :many
+SELECT user_id
+FROM message_reactions
+WHERE message_id = $1
+  AND emoji = $2
+  AND ($3::uuid IS NULL OR user_id > $3::uuid)
+ORDER BY user_id ASC
+LIMIT $4
+`
+
+type GetReactorIDsParams struct {
+	MessageID   uuid.UUID
+	Emoji       string
+	AfterUserID *uuid.UUID
+	RowLimit    int32
+}
+
+func (q *Queries) GetReactorIDs(ctx context.Context, arg GetReactorIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getReactorIDs,
+		arg.MessageID,
+		arg.Emoji,
+		arg.AfterUserID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockMessageReactions = `-- name: LockMessageReactions :exec
+SELECT pg_advisory_xact_lock(hashtextextended('message_reactions:' || CAST($1::uuid AS text), 0))
+`
+
+// 絵文字の種類を数えてから追加するまでの間、同じメッセージへのリアクションを直列にする
+func (q *Queries) LockMessageReactions(ctx context.Context, messageID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockMessageReactions, messageID)
+	return err
+}
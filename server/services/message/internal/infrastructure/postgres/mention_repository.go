@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"message-service/internal/domain"
+	"message-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+)
+
+type mentionRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresMentionRepository(queries *gen.Queries) *mentionRepository {
+	return &mentionRepository{
+		queries: queries,
+	}
+}
+
+func (r *mentionRepository) CreateMany(ctx context.Context, messageID uuid.UUID, mentions []*domain.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	params := make([]gen.CreateMentionsParams, len(mentions))
+	for i, mention := range mentions {
+		params[i] = gen.CreateMentionsParams{
+			MessageID: messageID,
+			Type:      string(mention.Type),
+			TargetID:  mention.ID,
+			Position:  mention.Position,
+		}
+	}
+	_, err := r.queries.CreateMentions(ctx, params)
+	return err
+}
+
+func (r *mentionRepository) DeleteByMessageID(ctx context.Context, messageID uuid.UUID) error {
+	return r.queries.DeleteMentionsByMessageID(ctx, messageID)
+}
+
+func (r *mentionRepository) GetByMessageIDs(ctx context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]*domain.Mention, error) {
+	rows, err := r.queries.GetMentionsByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions := make(map[uuid.UUID][]*domain.Mention)
+	for _, row := range rows {
+		mentions[row.MessageID] = append(mentions[row.MessageID], &domain.Mention{
+			Type:     domain.MentionType(row.Type),
+			ID:       row.TargetID,
+			Position: row.Position,
+		})
+	}
+	return mentions, nil
+}
+
+var _ domain.IMentionRepository = (*mentionRepository)(nil)
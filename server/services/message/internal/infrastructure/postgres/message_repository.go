@@ -2,10 +2,14 @@ package postgres
 
 import (
 	"context"
+	"html"
 	"message-service/internal/domain"
 	"message-service/internal/infrastructure/postgres/gen"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -41,24 +45,236 @@ func (r *messageRepository) Create(ctx context.Context, message *domain.Message)
 	}, nil
 }
 
-func (r *messageRepository) GetByChannelID(ctx context.Context, channelID uuid.UUID) ([]*domain.Message, error) {
-	dbMessages, err := r.queries.GetMessagesByChannelID(ctx, channelID)
+func (r *messageRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Message, error) {
+	dbMessage, err := r.queries.GetMessageByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return toDomainMessage(dbMessage), nil
+}
+
+func (r *messageRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Message, error) {
+	dbMessages, err := r.queries.GetMessagesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*domain.Message, len(dbMessages))
+	for i, dbMessage := range dbMessages {
+		messages[i] = toDomainMessage((*gen.GetMessageByIDRow)(dbMessage))
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) GetByChannelID(ctx context.Context, channelID uuid.UUID, limit int32) ([]*domain.Message, error) {
+	dbMessages, err := r.queries.GetLatestMessagesByChannelID(ctx, gen.GetLatestMessagesByChannelIDParams{
+		ChannelID: channelID,
+		RowLimit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*domain.Message, len(dbMessages))
+	for i, dbMessage := range dbMessages {
+		messages[i] = toDomainMessage((*gen.GetMessageByIDRow)(dbMessage))
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) GetByChannelIDBefore(ctx context.Context, channelID uuid.UUID, cursor *domain.Message, limit int32) ([]*domain.Message, error) {
+	dbMessages, err := r.queries.GetMessagesBeforeCursor(ctx, gen.GetMessagesBeforeCursorParams{
+		ChannelID:       channelID,
+		CursorCreatedAt: pgtype.Timestamp{Time: cursor.CreatedAt, Valid: true},
+		CursorID:        cursor.ID,
+		RowLimit:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*domain.Message, len(dbMessages))
+	for i, dbMessage := range dbMessages {
+		messages[i] = toDomainMessage((*gen.GetMessageByIDRow)(dbMessage))
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) GetByChannelIDAfter(ctx context.Context, channelID uuid.UUID, cursor *domain.Message, limit int32) ([]*domain.Message, error) {
+	dbMessages, err := r.queries.GetMessagesAfterCursor(ctx, gen.GetMessagesAfterCursorParams{
+		ChannelID:       channelID,
+		CursorCreatedAt: pgtype.Timestamp{Time: cursor.CreatedAt, Valid: true},
+		CursorID:        cursor.ID,
+		RowLimit:        limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	messages := make([]*domain.Message, len(dbMessages))
 	for i, dbMessage := range dbMessages {
+		messages[i] = toDomainMessage((*gen.GetMessageByIDRow)(dbMessage))
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) Update(ctx context.Context, message *domain.Message) (*domain.Message, error) {
+	dbMessage, err := r.queries.UpdateMessageContent(ctx, gen.UpdateMessageContentParams{
+		ID:       message.ID,
+		Content:  message.Content,
+		EditedAt: toPgTimestamp(message.EditedAt),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return toDomainMessage((*gen.GetMessageByIDRow)(dbMessage)), nil
+}
+
+func (r *messageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteMessage(ctx, id)
+}
+
+func (r *messageRepository) DeleteBySenderSince(ctx context.Context, senderID uuid.UUID, channelIDs []uuid.UUID, since time.Time) ([]*domain.Message, error) {
+	rows, err := r.queries.DeleteMessagesBySenderSince(ctx, gen.DeleteMessagesBySenderSinceParams{
+		SenderID:   senderID,
+		ChannelIds: channelIDs,
+		Since:      toPgTimestamp(&since),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*domain.Message, len(rows))
+	for i, row := range rows {
 		messages[i] = &domain.Message{
-			ID:        dbMessage.ID,
-			ChannelID: dbMessage.ChannelID,
-			SenderID:  dbMessage.SenderID,
-			Content:   dbMessage.Content,
-			ReplyID:   dbMessage.ReplyID,
-			CreatedAt: dbMessage.CreatedAt.Time,
+			ID:        row.ID,
+			ChannelID: row.ChannelID,
+			SenderID:  senderID,
 		}
 	}
 	return messages, nil
 }
 
+func (r *messageRepository) DeleteByChannelIDs(ctx context.Context, channelIDs []uuid.UUID, limit int32) (int64, error) {
+	return r.queries.DeleteMessagesByChannelIDs(ctx, gen.DeleteMessagesByChannelIDsParams{
+		ChannelIds: channelIDs,
+		RowLimit:   limit,
+	})
+}
+
+func (r *messageRepository) UpdatePinnedAt(ctx context.Context, id uuid.UUID, pinnedAt *time.Time) error {
+	return r.queries.UpdateMessagePinnedAt(ctx, gen.UpdateMessagePinnedAtParams{
+		ID:       id,
+		PinnedAt: toPgTimestamp(pinnedAt),
+	})
+}
+
+func (r *messageRepository) GetPinnedByChannelID(ctx context.Context, channelID uuid.UUID) ([]*domain.Message, error) {
+	dbMessages, err := r.queries.GetPinnedMessagesByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*domain.Message, len(dbMessages))
+	for i, dbMessage := range dbMessages {
+		messages[i] = toDomainMessage((*gen.GetMessageByIDRow)(dbMessage))
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) CountPinnedByChannelID(ctx context.Context, channelID uuid.UUID) (int, error) {
+	count, err := r.queries.CountPinnedMessagesByChannelID(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *messageRepository) LockPinsByChannelID(ctx context.Context, channelID uuid.UUID) error {
+	return r.queries.LockChannelPins(ctx, channelID)
+}
+
+func (r *messageRepository) Search(ctx context.Context, query *domain.MessageSearchQuery) ([]*domain.MessageSearchHit, error) {
+	params := gen.SearchMessagesParams{
+		Query:         query.Query,
+		ChannelIds:    query.ChannelIDs,
+		SenderID:      query.SenderID,
+		Before:        toPgTimestamp(query.Before),
+		After:         toPgTimestamp(query.After),
+		HasAttachment: query.HasAttachment,
+		RowLimit:      query.Limit,
+	}
+	if query.Cursor != nil {
+		params.CursorID = &query.Cursor.ID
+		params.CursorCreatedAt = pgtype.Timestamp{Time: query.Cursor.CreatedAt, Valid: true}
+	}
+
+	rows, err := r.queries.SearchMessages(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*domain.MessageSearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = &domain.MessageSearchHit{
+			Message: &domain.Message{
+				ID:        row.ID,
+				ChannelID: row.ChannelID,
+				SenderID:  row.SenderID,
+				Content:   row.Content,
+				ReplyID:   row.ReplyID,
+				CreatedAt: row.CreatedAt.Time,
+				EditedAt:  toTimePtr(row.EditedAt),
+				PinnedAt:  toTimePtr(row.PinnedAt),
+			},
+			Snippet: toHighlightedSnippet(row.Snippet),
+		}
+	}
+	return hits, nil
+}
+
+// ts_headlineは本文をエスケープしないため、先にエスケープしてから制御文字を<mark>に置き換える。
+// 本文に含まれていた制御文字はクエリで取り除いているので、ここに残るのはts_headlineが付けたものだけ
+func toHighlightedSnippet(headline string) string {
+	return strings.NewReplacer(
+		"\x02", domain.SearchHighlightStart,
+		"\x03", domain.SearchHighlightEnd,
+	).Replace(html.EscapeString(headline))
+}
+
+// メッセージを取得するクエリはすべて同じカラムを返すので、GetMessageByIDRowに変換して共通化する
+func toDomainMessage(dbMessage *gen.GetMessageByIDRow) *domain.Message {
+	return &domain.Message{
+		ID:        dbMessage.ID,
+		ChannelID: dbMessage.ChannelID,
+		SenderID:  dbMessage.SenderID,
+		Content:   dbMessage.Content,
+		ReplyID:   dbMessage.ReplyID,
+		CreatedAt: dbMessage.CreatedAt.Time,
+		EditedAt:  toTimePtr(dbMessage.EditedAt),
+		PinnedAt:  toTimePtr(dbMessage.PinnedAt),
+	}
+}
+
+func toTimePtr(ts pgtype.Timestamp) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
+
+func toPgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: *t, Valid: true}
+}
+
 var _ domain.IMessageRepository = (*messageRepository)(nil)
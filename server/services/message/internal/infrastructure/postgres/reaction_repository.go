@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"message-service/internal/domain"
+	"message-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type reactionRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresReactionRepository(queries *gen.Queries) *reactionRepository {
+	return &reactionRepository{
+		queries: queries,
+	}
+}
+
+func (r *reactionRepository) Create(ctx context.Context, reaction *domain.Reaction) (bool, error) {
+	rows, err := r.queries.CreateReaction(ctx, gen.CreateReactionParams{
+		MessageID: reaction.MessageID,
+		UserID:    reaction.UserID,
+		Emoji:     reaction.Emoji,
+		CreatedAt: pgtype.Timestamp{Time: reaction.CreatedAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *reactionRepository) LockByMessageID(ctx context.Context, messageID uuid.UUID) error {
+	return r.queries.LockMessageReactions(ctx, messageID)
+}
+
+func (r *reactionRepository) Delete(ctx context.Context, messageID, userID uuid.UUID, emoji string) (bool, error) {
+	rows, err := r.queries.DeleteReaction(ctx, gen.DeleteReactionParams{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     emoji,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *reactionRepository) GetCountsByMessageIDs(ctx context.Context, messageIDs []uuid.UUID, userID uuid.UUID) (map[uuid.UUID][]*domain.ReactionCount, error) {
+	rows, err := r.queries.GetReactionCountsByMessageIDs(ctx, gen.GetReactionCountsByMessageIDsParams{
+		UserID:     userID,
+		MessageIds: messageIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID][]*domain.ReactionCount)
+	for _, row := range rows {
+		counts[row.MessageID] = append(counts[row.MessageID], &domain.ReactionCount{
+			Emoji: row.Emoji,
+			Count: row.Count,
+			Me:    row.Me,
+		})
+	}
+	return counts, nil
+}
+
+func (r *reactionRepository) GetReactorIDs(ctx context.Context, messageID uuid.UUID, emoji string, after *uuid.UUID, limit int32) ([]uuid.UUID, error) {
+	return r.queries.GetReactorIDs(ctx, gen.GetReactorIDsParams{
+		MessageID:   messageID,
+		Emoji:       emoji,
+		AfterUserID: after,
+		RowLimit:    limit,
+	})
+}
+
+var _ domain.IReactionRepository = (*reactionRepository)(nil)
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"message-service/internal/domain"
+	"message-service/internal/infrastructure/postgres/gen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type readStateRepository struct {
+	queries *gen.Queries
+}
+
+func NewPostgresReadStateRepository(queries *gen.Queries) *readStateRepository {
+	return &readStateRepository{
+		queries: queries,
+	}
+}
+
+func (r *readStateRepository) Upsert(ctx context.Context, state *domain.ReadState) (bool, error) {
+	rows, err := r.queries.UpsertReadState(ctx, gen.UpsertReadStateParams{
+		UserID:            state.UserID,
+		ChannelID:         state.ChannelID,
+		LastReadMessageID: state.LastReadMessageID,
+		LastReadAt:        pgtype.Timestamp{Time: state.LastReadAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *readStateRepository) GetUnreadCounts(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]*domain.ChannelUnread, error) {
+	rows, err := r.queries.GetUnreadCounts(ctx, gen.GetUnreadCountsParams{
+		UserID:     userID,
+		MaxCount:   domain.MaxUnreadCount,
+		ChannelIds: channelIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unreads := make([]*domain.ChannelUnread, len(rows))
+	for i, row := range rows {
+		unreads[i] = &domain.ChannelUnread{
+			ChannelID:         row.ChannelID,
+			LastReadMessageID: row.LastReadMessageID,
+			UnreadCount:       row.UnreadCount,
+			MentionCount:      row.MentionCount,
+		}
+	}
+	return unreads, nil
+}
+
+var _ domain.IReadStateRepository = (*readStateRepository)(nil)
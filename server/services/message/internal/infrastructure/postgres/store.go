@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"message-service/internal/domain"
+	"message-service/internal/infrastructure/postgres/gen" // sqlcが生成したパッケージ
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresStore struct {
+	db          *pgxpool.Pool
+	messages    domain.IMessageRepository
+	reactions   domain.IReactionRepository
+	mentions    domain.IMentionRepository
+	attachments domain.IAttachmentRepository
+	readStates  domain.IReadStateRepository
+}
+
+func NewPostgresStore(db *pgxpool.Pool) domain.IStore {
+	q := gen.New(db)
+
+	return &PostgresStore{
+		db:          db,
+		messages:    NewPostgresMessageRepository(q),
+		reactions:   NewPostgresReactionRepository(q),
+		mentions:    NewPostgresMentionRepository(q),
+		attachments: NewPostgresAttachmentRepository(q),
+		readStates:  NewPostgresReadStateRepository(q),
+	}
+}
+
+func (s *PostgresStore) Messages() domain.IMessageRepository {
+	return s.messages
+}
+
+func (s *PostgresStore) Reactions() domain.IReactionRepository {
+	return s.reactions
+}
+
+func (s *PostgresStore) Mentions() domain.IMentionRepository {
+	return s.mentions
+}
+
+func (s *PostgresStore) Attachments() domain.IAttachmentRepository {
+	return s.attachments
+}
+
+func (s *PostgresStore) ReadStates() domain.IReadStateRepository {
+	return s.readStates
+}
+
+func (s *PostgresStore) ExecTx(ctx context.Context, fn func(domain.IStore) error) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	txQueries := gen.New(tx)
+
+	txStore := &PostgresStore{
+		db:          s.db,
+		messages:    NewPostgresMessageRepository(txQueries),
+		reactions:   NewPostgresReactionRepository(txQueries),
+		mentions:    NewPostgresMentionRepository(txQueries),
+		attachments: NewPostgresAttachmentRepository(txQueries),
+		readStates:  NewPostgresReadStateRepository(txQueries),
+	}
+
+	err = fn(txStore)
+	if err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+var _ domain.IStore = (*PostgresStore)(nil)
@@ -6,12 +6,21 @@ import (
 	"message-service/internal/domain"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
 const (
-	RedisChannelMessagePrefix = "message"
-	EventTypeMessageCreate    = "MESSAGE_CREATE"
+	RedisChannelMessagePrefix  = "message"
+	RedisChannelUserPrefix     = "user"
+	EventTypeMessageCreate     = "MESSAGE_CREATE"
+	EventTypeMessageUpdate     = "MESSAGE_UPDATE"
+	EventTypeMessageDelete     = "MESSAGE_DELETE"
+	EventTypeMessageDeleteBulk = "MESSAGE_DELETE_BULK"
+	EventTypeReactionAdd       = "REACTION_ADD"
+	EventTypeReactionRemove    = "REACTION_REMOVE"
+	EventTypeChannelPinsUpdate = "CHANNEL_PINS_UPDATE"
+	EventTypeChannelAck        = "CHANNEL_ACK"
 )
 
 type Event struct {
@@ -20,6 +29,35 @@ type Event struct {
 	Data      json.RawMessage `json:"data"`
 }
 
+type MessageDeletedData struct {
+	ID        uuid.UUID `json:"id"`
+	ChannelID uuid.UUID `json:"channelId"`
+}
+
+type MessagesDeletedData struct {
+	IDs       []uuid.UUID `json:"ids"`
+	ChannelID uuid.UUID   `json:"channelId"`
+}
+
+type ReactionData struct {
+	MessageID uuid.UUID `json:"messageId"`
+	ChannelID uuid.UUID `json:"channelId"`
+	UserID    uuid.UUID `json:"userId"`
+	Emoji     string    `json:"emoji"`
+}
+
+type ChannelPinsUpdateData struct {
+	ChannelID uuid.UUID  `json:"channelId"`
+	MessageID uuid.UUID  `json:"messageId"`
+	PinnedAt  *time.Time `json:"pinnedAt"`
+}
+
+type ChannelAckData struct {
+	UserID    uuid.UUID `json:"userId"`
+	ChannelID uuid.UUID `json:"channelId"`
+	MessageID uuid.UUID `json:"messageId"`
+}
+
 type RedisPublisher struct {
 	client *redis.Client
 }
@@ -30,18 +68,78 @@ func NewRedisPublisher(client *redis.Client) *RedisPublisher {
 	}
 }
 
-func (p *RedisPublisher) Publish(ctx context.Context, message *domain.Message) error {
-	messageJson, err := json.Marshal(message)
+func (p *RedisPublisher) PublishMessageCreated(ctx context.Context, message *domain.Message) error {
+	return p.publish(ctx, message.ChannelID, EventTypeMessageCreate, message)
+}
+
+func (p *RedisPublisher) PublishMessageUpdated(ctx context.Context, message *domain.Message) error {
+	return p.publish(ctx, message.ChannelID, EventTypeMessageUpdate, message)
+}
+
+func (p *RedisPublisher) PublishMessageDeleted(ctx context.Context, message *domain.Message) error {
+	return p.publish(ctx, message.ChannelID, EventTypeMessageDelete, MessageDeletedData{
+		ID:        message.ID,
+		ChannelID: message.ChannelID,
+	})
+}
+
+func (p *RedisPublisher) PublishMessagesDeleted(ctx context.Context, channelID uuid.UUID, messageIDs []uuid.UUID) error {
+	return p.publish(ctx, channelID, EventTypeMessageDeleteBulk, MessagesDeletedData{
+		IDs:       messageIDs,
+		ChannelID: channelID,
+	})
+}
+
+func (p *RedisPublisher) PublishReactionAdded(ctx context.Context, channelID uuid.UUID, reaction *domain.Reaction) error {
+	return p.publish(ctx, channelID, EventTypeReactionAdd, newReactionData(channelID, reaction))
+}
+
+func (p *RedisPublisher) PublishReactionRemoved(ctx context.Context, channelID uuid.UUID, reaction *domain.Reaction) error {
+	return p.publish(ctx, channelID, EventTypeReactionRemove, newReactionData(channelID, reaction))
+}
+
+func (p *RedisPublisher) PublishChannelPinsUpdated(ctx context.Context, message *domain.Message) error {
+	return p.publish(ctx, message.ChannelID, EventTypeChannelPinsUpdate, ChannelPinsUpdateData{
+		ChannelID: message.ChannelID,
+		MessageID: message.ID,
+		PinnedAt:  message.PinnedAt,
+	})
+}
+
+// チャンネルではなくユーザー宛てに配信する
+func (p *RedisPublisher) PublishChannelAcked(ctx context.Context, state *domain.ReadState) error {
+	redisChannel := RedisChannelUserPrefix + ":" + state.UserID.String()
+	return p.publishTo(ctx, redisChannel, EventTypeChannelAck, ChannelAckData{
+		UserID:    state.UserID,
+		ChannelID: state.ChannelID,
+		MessageID: state.LastReadMessageID,
+	})
+}
+
+func newReactionData(channelID uuid.UUID, reaction *domain.Reaction) ReactionData {
+	return ReactionData{
+		MessageID: reaction.MessageID,
+		ChannelID: channelID,
+		UserID:    reaction.UserID,
+		Emoji:     reaction.Emoji,
+	}
+}
+
+func (p *RedisPublisher) publish(ctx context.Context, channelID uuid.UUID, eventType string, data any) error {
+	redisChannel := RedisChannelMessagePrefix + ":" + channelID.String()
+	return p.publishTo(ctx, redisChannel, eventType, data)
+}
+
+func (p *RedisPublisher) publishTo(ctx context.Context, redisChannel string, eventType string, data any) error {
+	dataJson, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 
-	redisChannel := RedisChannelMessagePrefix + ":" + message.ChannelID.String()
-
 	payload := Event{
-		Type:      EventTypeMessageCreate,
+		Type:      eventType,
 		Timestamp: time.Now(),
-		Data:      messageJson,
+		Data:      dataJson,
 	}
 	payloadJson, err := json.Marshal(payload)
 	if err != nil {
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"message-service/internal/domain"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator"
@@ -11,39 +13,66 @@ import (
 
 type MessageUsecase interface {
 	Create(ctx context.Context, params *CreateParams) (*domain.Message, error)
-	GetByChannelID(ctx context.Context, userID, channelID uuid.UUID) ([]*domain.Message, error)
+	GetByChannelID(ctx context.Context, params *GetByChannelIDParams) (*GetByChannelIDResult, error)
+	Update(ctx context.Context, params *UpdateParams) (*domain.Message, error)
+	Delete(ctx context.Context, params *DeleteParams) error
+	DeleteBySender(ctx context.Context, params *DeleteBySenderParams) (int, error)
+	PurgeChannels(ctx context.Context, channelIDs []uuid.UUID) (int64, error)
+	Pin(ctx context.Context, params *PinParams) error
+	Unpin(ctx context.Context, params *PinParams) error
+	GetPinned(ctx context.Context, params *GetPinnedParams) ([]*domain.Message, error)
+	Search(ctx context.Context, params *SearchParams) (*SearchResult, error)
 }
 
+const (
+	DefaultMessageLimit = 50
+	MaxMessageLimit     = 100
+	DefaultSearchLimit  = 25
+	PurgeBatchSize      = 1000
+)
+
+// 添付ファイルがある場合はContentを省略できる
 type CreateParams struct {
-	ChannelID uuid.UUID  `validate:"required"`
-	SenderID  uuid.UUID  `validate:"required"`
-	Content   string     `validate:"required,min=1,max=500"`
-	ReplyID   *uuid.UUID `validate:"omitempty"`
+	ChannelID   uuid.UUID                 `validate:"required"`
+	SenderID    uuid.UUID                 `validate:"required"`
+	Content     string                    `validate:"required_without=Attachments,max=500"`
+	ReplyID     *uuid.UUID                `validate:"omitempty"`
+	Attachments []*CreateAttachmentParams `validate:"omitempty,dive"`
+}
+
+type CreateAttachmentParams struct {
+	ObjectKey   string `validate:"required,max=512"`
+	Filename    string `validate:"required,max=255"`
+	Size        int64  `validate:"gt=0"`
+	ContentType string `validate:"required,max=127"`
 }
 
 type messageUsecase struct {
-	messageRepo domain.IMessageRepository
-	userSvc     domain.IUserService
-	guildSvc    domain.IGuildService
-	publisher   domain.IPublisher
-	validator   *validator.Validate
+	store     domain.IStore
+	userSvc   domain.IUserService
+	guildSvc  domain.IGuildService
+	mediaSvc  domain.IMediaService
+	publisher domain.IPublisher
+	validator *validator.Validate
 }
 
 type MessageUsecaseParams struct {
-	MessageRepo domain.IMessageRepository
-	UserSvc     domain.IUserService
-	GuildSvc    domain.IGuildService
-	Publisher   domain.IPublisher
-	Validator   *validator.Validate
+	Store     domain.IStore
+	UserSvc   domain.IUserService
+	GuildSvc  domain.IGuildService
+	MediaSvc  domain.IMediaService
+	Publisher domain.IPublisher
+	Validator *validator.Validate
 }
 
 func NewMessageUsecase(params MessageUsecaseParams) MessageUsecase {
 	return &messageUsecase{
-		messageRepo: params.MessageRepo,
-		userSvc:     params.UserSvc,
-		guildSvc:    params.GuildSvc,
-		publisher:   params.Publisher,
-		validator:   params.Validator,
+		store:     params.Store,
+		userSvc:   params.UserSvc,
+		guildSvc:  params.GuildSvc,
+		mediaSvc:  params.MediaSvc,
+		publisher: params.Publisher,
+		validator: params.Validator,
 	}
 }
 
@@ -51,14 +80,39 @@ func (u *messageUsecase) Create(ctx context.Context, params *CreateParams) (*dom
 	if err := u.validator.Struct(params); err != nil {
 		return nil, domain.ErrInvalidMessageData
 	}
+	if err := validateAttachmentLimits(params.Attachments); err != nil {
+		return nil, err
+	}
 
-	hasAccess, err := u.guildSvc.CheckChannelAccess(ctx, params.SenderID, params.ChannelID)
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, params.SenderID, params.ChannelID)
 	if err != nil {
 		return nil, err
 	}
-	if !hasAccess {
+	if !perms.ViewChannel {
 		return nil, domain.ErrChannelNotFound
 	}
+	if perms.CommunicationDisabledUntil != nil {
+		return nil, &domain.CommunicationDisabledError{Until: *perms.CommunicationDisabledUntil}
+	}
+	// 見られても書き込めないチャンネルがある
+	if !perms.SendMessages || (len(params.Attachments) > 0 && !perms.AttachFiles) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	var replyTarget *domain.Message
+	if params.ReplyID != nil {
+		replyTarget, err = u.store.Messages().GetByID(ctx, *params.ReplyID)
+		if err != nil {
+			if err == domain.ErrMessageNotFound {
+				return nil, domain.ErrInvalidReplyTarget
+			}
+			return nil, err
+		}
+		// 別チャンネルのメッセージにはリプライできない
+		if replyTarget.ChannelID != params.ChannelID {
+			return nil, domain.ErrInvalidReplyTarget
+		}
+	}
 
 	message := domain.Message{
 		ID:        uuid.New(),
@@ -69,19 +123,45 @@ func (u *messageUsecase) Create(ctx context.Context, params *CreateParams) (*dom
 		CreatedAt: time.Now(),
 	}
 
-	createdMessage, err := u.messageRepo.Create(ctx, &message)
+	mentions, err := u.resolveMentions(ctx, params.ChannelID, params.Content)
 	if err != nil {
 		return nil, err
 	}
 
-	sender, err := u.userSvc.GetUserByID(ctx, createdMessage.SenderID)
+	attachments, err := u.verifyAttachments(ctx, message.ID, params.ChannelID, params.Attachments)
 	if err != nil {
 		return nil, err
 	}
-	createdMessage.Sender = sender
-	// TODO: Reply機能作ったらReplyも取得する
 
-	err = u.publisher.Publish(ctx, createdMessage)
+	var createdMessage *domain.Message
+	err = u.store.ExecTx(ctx, func(store domain.IStore) error {
+		created, err := store.Messages().Create(ctx, &message)
+		if err != nil {
+			return err
+		}
+		if err := store.Mentions().CreateMany(ctx, created.ID, mentions); err != nil {
+			return err
+		}
+		if err := store.Attachments().CreateMany(ctx, attachments); err != nil {
+			return err
+		}
+		createdMessage = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	createdMessage.Mentions = mentions
+	createdMessage.Attachments = attachments
+
+	if replyTarget != nil {
+		createdMessage.ReferencedMessage = domain.NewReferencedMessage(replyTarget)
+	}
+	if err := u.attachSenders(ctx, []*domain.Message{createdMessage}); err != nil {
+		return nil, err
+	}
+
+	err = u.publisher.PublishMessageCreated(ctx, createdMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -89,23 +169,302 @@ func (u *messageUsecase) Create(ctx context.Context, params *CreateParams) (*dom
 	return createdMessage, nil
 }
 
-func (u *messageUsecase) GetByChannelID(ctx context.Context, userID, channelID uuid.UUID) ([]*domain.Message, error) {
-	hasAccess, err := u.guildSvc.CheckChannelAccess(ctx, userID, channelID)
+// 添付ファイルの件数とサイズの上限はdomainの定数で判定する
+func validateAttachmentLimits(params []*CreateAttachmentParams) error {
+	if len(params) > domain.MaxAttachmentsPerMessage {
+		return domain.ErrInvalidMessageData
+	}
+	for _, param := range params {
+		if param.Size > domain.MaxAttachmentSize {
+			return domain.ErrInvalidMessageData
+		}
+	}
+	return nil
+}
+
+// 添付ファイルがチャンネル用にアップロードされ、申告どおりのサイズで存在するかをメディアサービスに確認する
+func (u *messageUsecase) verifyAttachments(ctx context.Context, messageID, channelID uuid.UUID, params []*CreateAttachmentParams) ([]*domain.Attachment, error) {
+	attachments := make([]*domain.Attachment, 0, len(params))
+	if len(params) == 0 {
+		return attachments, nil
+	}
+
+	prefix := domain.AttachmentObjectKeyPrefix(channelID)
+	seen := make(map[string]struct{}, len(params))
+	objectKeys := make([]string, len(params))
+	for i, param := range params {
+		if !strings.HasPrefix(param.ObjectKey, prefix) {
+			return nil, domain.ErrInvalidAttachment
+		}
+		if _, ok := seen[param.ObjectKey]; ok {
+			return nil, domain.ErrInvalidAttachment
+		}
+		seen[param.ObjectKey] = struct{}{}
+		objectKeys[i] = param.ObjectKey
+	}
+
+	stats, err := u.mediaSvc.StatObjects(ctx, objectKeys)
 	if err != nil {
 		return nil, err
 	}
-	if !hasAccess {
+	if len(stats) != len(params) {
+		return nil, domain.ErrInvalidAttachment
+	}
+
+	now := time.Now()
+	for i, param := range params {
+		stat := stats[i]
+		// サイズとContent-Typeはクライアントの申告ではなく、実際にアップロードされたオブジェクトの値を信用する
+		if !stat.Exists || stat.Size != param.Size || stat.Size > domain.MaxAttachmentSize {
+			return nil, domain.ErrInvalidAttachment
+		}
+		if stat.ContentType != param.ContentType {
+			return nil, domain.ErrInvalidAttachment
+		}
+		attachments = append(attachments, &domain.Attachment{
+			ID:          uuid.New(),
+			MessageID:   messageID,
+			ObjectKey:   param.ObjectKey,
+			Filename:    param.Filename,
+			Size:        stat.Size,
+			ContentType: stat.ContentType,
+			URL:         stat.URL,
+			CreatedAt:   now,
+		})
+	}
+	return attachments, nil
+}
+
+type GetByChannelIDParams struct {
+	UserID    uuid.UUID  `validate:"required"`
+	ChannelID uuid.UUID  `validate:"required"`
+	Before    *uuid.UUID `validate:"omitempty"`
+	After     *uuid.UUID `validate:"omitempty"`
+	Around    *uuid.UUID `validate:"omitempty"`
+	Limit     int32      `validate:"omitempty,min=1"`
+}
+
+type GetByChannelIDResult struct {
+	// created_atの昇順
+	Messages   []*domain.Message
+	NextBefore *uuid.UUID
+	NextAfter  *uuid.UUID
+}
+
+func (r *GetByChannelIDResult) HasMore() bool {
+	return r.NextBefore != nil || r.NextAfter != nil
+}
+
+func (u *messageUsecase) GetByChannelID(ctx context.Context, params *GetByChannelIDParams) (*GetByChannelIDResult, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidMessageData
+	}
+	if params.Limit > MaxMessageLimit {
+		return nil, domain.ErrInvalidMessageData
+	}
+
+	cursorCount := 0
+	for _, cursor := range []*uuid.UUID{params.Before, params.After, params.Around} {
+		if cursor != nil {
+			cursorCount++
+		}
+	}
+	if cursorCount > 1 {
+		return nil, domain.ErrInvalidMessageData
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = DefaultMessageLimit
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, params.UserID, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.ViewChannel {
 		return nil, domain.ErrChannelNotFound
 	}
 
-	messages, err := u.messageRepo.GetByChannelID(ctx, channelID)
+	var result *GetByChannelIDResult
+	switch {
+	case params.Before != nil:
+		cursor, err := u.getCursor(ctx, params.ChannelID, *params.Before)
+		if err != nil {
+			return nil, err
+		}
+		result, err = u.getBefore(ctx, params.ChannelID, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+	case params.After != nil:
+		cursor, err := u.getCursor(ctx, params.ChannelID, *params.After)
+		if err != nil {
+			return nil, err
+		}
+		result, err = u.getAfter(ctx, params.ChannelID, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+	case params.Around != nil:
+		cursor, err := u.getCursor(ctx, params.ChannelID, *params.Around)
+		if err != nil {
+			return nil, err
+		}
+		// cursor自身を真ん中に含め、残りを前後に振り分ける
+		beforeLimit := (limit - 1) / 2
+		afterLimit := limit - 1 - beforeLimit
+
+		before, err := u.getBefore(ctx, params.ChannelID, cursor, beforeLimit)
+		if err != nil {
+			return nil, err
+		}
+		after, err := u.getAfter(ctx, params.ChannelID, cursor, afterLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		messages := make([]*domain.Message, 0, len(before.Messages)+1+len(after.Messages))
+		messages = append(messages, before.Messages...)
+		messages = append(messages, cursor)
+		messages = append(messages, after.Messages...)
+		result = &GetByChannelIDResult{
+			Messages:   messages,
+			NextBefore: before.NextBefore,
+			NextAfter:  after.NextAfter,
+		}
+	default:
+		rows, err := u.store.Messages().GetByChannelID(ctx, params.ChannelID, limit+1)
+		if err != nil {
+			return nil, err
+		}
+		result = newBeforeResult(rows, limit)
+	}
+
+	if err := u.attachReferencedMessages(ctx, result.Messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachSenders(ctx, result.Messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachMentions(ctx, result.Messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachAttachments(ctx, result.Messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachReactions(ctx, result.Messages, params.UserID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// カーソルとして指定されたメッセージが同じチャンネルに存在するか確認する
+func (u *messageUsecase) getCursor(ctx context.Context, channelID, messageID uuid.UUID) (*domain.Message, error) {
+	cursor, err := u.store.Messages().GetByID(ctx, messageID)
+	if err != nil {
+		if err == domain.ErrMessageNotFound {
+			return nil, domain.ErrInvalidMessageData
+		}
+		return nil, err
+	}
+	if cursor.ChannelID != channelID {
+		return nil, domain.ErrInvalidMessageData
+	}
+	return cursor, nil
+}
+
+func (u *messageUsecase) getBefore(ctx context.Context, channelID uuid.UUID, cursor *domain.Message, limit int32) (*GetByChannelIDResult, error) {
+	if limit == 0 {
+		return &GetByChannelIDResult{}, nil
+	}
+	// 1件多く取得して、さらに古いメッセージがあるかを判定する
+	rows, err := u.store.Messages().GetByChannelIDBefore(ctx, channelID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	return newBeforeResult(rows, limit), nil
+}
+
+func (u *messageUsecase) getAfter(ctx context.Context, channelID uuid.UUID, cursor *domain.Message, limit int32) (*GetByChannelIDResult, error) {
+	if limit == 0 {
+		return &GetByChannelIDResult{}, nil
+	}
+	rows, err := u.store.Messages().GetByChannelIDAfter(ctx, channelID, cursor, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
+	result := &GetByChannelIDResult{}
+	if int32(len(rows)) > limit {
+		rows = rows[:limit]
+		nextAfter := rows[len(rows)-1].ID
+		result.NextAfter = &nextAfter
+	}
+	result.Messages = rows
+	return result, nil
+}
+
+// rowsは新しい順なので、昇順に並べ替えて返す
+func newBeforeResult(rows []*domain.Message, limit int32) *GetByChannelIDResult {
+	result := &GetByChannelIDResult{}
+	if int32(len(rows)) > limit {
+		rows = rows[:limit]
+		nextBefore := rows[len(rows)-1].ID
+		result.NextBefore = &nextBefore
+	}
+	slices.Reverse(rows)
+	result.Messages = rows
+	return result
+}
+
+// リプライ先のメッセージをまとめて取得して埋め込む。見つからないものは削除済みとして扱う
+func (u *messageUsecase) attachReferencedMessages(ctx context.Context, messages []*domain.Message) error {
+	replyIDSet := make(map[uuid.UUID]struct{})
+	for _, msg := range messages {
+		if msg.ReplyID != nil {
+			replyIDSet[*msg.ReplyID] = struct{}{}
+		}
+	}
+	if len(replyIDSet) == 0 {
+		return nil
+	}
+	replyIDs := make([]uuid.UUID, 0, len(replyIDSet))
+	for id := range replyIDSet {
+		replyIDs = append(replyIDs, id)
+	}
+
+	referenced, err := u.store.Messages().GetByIDs(ctx, replyIDs)
+	if err != nil {
+		return err
+	}
+	referencedMap := make(map[uuid.UUID]*domain.Message)
+	for _, msg := range referenced {
+		referencedMap[msg.ID] = msg
+	}
+
+	for _, msg := range messages {
+		if msg.ReplyID == nil {
+			continue
+		}
+		if ref, ok := referencedMap[*msg.ReplyID]; ok {
+			msg.ReferencedMessage = domain.NewReferencedMessage(ref)
+		} else {
+			msg.ReferencedMessage = domain.NewDeletedReferencedMessage(*msg.ReplyID)
+		}
+	}
+	return nil
+}
+
+// 投稿者とリプライ先の投稿者をまとめて取得して埋め込む
+func (u *messageUsecase) attachSenders(ctx context.Context, messages []*domain.Message) error {
 	userIDSet := make(map[uuid.UUID]struct{})
 	for _, msg := range messages {
 		userIDSet[msg.SenderID] = struct{}{}
+		if ref := msg.ReferencedMessage; ref != nil && ref.SenderID != nil {
+			userIDSet[*ref.SenderID] = struct{}{}
+		}
 	}
 	userIDs := make([]uuid.UUID, 0, len(userIDSet))
 	for id := range userIDSet {
@@ -114,7 +473,7 @@ func (u *messageUsecase) GetByChannelID(ctx context.Context, userID, channelID u
 
 	users, err := u.userSvc.GetUsersByIDs(ctx, userIDs)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	userMap := make(map[uuid.UUID]*domain.User)
 	for _, user := range users {
@@ -122,8 +481,545 @@ func (u *messageUsecase) GetByChannelID(ctx context.Context, userID, channelID u
 	}
 	for _, msg := range messages {
 		msg.Sender = userMap[msg.SenderID]
+		if ref := msg.ReferencedMessage; ref != nil && ref.SenderID != nil {
+			ref.Sender = userMap[*ref.SenderID]
+		}
+	}
+	return nil
+}
+
+// リアクションの集計結果をまとめて取得して埋め込む
+func (u *messageUsecase) attachReactions(ctx context.Context, messages []*domain.Message, userID uuid.UUID) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	messageIDs := make([]uuid.UUID, len(messages))
+	for i, msg := range messages {
+		messageIDs[i] = msg.ID
+	}
+
+	counts, err := u.store.Reactions().GetCountsByMessageIDs(ctx, messageIDs, userID)
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		msg.Reactions = counts[msg.ID]
+	}
+	return nil
+}
+
+// メッセージに含まれるメンションのうち、同じギルドのメンバーとチャンネルだけを残す
+func (u *messageUsecase) resolveMentions(ctx context.Context, channelID uuid.UUID, content string) ([]*domain.Mention, error) {
+	parsed := domain.ParseMentions(content)
+	if len(parsed) == 0 {
+		return []*domain.Mention{}, nil
+	}
+
+	var userIDs, channelIDs []uuid.UUID
+	for _, mention := range parsed {
+		switch mention.Type {
+		case domain.MentionTypeUser:
+			userIDs = append(userIDs, mention.ID)
+		case domain.MentionTypeChannel:
+			channelIDs = append(channelIDs, mention.ID)
+		}
+	}
+
+	validUserIDs, validChannelIDs, err := u.guildSvc.FilterMentionTargets(ctx, channelID, userIDs, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+	valid := make(map[domain.MentionType]map[uuid.UUID]bool, 2)
+	valid[domain.MentionTypeUser] = make(map[uuid.UUID]bool, len(validUserIDs))
+	for _, id := range validUserIDs {
+		valid[domain.MentionTypeUser][id] = true
+	}
+	valid[domain.MentionTypeChannel] = make(map[uuid.UUID]bool, len(validChannelIDs))
+	for _, id := range validChannelIDs {
+		valid[domain.MentionTypeChannel][id] = true
+	}
+
+	// 作成時と取得時で同じ順序になるよう、本文に出てきた順のまま残す
+	mentions := make([]*domain.Mention, 0, len(parsed))
+	for _, mention := range parsed {
+		if valid[mention.Type][mention.ID] {
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions, nil
+}
+
+// メンションをまとめて取得して埋め込む
+func (u *messageUsecase) attachMentions(ctx context.Context, messages []*domain.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	messageIDs := make([]uuid.UUID, len(messages))
+	for i, msg := range messages {
+		messageIDs[i] = msg.ID
+	}
+
+	mentions, err := u.store.Mentions().GetByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		msg.Mentions = mentions[msg.ID]
+		if msg.Mentions == nil {
+			msg.Mentions = []*domain.Mention{}
+		}
+	}
+	return nil
+}
+
+// 添付ファイルをまとめて取得して埋め込む
+func (u *messageUsecase) attachAttachments(ctx context.Context, messages []*domain.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	messageIDs := make([]uuid.UUID, len(messages))
+	for i, msg := range messages {
+		messageIDs[i] = msg.ID
+	}
+
+	attachments, err := u.store.Attachments().GetByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		msg.Attachments = attachments[msg.ID]
+		if msg.Attachments == nil {
+			msg.Attachments = []*domain.Attachment{}
+		}
+	}
+	return nil
+}
+
+type UpdateParams struct {
+	MessageID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	Content   string    `validate:"required,min=1,max=500"`
+}
+
+// 送信と同じく、タイムアウト中や書き込めなくなったチャンネルでは編集できない
+func (u *messageUsecase) Update(ctx context.Context, params *UpdateParams) (*domain.Message, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidMessageData
+	}
+
+	message, perms, err := u.getOwnMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	if perms.CommunicationDisabledUntil != nil {
+		return nil, &domain.CommunicationDisabledError{Until: *perms.CommunicationDisabledUntil}
+	}
+	if !perms.SendMessages {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	editedAt := time.Now()
+	message.Content = params.Content
+	message.EditedAt = &editedAt
+
+	mentions, err := u.resolveMentions(ctx, message.ChannelID, params.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 編集後の内容でメンションを置き換える
+	var updatedMessage *domain.Message
+	err = u.store.ExecTx(ctx, func(store domain.IStore) error {
+		updated, err := store.Messages().Update(ctx, message)
+		if err != nil {
+			return err
+		}
+		if err := store.Mentions().DeleteByMessageID(ctx, updated.ID); err != nil {
+			return err
+		}
+		if err := store.Mentions().CreateMany(ctx, updated.ID, mentions); err != nil {
+			return err
+		}
+		updatedMessage = updated
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	updatedMessage.Mentions = mentions
+
+	if err := u.attachReferencedMessages(ctx, []*domain.Message{updatedMessage}); err != nil {
+		return nil, err
+	}
+	if err := u.attachSenders(ctx, []*domain.Message{updatedMessage}); err != nil {
+		return nil, err
+	}
+	if err := u.attachAttachments(ctx, []*domain.Message{updatedMessage}); err != nil {
+		return nil, err
+	}
+
+	err = u.publisher.PublishMessageUpdated(ctx, updatedMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	// meフラグはユーザーごとに異なるので、配信した後にレスポンス用として埋め込む
+	if err := u.attachReactions(ctx, []*domain.Message{updatedMessage}, params.UserID); err != nil {
+		return nil, err
+	}
+
+	return updatedMessage, nil
+}
+
+type DeleteParams struct {
+	MessageID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+func (u *messageUsecase) Delete(ctx context.Context, params *DeleteParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidMessageData
+	}
+
+	message, _, err := u.getOwnMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.store.Messages().Delete(ctx, message.ID); err != nil {
+		return err
+	}
+
+	return u.publisher.PublishMessageDeleted(ctx, message)
+}
+
+type DeleteBySenderParams struct {
+	SenderID   uuid.UUID   `validate:"required"`
+	ChannelIDs []uuid.UUID `validate:"max=500"`
+	Since      time.Time   `validate:"required"`
+}
+
+// 呼び出し元が権限を確認済みのチャンネルについて、指定ユーザーの最近のメッセージを削除する
+func (u *messageUsecase) DeleteBySender(ctx context.Context, params *DeleteBySenderParams) (int, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return 0, domain.ErrInvalidMessageData
+	}
+	if len(params.ChannelIDs) == 0 {
+		return 0, nil
+	}
+
+	messages, err := u.store.Messages().DeleteBySenderSince(ctx, params.SenderID, params.ChannelIDs, params.Since)
+	if err != nil {
+		return 0, err
+	}
+
+	// チャンネルごとにまとめて削除を通知する
+	var channelIDs []uuid.UUID
+	messageIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, message := range messages {
+		if _, ok := messageIDs[message.ChannelID]; !ok {
+			channelIDs = append(channelIDs, message.ChannelID)
+		}
+		messageIDs[message.ChannelID] = append(messageIDs[message.ChannelID], message.ID)
+	}
+	for _, channelID := range channelIDs {
+		if err := u.publisher.PublishMessagesDeleted(ctx, channelID, messageIDs[channelID]); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(messages), nil
+}
+
+// 削除済みのチャンネルのメッセージをすべて削除する。一度に消す件数を抑えるため、PurgeBatchSize件ずつ繰り返す
+func (u *messageUsecase) PurgeChannels(ctx context.Context, channelIDs []uuid.UUID) (int64, error) {
+	if len(channelIDs) == 0 {
+		return 0, nil
+	}
+
+	var total int64
+	for {
+		deleted, err := u.store.Messages().DeleteByChannelIDs(ctx, channelIDs, PurgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+		if deleted < PurgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// 編集・削除は投稿者本人のみ許可する
+// 自分のメッセージと、そのチャンネルでの自分の権限を返す
+func (u *messageUsecase) getOwnMessage(ctx context.Context, userID, messageID uuid.UUID) (*domain.Message, *domain.ChannelPermissions, error) {
+	message, err := u.store.Messages().GetByID(ctx, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, userID, message.ChannelID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !perms.ViewChannel {
+		return nil, nil, domain.ErrMessageNotFound
+	}
+
+	if message.SenderID != userID {
+		return nil, nil, domain.ErrPermissionDenied
+	}
+
+	return message, perms, nil
+}
+
+type PinParams struct {
+	MessageID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+func (u *messageUsecase) Pin(ctx context.Context, params *PinParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidMessageData
+	}
+
+	message, err := u.getPinnableMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return err
+	}
+	if message.PinnedAt != nil {
+		return nil
+	}
+
+	// 同時にピン留めされても上限を超えないよう、数えてから更新するまでをチャンネル単位で直列にする
+	pinned := false
+	err = u.store.ExecTx(ctx, func(store domain.IStore) error {
+		if err := store.Messages().LockPinsByChannelID(ctx, message.ChannelID); err != nil {
+			return err
+		}
+
+		// ロックを待つ間に同じメッセージがピン留めされていれば何もしない
+		current, err := store.Messages().GetByID(ctx, message.ID)
+		if err != nil {
+			return err
+		}
+		if current.PinnedAt != nil {
+			return nil
+		}
+
+		count, err := store.Messages().CountPinnedByChannelID(ctx, message.ChannelID)
+		if err != nil {
+			return err
+		}
+		if count >= domain.MaxPinnedMessagesPerChannel {
+			return domain.ErrTooManyPins
+		}
+
+		pinnedAt := time.Now()
+		if err := store.Messages().UpdatePinnedAt(ctx, message.ID, &pinnedAt); err != nil {
+			return err
+		}
+		message.PinnedAt = &pinnedAt
+		pinned = true
+		return nil
+	})
+	if err != nil || !pinned {
+		return err
+	}
+
+	return u.publisher.PublishChannelPinsUpdated(ctx, message)
+}
+
+func (u *messageUsecase) Unpin(ctx context.Context, params *PinParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidMessageData
+	}
+
+	message, err := u.getPinnableMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return err
+	}
+	if message.PinnedAt == nil {
+		return nil
+	}
+
+	if err := u.store.Messages().UpdatePinnedAt(ctx, message.ID, nil); err != nil {
+		return err
+	}
+	message.PinnedAt = nil
+
+	return u.publisher.PublishChannelPinsUpdated(ctx, message)
+}
+
+// ピン留めの操作はチャンネルでMANAGE_MESSAGESを持つユーザーのみ許可する
+func (u *messageUsecase) getPinnableMessage(ctx context.Context, userID, messageID uuid.UUID) (*domain.Message, error) {
+	message, err := u.store.Messages().GetByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, userID, message.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.ViewChannel {
+		return nil, domain.ErrMessageNotFound
+	}
+	if !perms.ManageMessages {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	return message, nil
+}
+
+type GetPinnedParams struct {
+	ChannelID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+// ピン留めされた順に新しいものから返す
+func (u *messageUsecase) GetPinned(ctx context.Context, params *GetPinnedParams) ([]*domain.Message, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidMessageData
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, params.UserID, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !perms.ViewChannel {
+		return nil, domain.ErrChannelNotFound
+	}
+
+	messages, err := u.store.Messages().GetPinnedByChannelID(ctx, params.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.attachReferencedMessages(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachSenders(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachMentions(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachAttachments(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachReactions(ctx, messages, params.UserID); err != nil {
+		return nil, err
 	}
+
 	return messages, nil
 }
 
+type SearchParams struct {
+	UserID        uuid.UUID  `validate:"required"`
+	GuildID       uuid.UUID  `validate:"required"`
+	Query         string     `validate:"required,max=200"`
+	AuthorID      *uuid.UUID `validate:"omitempty"`
+	ChannelID     *uuid.UUID `validate:"omitempty"`
+	HasAttachment *bool      `validate:"omitempty"`
+	Before        *time.Time `validate:"omitempty"`
+	After         *time.Time `validate:"omitempty"`
+	Cursor        *uuid.UUID `validate:"omitempty"`
+	Limit         int32      `validate:"omitempty,min=1,max=100"`
+}
+
+type SearchResult struct {
+	// created_atの降順
+	Hits       []*domain.MessageSearchHit
+	NextCursor *uuid.UUID
+}
+
+// ユーザーがアクセスできるチャンネルのメッセージだけを検索する
+func (u *messageUsecase) Search(ctx context.Context, params *SearchParams) (*SearchResult, error) {
+	params.Query = strings.TrimSpace(params.Query)
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidMessageData
+	}
+	if params.Before != nil && params.After != nil && !params.After.Before(*params.Before) {
+		return nil, domain.ErrInvalidMessageData
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+
+	channelIDs, err := u.guildSvc.ListAccessibleChannelIDs(ctx, params.UserID, params.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if params.ChannelID != nil {
+		if !slices.Contains(channelIDs, *params.ChannelID) {
+			return nil, domain.ErrChannelNotFound
+		}
+		channelIDs = []uuid.UUID{*params.ChannelID}
+	}
+	if len(channelIDs) == 0 {
+		return &SearchResult{Hits: []*domain.MessageSearchHit{}}, nil
+	}
+
+	query := &domain.MessageSearchQuery{
+		Query:         params.Query,
+		ChannelIDs:    channelIDs,
+		SenderID:      params.AuthorID,
+		HasAttachment: params.HasAttachment,
+		Before:        params.Before,
+		After:         params.After,
+		Limit:         limit + 1,
+	}
+	if params.Cursor != nil {
+		cursor, err := u.store.Messages().GetByID(ctx, *params.Cursor)
+		if err != nil {
+			if err == domain.ErrMessageNotFound {
+				return nil, domain.ErrInvalidMessageData
+			}
+			return nil, err
+		}
+		if !slices.Contains(channelIDs, cursor.ChannelID) {
+			return nil, domain.ErrInvalidMessageData
+		}
+		query.Cursor = cursor
+	}
+
+	// 1件多く取得して、続きがあるかを判定する
+	hits, err := u.store.Messages().Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result := &SearchResult{}
+	if int32(len(hits)) > limit {
+		hits = hits[:limit]
+		nextCursor := hits[len(hits)-1].Message.ID
+		result.NextCursor = &nextCursor
+	}
+	result.Hits = hits
+
+	messages := make([]*domain.Message, len(hits))
+	for i, hit := range hits {
+		messages[i] = hit.Message
+	}
+	if err := u.attachReferencedMessages(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachSenders(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachMentions(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachAttachments(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := u.attachReactions(ctx, messages, params.UserID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 var _ MessageUsecase = (*messageUsecase)(nil)
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"message-service/internal/domain"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type ReactionUsecase interface {
+	Add(ctx context.Context, params *AddReactionParams) error
+	Remove(ctx context.Context, params *RemoveReactionParams) error
+	ListReactors(ctx context.Context, params *ListReactorsParams) ([]*domain.User, error)
+}
+
+const (
+	DefaultReactorLimit = 25
+	MaxReactorLimit     = 100
+)
+
+type reactionUsecase struct {
+	store     domain.IStore
+	userSvc   domain.IUserService
+	guildSvc  domain.IGuildService
+	publisher domain.IPublisher
+	validator *validator.Validate
+}
+
+type ReactionUsecaseParams struct {
+	Store     domain.IStore
+	UserSvc   domain.IUserService
+	GuildSvc  domain.IGuildService
+	Publisher domain.IPublisher
+	Validator *validator.Validate
+}
+
+func NewReactionUsecase(params ReactionUsecaseParams) ReactionUsecase {
+	return &reactionUsecase{
+		store:     params.Store,
+		userSvc:   params.UserSvc,
+		guildSvc:  params.GuildSvc,
+		publisher: params.Publisher,
+		validator: params.Validator,
+	}
+}
+
+type AddReactionParams struct {
+	MessageID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	Emoji     string    `validate:"required,max=32"`
+}
+
+func (u *reactionUsecase) Add(ctx context.Context, params *AddReactionParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidReactionData
+	}
+
+	message, perms, err := u.getAccessibleMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return err
+	}
+	if perms.CommunicationDisabledUntil != nil {
+		return &domain.CommunicationDisabledError{Until: *perms.CommunicationDisabledUntil}
+	}
+	if !perms.AddReactions {
+		return domain.ErrPermissionDenied
+	}
+
+	reaction := &domain.Reaction{
+		MessageID: message.ID,
+		UserID:    params.UserID,
+		Emoji:     params.Emoji,
+		CreatedAt: time.Now(),
+	}
+	// 同時に別の絵文字が付けられても上限を超えないよう、数えてから追加するまでをメッセージ単位で直列にする
+	created := false
+	err = u.store.ExecTx(ctx, func(store domain.IStore) error {
+		if err := store.Reactions().LockByMessageID(ctx, message.ID); err != nil {
+			return err
+		}
+
+		// 新しい種類の絵文字を付ける場合のみ上限を確認する
+		counts, err := store.Reactions().GetCountsByMessageIDs(ctx, []uuid.UUID{message.ID}, params.UserID)
+		if err != nil {
+			return err
+		}
+		exists := false
+		for _, count := range counts[message.ID] {
+			if count.Emoji == params.Emoji {
+				exists = true
+				break
+			}
+		}
+		if !exists && len(counts[message.ID]) >= domain.MaxReactionEmojisPerMessage {
+			return domain.ErrTooManyReactions
+		}
+
+		created, err = store.Reactions().Create(ctx, reaction)
+		return err
+	})
+	// 既にリアクション済みの場合は何もしない
+	if err != nil || !created {
+		return err
+	}
+
+	return u.publisher.PublishReactionAdded(ctx, message.ChannelID, reaction)
+}
+
+type RemoveReactionParams struct {
+	MessageID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	Emoji     string    `validate:"required,max=32"`
+}
+
+func (u *reactionUsecase) Remove(ctx context.Context, params *RemoveReactionParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidReactionData
+	}
+
+	message, _, err := u.getAccessibleMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := u.store.Reactions().Delete(ctx, message.ID, params.UserID, params.Emoji)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return nil
+	}
+
+	return u.publisher.PublishReactionRemoved(ctx, message.ChannelID, &domain.Reaction{
+		MessageID: message.ID,
+		UserID:    params.UserID,
+		Emoji:     params.Emoji,
+	})
+}
+
+type ListReactorsParams struct {
+	MessageID uuid.UUID  `validate:"required"`
+	UserID    uuid.UUID  `validate:"required"`
+	Emoji     string     `validate:"required,max=32"`
+	After     *uuid.UUID `validate:"omitempty"`
+	Limit     int32      `validate:"omitempty,min=1"`
+}
+
+// リアクションしたユーザーをユーザーIDの昇順で返す
+func (u *reactionUsecase) ListReactors(ctx context.Context, params *ListReactorsParams) ([]*domain.User, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidReactionData
+	}
+	if params.Limit > MaxReactorLimit {
+		return nil, domain.ErrInvalidReactionData
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = DefaultReactorLimit
+	}
+
+	message, _, err := u.getAccessibleMessage(ctx, params.UserID, params.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err := u.store.Reactions().GetReactorIDs(ctx, message.ID, params.Emoji, params.After, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return []*domain.User{}, nil
+	}
+
+	users, err := u.userSvc.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[uuid.UUID]*domain.User)
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	reactors := make([]*domain.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		if user, ok := userMap[id]; ok {
+			reactors = append(reactors, user)
+		}
+	}
+	return reactors, nil
+}
+
+// チャンネルにアクセスできないユーザーにはメッセージの存在自体を見せない
+func (u *reactionUsecase) getAccessibleMessage(ctx context.Context, userID, messageID uuid.UUID) (*domain.Message, *domain.ChannelPermissions, error) {
+	message, err := u.store.Messages().GetByID(ctx, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, userID, message.ChannelID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !perms.ViewChannel {
+		return nil, nil, domain.ErrMessageNotFound
+	}
+
+	return message, perms, nil
+}
+
+var _ ReactionUsecase = (*reactionUsecase)(nil)
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"message-service/internal/domain"
+
+	"github.com/go-playground/validator"
+	"github.com/google/uuid"
+)
+
+type ReadStateUsecase interface {
+	Ack(ctx context.Context, params *AckParams) error
+	GetUnreadCounts(ctx context.Context, params *GetUnreadCountsParams) ([]*domain.ChannelUnread, error)
+}
+
+type readStateUsecase struct {
+	store     domain.IStore
+	guildSvc  domain.IGuildService
+	publisher domain.IPublisher
+	validator *validator.Validate
+}
+
+type ReadStateUsecaseParams struct {
+	Store     domain.IStore
+	GuildSvc  domain.IGuildService
+	Publisher domain.IPublisher
+	Validator *validator.Validate
+}
+
+func NewReadStateUsecase(params ReadStateUsecaseParams) ReadStateUsecase {
+	return &readStateUsecase{
+		store:     params.Store,
+		guildSvc:  params.GuildSvc,
+		publisher: params.Publisher,
+		validator: params.Validator,
+	}
+}
+
+type AckParams struct {
+	UserID    uuid.UUID `validate:"required"`
+	ChannelID uuid.UUID `validate:"required"`
+	MessageID uuid.UUID `validate:"required"`
+}
+
+// messageIDまでを既読にする。既により新しいメッセージまで既読の場合は何もしない
+func (u *readStateUsecase) Ack(ctx context.Context, params *AckParams) error {
+	if err := u.validator.Struct(params); err != nil {
+		return domain.ErrInvalidMessageData
+	}
+
+	perms, err := u.guildSvc.GetChannelPermissions(ctx, params.UserID, params.ChannelID)
+	if err != nil {
+		return err
+	}
+	if !perms.ViewChannel {
+		return domain.ErrChannelNotFound
+	}
+
+	message, err := u.store.Messages().GetByID(ctx, params.MessageID)
+	if err != nil {
+		return err
+	}
+	if message.ChannelID != params.ChannelID {
+		return domain.ErrMessageNotFound
+	}
+
+	state := &domain.ReadState{
+		UserID:            params.UserID,
+		ChannelID:         params.ChannelID,
+		LastReadMessageID: message.ID,
+		LastReadAt:        message.CreatedAt,
+	}
+	updated, err := u.store.ReadStates().Upsert(ctx, state)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+
+	return u.publisher.PublishChannelAcked(ctx, state)
+}
+
+type GetUnreadCountsParams struct {
+	UserID     uuid.UUID   `validate:"required"`
+	ChannelIDs []uuid.UUID `validate:"max=500"`
+}
+
+// 呼び出し元がアクセスを確認済みのチャンネルについて未読件数を返す
+func (u *readStateUsecase) GetUnreadCounts(ctx context.Context, params *GetUnreadCountsParams) ([]*domain.ChannelUnread, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return nil, domain.ErrInvalidMessageData
+	}
+	if len(params.ChannelIDs) == 0 {
+		return []*domain.ChannelUnread{}, nil
+	}
+
+	return u.store.ReadStates().GetUnreadCounts(ctx, params.UserID, params.ChannelIDs)
+}
+
+var _ ReadStateUsecase = (*readStateUsecase)(nil)
@@ -0,0 +1,9 @@
+-- name: CreateAttachments :copyfrom
+INSERT INTO message_attachments (id, message_id, object_key, filename, size, content_type, url, position, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetAttachmentsByMessageIDs :many
+SELECT id, message_id, object_key, filename, size, content_type, url
+FROM message_attachments
+WHERE message_id = ANY(@message_ids::uuid[])
+ORDER BY message_id, position;
@@ -0,0 +1,14 @@
+-- name: CreateMentions :copyfrom
+INSERT INTO message_mentions (message_id, type, target_id, position)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteMentionsByMessageID :exec
+DELETE FROM message_mentions
+WHERE message_id = $1;
+
+-- name: GetMentionsByMessageIDs :many
+-- 本文に出てきた順に並べる。positionを持たない古い行は種類とIDの順になる
+SELECT message_id, type, target_id, position
+FROM message_mentions
+WHERE message_id = ANY(@message_ids::uuid[])
+ORDER BY message_id, position, type, target_id;
@@ -3,8 +3,102 @@ INSERT INTO messages (id, channel_id, sender_id, content, reply_id, created_at,
 VALUES ($1, $2, $3, $4, $5, $6, NOW())
 RETURNING id, channel_id, sender_id, content, reply_id, created_at;
 
--- name: GetMessagesByChannelID :many
-SELECT id, channel_id, sender_id, content, reply_id, created_at
+-- name: GetLatestMessagesByChannelID :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
 FROM messages
-WHERE channel_id = $1
-ORDER BY created_at ASC;
+WHERE channel_id = @channel_id
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
+
+-- name: GetMessagesBeforeCursor :many
+-- created_atの範囲条件でidx_channel_created_atを使い、同時刻のメッセージはidで順序を安定させる
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = @channel_id
+  AND created_at <= @cursor_created_at
+  AND (created_at, id) < (@cursor_created_at::timestamp, @cursor_id::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
+
+-- name: GetMessagesAfterCursor :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = @channel_id
+  AND created_at >= @cursor_created_at
+  AND (created_at, id) > (@cursor_created_at::timestamp, @cursor_id::uuid)
+ORDER BY created_at ASC, id ASC
+LIMIT @row_limit;
+
+-- name: GetMessageByID :one
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE id = $1;
+
+-- name: UpdateMessageContent :one
+UPDATE messages
+SET content = $2, edited_at = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at;
+
+-- name: DeleteMessage :exec
+DELETE FROM messages
+WHERE id = $1;
+
+-- name: GetMessagesByIDs :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE id = ANY(@ids::uuid[]);
+
+-- name: UpdateMessagePinnedAt :exec
+UPDATE messages
+SET pinned_at = $2
+WHERE id = $1;
+
+-- name: GetPinnedMessagesByChannelID :many
+SELECT id, channel_id, sender_id, content, reply_id, created_at, edited_at, pinned_at
+FROM messages
+WHERE channel_id = $1 AND pinned_at IS NOT NULL
+ORDER BY pinned_at DESC;
+
+-- name: CountPinnedMessagesByChannelID :one
+SELECT COUNT(*)::int
+FROM messages
+WHERE channel_id = $1 AND pinned_at IS NOT NULL;
+
+-- name: LockChannelPins :exec
+-- チャンネルの行はこのサービスにないので、チャンネルIDをキーにしたアドバイザリロックを取る
+SELECT pg_advisory_xact_lock(hashtextextended('message_pins:' || CAST(sqlc.arg(channel_id)::uuid AS text), 0));
+
+-- name: SearchMessages :many
+-- ts_headlineの強調部分は制御文字で囲み、HTMLエスケープ後に<mark>へ置き換える。
+-- 本文に同じ制御文字が含まれていると<mark>を差し込めてしまうので、先に取り除いておく
+SELECT m.id, m.channel_id, m.sender_id, m.content, m.reply_id, m.created_at, m.edited_at, m.pinned_at,
+  ts_headline('simple', translate(m.content, chr(2) || chr(3), ''), websearch_to_tsquery('simple', @query::text),
+    'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=35, MinWords=15, MaxFragments=2')::text AS snippet
+FROM messages m
+WHERE m.channel_id = ANY(@channel_ids::uuid[])
+  AND m.content_tsv @@ websearch_to_tsquery('simple', @query::text)
+  AND (sqlc.narg('sender_id')::uuid IS NULL OR m.sender_id = sqlc.narg('sender_id')::uuid)
+  AND (sqlc.narg('before')::timestamp IS NULL OR m.created_at < sqlc.narg('before')::timestamp)
+  AND (sqlc.narg('after')::timestamp IS NULL OR m.created_at > sqlc.narg('after')::timestamp)
+  AND (sqlc.narg('has_attachment')::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM message_attachments a WHERE a.message_id = m.id) = sqlc.narg('has_attachment')::boolean)
+  AND (sqlc.narg('cursor_id')::uuid IS NULL
+    OR (m.created_at, m.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT @row_limit;
+
+-- name: DeleteMessagesBySenderSince :many
+DELETE FROM messages
+WHERE sender_id = @sender_id
+  AND channel_id = ANY(@channel_ids::uuid[])
+  AND created_at >= @since
+RETURNING id, channel_id;
+
+-- name: DeleteMessagesByChannelIDs :execrows
+DELETE FROM messages
+WHERE id IN (
+  SELECT id FROM messages
+  WHERE channel_id = ANY(@channel_ids::uuid[])
+  LIMIT @row_limit
+);
@@ -0,0 +1,29 @@
+-- name: CreateReaction :execrows
+INSERT INTO message_reactions (message_id, user_id, emoji, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING;
+
+-- name: LockMessageReactions :exec
+-- 絵文字の種類を数えてから追加するまでの間、同じメッセージへのリアクションを直列にする
+SELECT pg_advisory_xact_lock(hashtextextended('message_reactions:' || CAST(sqlc.arg(message_id)::uuid AS text), 0));
+
+-- name: DeleteReaction :execrows
+DELETE FROM message_reactions
+WHERE message_id = $1 AND user_id = $2 AND emoji = $3;
+
+-- name: GetReactionCountsByMessageIDs :many
+-- 絵文字ごとに集計し、最初にリアクションされた順に並べる
+SELECT message_id, emoji, COUNT(*)::int AS count, BOOL_OR(user_id = @user_id::uuid)::boolean AS me
+FROM message_reactions
+WHERE message_id = ANY(@message_ids::uuid[])
+GROUP BY message_id, emoji
+ORDER BY message_id, MIN(created_at);
+
+-- name: GetReactorIDs :many
+SELECT user_id
+FROM message_reactions
+WHERE message_id = @message_id
+  AND emoji = @emoji
+  AND (sqlc.narg(after_user_id)::uuid IS NULL OR user_id > sqlc.narg(after_user_id)::uuid)
+ORDER BY user_id ASC
+LIMIT @row_limit;
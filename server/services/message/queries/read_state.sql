@@ -0,0 +1,35 @@
+-- name: UpsertReadState :execrows
+-- 既読位置は後ろに戻さない。より新しいメッセージまで既読の場合は更新しない
+INSERT INTO channel_read_states (user_id, channel_id, last_read_message_id, last_read_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (user_id, channel_id) DO UPDATE
+SET last_read_message_id = EXCLUDED.last_read_message_id,
+    last_read_at = EXCLUDED.last_read_at,
+    updated_at = NOW()
+WHERE (channel_read_states.last_read_at, channel_read_states.last_read_message_id)
+  < (EXCLUDED.last_read_at, EXCLUDED.last_read_message_id);
+
+-- name: GetUnreadCounts :many
+-- 自分が送ったメッセージは未読に数えない。件数はmax_countで打ち切る
+SELECT c.channel_id::uuid AS channel_id,
+  rs.last_read_message_id,
+  (SELECT COUNT(*) FROM (
+    SELECT 1 FROM messages m
+    WHERE m.channel_id = c.channel_id
+      AND m.sender_id <> @user_id::uuid
+      AND (rs.user_id IS NULL OR (m.created_at, m.id) > (rs.last_read_at, rs.last_read_message_id))
+    LIMIT @max_count::int
+  ) unread)::int AS unread_count,
+  (SELECT COUNT(*) FROM (
+    SELECT 1 FROM message_mentions mm
+    JOIN messages m ON m.id = mm.message_id
+    WHERE mm.target_id = @user_id::uuid
+      AND mm.type = 'user'
+      AND m.channel_id = c.channel_id
+      AND m.sender_id <> @user_id::uuid
+      AND (rs.user_id IS NULL OR (m.created_at, m.id) > (rs.last_read_at, rs.last_read_message_id))
+    LIMIT @max_count::int
+  ) mentioned)::int AS mention_count
+FROM unnest(@channel_ids::uuid[]) WITH ORDINALITY AS c(channel_id, ord)
+LEFT JOIN channel_read_states rs ON rs.channel_id = c.channel_id AND rs.user_id = @user_id::uuid
+ORDER BY c.ord;
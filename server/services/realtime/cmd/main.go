@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"realtime-service/internal/access"
 	"realtime-service/internal/config"
 	"realtime-service/internal/handler"
 	"realtime-service/internal/hub"
 	"realtime-service/internal/metrics"
+	"realtime-service/internal/presence"
+	"realtime-service/internal/publisher"
+	"realtime-service/internal/session"
 	"realtime-service/internal/subscriber"
 	"shared/logger"
 	"shared/tracing"
@@ -21,7 +25,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	_ "net/http/pprof"
 )
@@ -71,10 +78,40 @@ func main() {
 		panic(err)
 	}
 
-	hub := hub.NewHub(wsMetrics)
+	guildConn, err := grpc.NewClient(cfg.GuildServiceURL, grpc.WithStatsHandler(otelgrpc.NewClientHandler()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to connect to guild service", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := guildConn.Close(); err != nil {
+			log.Error("Failed to close guild service connection", "error", err)
+		}
+	}()
+	guildClient := access.NewGuildClient(guildConn)
+	accessChecker := access.NewCachedChecker(guildClient, access.DefaultCacheTTL)
+
+	sessionStore := session.NewRedisStore(redisClient, hub.ResumeWindow, hub.ReplayBufferSize)
+	go sessionStore.Run(context.Background())
+
+	hub := hub.NewHub(&hub.NewHubParams{
+		Metrics:     wsMetrics,
+		Publisher:   publisher.NewRedisPublisher(redisClient),
+		Access:      accessChecker,
+		SendChecker: guildClient,
+		GuildLister: guildClient,
+		Store:       sessionStore,
+		Presence:    presence.NewRedisTracker(redisClient, hub.PresenceTTL),
+	})
 	go hub.Run()
 	log.Info("Hub started")
 
+	go func() {
+		if err := sessionStore.ListenTakeover(context.Background(), hub); err != nil {
+			log.Error("Session takeover listener stopped with error", "err", err)
+		}
+	}()
+
 	messageSub := subscriber.NewMessageSubscriber(redisClient, hub)
 	go func() {
 		if err := messageSub.Start(context.Background()); err != nil {
@@ -83,6 +120,22 @@ func main() {
 	}()
 	log.Info("Message subscriber started")
 
+	userSub := subscriber.NewUserSubscriber(redisClient, hub)
+	go func() {
+		if err := userSub.Start(context.Background()); err != nil {
+			log.Error("User subscriber stopped with error", "err", err)
+		}
+	}()
+	log.Info("User subscriber started")
+
+	guildSub := subscriber.NewGuildSubscriber(redisClient, hub)
+	go func() {
+		if err := guildSub.Start(context.Background()); err != nil {
+			log.Error("Guild subscriber stopped with error", "err", err)
+		}
+	}()
+	log.Info("Guild subscriber started")
+
 	wsHandler := handler.NewWebSocketHandler(hub, cfg.JWTSecret)
 	wsMux := http.NewServeMux()
 
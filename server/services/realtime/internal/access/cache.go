@@ -0,0 +1,117 @@
+package access
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 権限の変更がこの時間だけ遅れて反映されることを許容する
+	DefaultCacheTTL = 30 * time.Second
+	// これを超えたら期限切れのエントリを掃除する
+	maxCacheEntries = 100000
+)
+
+type Checker interface {
+	FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+type cacheKey struct {
+	userID    uuid.UUID
+	channelID uuid.UUID
+}
+
+type cacheEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// チャンネルごとのアクセス可否を短時間キャッシュし、キャッシュにないものだけをまとめて問い合わせる
+type CachedChecker struct {
+	checker Checker
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+func NewCachedChecker(checker Checker, ttl time.Duration) *CachedChecker {
+	return &CachedChecker{
+		checker: checker,
+		ttl:     ttl,
+		entries: make(map[cacheKey]cacheEntry),
+	}
+}
+
+func (c *CachedChecker) FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error) {
+	now := time.Now()
+	allowed := make(map[uuid.UUID]bool, len(channelIDs))
+	misses := make([]uuid.UUID, 0)
+
+	c.mu.Lock()
+	for _, channelID := range channelIDs {
+		entry, ok := c.entries[cacheKey{userID, channelID}]
+		if ok && now.Before(entry.expiresAt) {
+			allowed[channelID] = entry.allowed
+			continue
+		}
+		misses = append(misses, channelID)
+	}
+	c.mu.Unlock()
+
+	if len(misses) > 0 {
+		accessible, err := c.checker.FilterAccessible(ctx, userID, misses)
+		if err != nil {
+			return nil, err
+		}
+		for _, channelID := range misses {
+			allowed[channelID] = false
+		}
+		for _, channelID := range accessible {
+			allowed[channelID] = true
+		}
+
+		c.mu.Lock()
+		if len(c.entries) > maxCacheEntries {
+			c.removeExpired(now)
+		}
+		expiresAt := now.Add(c.ttl)
+		for _, channelID := range misses {
+			c.entries[cacheKey{userID, channelID}] = cacheEntry{
+				allowed:   allowed[channelID],
+				expiresAt: expiresAt,
+			}
+		}
+		c.mu.Unlock()
+	}
+
+	result := make([]uuid.UUID, 0, len(channelIDs))
+	for _, channelID := range channelIDs {
+		if allowed[channelID] {
+			result = append(result, channelID)
+		}
+	}
+	return result, nil
+}
+
+// ユーザーのキャッシュを捨て、次の問い合わせでguild-serviceに確認させる
+func (c *CachedChecker) Invalidate(userID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.userID == userID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *CachedChecker) removeExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
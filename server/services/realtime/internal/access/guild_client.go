@@ -0,0 +1,85 @@
+package access
+
+import (
+	pb "chat-app-proto/gen/guild"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+type GuildClient struct {
+	client pb.GuildServiceClient
+}
+
+func NewGuildClient(conn *grpc.ClientConn) *GuildClient {
+	return &GuildClient{
+		client: pb.NewGuildServiceClient(conn),
+	}
+}
+
+// channelIDsのうちユーザーがアクセスできるものだけを返す
+func (c *GuildClient) FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error) {
+	channelIDStrs := make([]string, len(channelIDs))
+	for i, id := range channelIDs {
+		channelIDStrs[i] = id.String()
+	}
+
+	res, err := c.client.BatchCheckChannelAccess(ctx, &pb.BatchCheckChannelAccessRequest{
+		UserId:     userID.String(),
+		ChannelIds: channelIDStrs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make([]uuid.UUID, len(res.ChannelIds))
+	for i, idStr := range res.ChannelIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, err
+		}
+		accessible[i] = id
+	}
+	return accessible, nil
+}
+
+// チャンネルにメッセージを送れるかと、タイムアウト中の場合の解除時刻を返す。
+// タイムアウトをすぐに反映するためキャッシュしない
+func (c *GuildClient) CheckSend(ctx context.Context, userID, channelID uuid.UUID) (bool, *time.Time, error) {
+	res, err := c.client.CheckChannelAccess(ctx, &pb.CheckChannelAccessRequest{
+		UserId:    userID.String(),
+		ChannelId: channelID.String(),
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	var disabledUntil *time.Time
+	if res.CommunicationDisabledUntil != nil {
+		until := res.CommunicationDisabledUntil.AsTime()
+		disabledUntil = &until
+	}
+	return res.GetPermissions().GetSendMessages(), disabledUntil, nil
+}
+
+// ユーザーが参加しているギルドのIDを返す
+func (c *GuildClient) ListGuildIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	res, err := c.client.ListUserGuildIDs(ctx, &pb.ListUserGuildIDsRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	guildIDs := make([]uuid.UUID, len(res.GuildIds))
+	for i, idStr := range res.GuildIds {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, err
+		}
+		guildIDs[i] = id
+	}
+	return guildIDs, nil
+}
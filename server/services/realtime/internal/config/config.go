@@ -3,9 +3,10 @@ package config
 import "os"
 
 type Config struct {
-	Port      string
-	RedisAddr string
-	JWTSecret string
+	Port            string
+	RedisAddr       string
+	JWTSecret       string
+	GuildServiceURL string
 }
 
 func Load() *Config {
@@ -13,6 +14,8 @@ func Load() *Config {
 		Port:      getEnv("REALTIME_SERVICE_PORT", "50053"),
 		RedisAddr: getEnv("REDIS_ADDR", "localhost:6379"),
 		JWTSecret: getEnv("JWT_SECRET", "mysecret"),
+
+		GuildServiceURL: getEnv("GUILD_SERVICE_URL", "localhost:50052"),
 	}
 }
 
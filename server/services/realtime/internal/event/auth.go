@@ -13,5 +13,6 @@ type AuthError struct {
 }
 
 type AuthSuccess struct {
-	UserID uuid.UUID `json:"user_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"session_id"`
 }
@@ -0,0 +1,26 @@
+package event
+
+import "time"
+
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidFrame    ErrorCode = "INVALID_FRAME"
+	ErrorCodeEventNotAllowed ErrorCode = "EVENT_NOT_ALLOWED"
+	ErrorCodeInvalidPayload  ErrorCode = "INVALID_PAYLOAD"
+	ErrorCodeForbidden       ErrorCode = "FORBIDDEN"
+	ErrorCodeInternal        ErrorCode = "INTERNAL_ERROR"
+
+	// タイムアウト中で、解除されるまで送れない
+	ErrorCodeCommunicationDisabled ErrorCode = "COMMUNICATION_DISABLED"
+)
+
+// クライアントから届いたフレームを拒否したときに返す
+type Error struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	// 拒否したフレームのtype。フレームを解析できなかった場合は空
+	EventType EventType `json:"event_type,omitempty"`
+	// COMMUNICATION_DISABLEDの場合だけ、タイムアウトが解除される時刻が入る
+	CommunicationDisabledUntil *time.Time `json:"communication_disabled_until,omitempty"`
+}
@@ -8,17 +8,58 @@ const (
 	EventTypeMessageCreated EventType = "MESSAGE_CREATE"
 	EventTypeMessageUpdated EventType = "MESSAGE_UPDATE"
 	EventTypeMessageDeleted EventType = "MESSAGE_DELETE"
+	// 同じチャンネルの複数メッセージがまとめて削除された
+	EventTypeMessagesDeleted EventType = "MESSAGE_DELETE_BULK"
+
+	EventTypeReactionAdded   EventType = "REACTION_ADD"
+	EventTypeReactionRemoved EventType = "REACTION_REMOVE"
+
+	EventTypeChannelPinsUpdated EventType = "CHANNEL_PINS_UPDATE"
+	EventTypeChannelAcked       EventType = "CHANNEL_ACK"
+
+	EventTypeGuildUpdated      EventType = "GUILD_UPDATE"
+	EventTypeGuildDeleted      EventType = "GUILD_DELETE"
+	EventTypeGuildOwnerUpdated EventType = "GUILD_OWNER_UPDATE"
+
+	EventTypeCategoryCreated EventType = "CATEGORY_CREATE"
+	EventTypeCategoryUpdated EventType = "CATEGORY_UPDATE"
+	EventTypeCategoryDeleted EventType = "CATEGORY_DELETE"
+
+	EventTypeChannelCreated EventType = "CHANNEL_CREATE"
+	EventTypeChannelUpdated EventType = "CHANNEL_UPDATE"
+	EventTypeChannelDeleted EventType = "CHANNEL_DELETE"
+	// カテゴリーとチャンネルの並びがまとめて変わった
+	EventTypeChannelsReordered EventType = "CHANNELS_REORDER"
+
+	EventTypeGuildMemberAdded   EventType = "GUILD_MEMBER_ADD"
+	EventTypeGuildMemberRemoved EventType = "GUILD_MEMBER_REMOVE"
+	EventTypeGuildMemberUpdated EventType = "GUILD_MEMBER_UPDATE"
+
+	// ロールや権限の上書きが変わった
+	EventTypePermissionsUpdated EventType = "PERMISSIONS_UPDATE"
 
 	EventTypeSubscribeChannels EventType = "SUBSCRIBE_CHANNELS"
 
+	EventTypeTypingStart EventType = "TYPING_START"
+
+	EventTypePresenceUpdate EventType = "PRESENCE_UPDATE"
+
 	EventTypeAuth        EventType = "AUTH_REQUEST"
 	EventTypeAuthError   EventType = "AUTH_ERROR"
 	EventTypeAuthSuccess EventType = "AUTH_SUCCESS"
+
+	EventTypeResume         EventType = "RESUME"
+	EventTypeResumed        EventType = "RESUMED"
+	EventTypeInvalidSession EventType = "INVALID_SESSION"
+
+	EventTypeError EventType = "ERROR"
 )
 
 type Event struct {
 	Type EventType       `json:"type"`
 	Data json.RawMessage `json:"data"`
+	// Redisから届いたペイロードのハッシュ。同じPublishはどのレプリカでも同じ値になる
+	DedupeKey string `json:"-"`
 }
 
 type EventResponse[T any] struct {
@@ -2,15 +2,17 @@ package event
 
 import "github.com/google/uuid"
 
+// 購読するユーザーは認証済みの接続から決めるので、ペイロードには含めない
 type SubscribeChannels struct {
-	UserID     uuid.UUID   `json:"user_id"`
 	ChannelIDs []uuid.UUID `json:"channel_ids"`
 }
 
-func (e SubscribeChannels) GetChannelIDs() []uuid.UUID {
-	return e.ChannelIDs
+// クライアントが入力中であることを通知する
+type TypingStart struct {
+	ChannelID uuid.UUID `json:"channel_id"`
 }
 
-func (e SubscribeChannels) GetUserID() uuid.UUID {
-	return e.UserID
+// クライアントが自分の状態を変える。offlineはクライアントからは指定できない
+type PresenceUpdate struct {
+	Status PresenceStatus `json:"status"`
 }
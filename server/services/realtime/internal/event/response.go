@@ -16,14 +16,39 @@ type MessageUser struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+type MessageReferencedMessage struct {
+	ID       uuid.UUID    `json:"id"`
+	SenderID *uuid.UUID   `json:"senderId"`
+	Sender   *MessageUser `json:"sender"`
+	Content  string       `json:"content"`
+	Deleted  bool         `json:"deleted"`
+}
+
+type MessageMention struct {
+	// "user" または "channel"
+	Type string    `json:"type"`
+	ID   uuid.UUID `json:"id"`
+}
+
+type MessageAttachment struct {
+	ID          uuid.UUID `json:"id"`
+	Filename    string    `json:"filename"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType"`
+	URL         string    `json:"url"`
+}
+
 type MessageCreatedEvent struct {
-	ID        uuid.UUID   `json:"id"`
-	ChannelID uuid.UUID   `json:"channelId"`
-	SenderID  uuid.UUID   `json:"senderId"`
-	Sender    MessageUser `json:"sender"`
-	Content   string      `json:"content"`
-	ReplyID   *uuid.UUID  `json:"replyId"`
-	CreatedAt time.Time   `json:"createdAt"`
+	ID                uuid.UUID                 `json:"id"`
+	ChannelID         uuid.UUID                 `json:"channelId"`
+	SenderID          uuid.UUID                 `json:"senderId"`
+	Sender            MessageUser               `json:"sender"`
+	Content           string                    `json:"content"`
+	ReplyID           *uuid.UUID                `json:"replyId"`
+	ReferencedMessage *MessageReferencedMessage `json:"referencedMessage"`
+	Mentions          []MessageMention          `json:"mentions"`
+	Attachments       []MessageAttachment       `json:"attachments"`
+	CreatedAt         time.Time                 `json:"createdAt"`
 }
 
 func (e MessageCreatedEvent) GetChannelID() uuid.UUID {
@@ -31,9 +56,11 @@ func (e MessageCreatedEvent) GetChannelID() uuid.UUID {
 }
 
 type MessageUpdatedEvent struct {
-	ID        uuid.UUID `json:"id"`
-	ChannelID uuid.UUID `json:"channelId"`
-	Content   string    `json:"content"`
+	ID        uuid.UUID        `json:"id"`
+	ChannelID uuid.UUID        `json:"channelId"`
+	Content   string           `json:"content"`
+	Mentions  []MessageMention `json:"mentions"`
+	EditedAt  *time.Time       `json:"editedAt"`
 }
 
 func (e MessageUpdatedEvent) GetChannelID() uuid.UUID {
@@ -48,3 +75,194 @@ type MessageDeletedEvent struct {
 func (e MessageDeletedEvent) GetChannelID() uuid.UUID {
 	return e.ChannelID
 }
+
+type MessagesDeletedEvent struct {
+	IDs       []uuid.UUID `json:"ids"`
+	ChannelID uuid.UUID   `json:"channelId"`
+}
+
+func (e MessagesDeletedEvent) GetChannelID() uuid.UUID {
+	return e.ChannelID
+}
+
+type ReactionEvent struct {
+	MessageID uuid.UUID `json:"messageId"`
+	ChannelID uuid.UUID `json:"channelId"`
+	UserID    uuid.UUID `json:"userId"`
+	Emoji     string    `json:"emoji"`
+}
+
+func (e ReactionEvent) GetChannelID() uuid.UUID {
+	return e.ChannelID
+}
+
+type ChannelPinsUpdatedEvent struct {
+	ChannelID uuid.UUID  `json:"channelId"`
+	MessageID uuid.UUID  `json:"messageId"`
+	PinnedAt  *time.Time `json:"pinnedAt"`
+}
+
+func (e ChannelPinsUpdatedEvent) GetChannelID() uuid.UUID {
+	return e.ChannelID
+}
+
+// チャンネルの購読者ではなく、既読にしたユーザー本人に届ける
+type ChannelAckedEvent struct {
+	UserID    uuid.UUID `json:"userId"`
+	ChannelID uuid.UUID `json:"channelId"`
+	MessageID uuid.UUID `json:"messageId"`
+}
+
+func (e ChannelAckedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
+
+// ExpiresAtを過ぎても次のTYPING_STARTが届かなければ、クライアントは表示を消す
+type TypingStartedEvent struct {
+	ChannelID uuid.UUID `json:"channelId"`
+	UserID    uuid.UUID `json:"userId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (e TypingStartedEvent) GetChannelID() uuid.UUID {
+	return e.ChannelID
+}
+
+type GuildUpdatedEvent struct {
+	ID               uuid.UUID  `json:"id"`
+	OwnerID          *uuid.UUID `json:"ownerId,omitempty"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	IconURL          string     `json:"iconUrl"`
+	DefaultChannelID uuid.UUID  `json:"defaultChannelId"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+func (e GuildUpdatedEvent) GetGuildID() uuid.UUID {
+	return e.ID
+}
+
+type GuildDeletedEvent struct {
+	GuildID uuid.UUID `json:"guildId"`
+}
+
+func (e GuildDeletedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type GuildOwnerUpdatedEvent struct {
+	GuildID         uuid.UUID `json:"guildId"`
+	PreviousOwnerID uuid.UUID `json:"previousOwnerId"`
+	OwnerID         uuid.UUID `json:"ownerId"`
+}
+
+func (e GuildOwnerUpdatedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+// CATEGORY_CREATE, CATEGORY_UPDATE, CATEGORY_DELETEで共通
+type CategoryEvent struct {
+	ID        uuid.UUID `json:"id"`
+	GuildID   uuid.UUID `json:"guildId"`
+	Name      string    `json:"name"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (e CategoryEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+// CHANNEL_CREATE, CHANNEL_UPDATE, CHANNEL_DELETEで共通
+type GuildChannelEvent struct {
+	ID         uuid.UUID `json:"id"`
+	GuildID    uuid.UUID `json:"guildId"`
+	CategoryID uuid.UUID `json:"categoryId"`
+	Name       string    `json:"name"`
+	Position   int32     `json:"position"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (e GuildChannelEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type CategoryLayout struct {
+	CategoryID uuid.UUID   `json:"categoryId"`
+	ChannelIDs []uuid.UUID `json:"channelIds"`
+}
+
+// カテゴリーは上から順、チャンネルはそれぞれのカテゴリー内で上から順
+type ChannelsReorderedEvent struct {
+	GuildID    uuid.UUID        `json:"guildId"`
+	Categories []CategoryLayout `json:"categories"`
+}
+
+func (e ChannelsReorderedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type GuildMemberAddedEvent struct {
+	GuildID  uuid.UUID `json:"guildId"`
+	UserID   uuid.UUID `json:"userId"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+func (e GuildMemberAddedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type GuildMemberRemovedEvent struct {
+	GuildID uuid.UUID `json:"guildId"`
+	UserID  uuid.UUID `json:"userId"`
+}
+
+func (e GuildMemberRemovedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+func (e GuildMemberRemovedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
+
+type GuildMemberUpdatedEvent struct {
+	GuildID uuid.UUID `json:"guildId"`
+	UserID  uuid.UUID `json:"userId"`
+	// タイムアウトを解除した場合はnull
+	CommunicationDisabledUntil *time.Time `json:"communicationDisabledUntil"`
+}
+
+func (e GuildMemberUpdatedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type PermissionsUpdatedEvent struct {
+	GuildID uuid.UUID `json:"guildId"`
+	// nullの場合はギルドの全メンバーが対象
+	UserID *uuid.UUID `json:"userId"`
+}
+
+func (e PermissionsUpdatedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
+
+type PresenceStatus string
+
+const (
+	PresenceStatusOnline  PresenceStatus = "online"
+	PresenceStatusIdle    PresenceStatus = "idle"
+	PresenceStatusDnd     PresenceStatus = "dnd"
+	PresenceStatusOffline PresenceStatus = "offline"
+)
+
+// ユーザーと同じギルドのメンバーに、ギルドごとに届ける
+type PresenceUpdatedEvent struct {
+	GuildID   uuid.UUID      `json:"guildId"`
+	UserID    uuid.UUID      `json:"userId"`
+	Status    PresenceStatus `json:"status"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+func (e PresenceUpdatedEvent) GetGuildID() uuid.UUID {
+	return e.GuildID
+}
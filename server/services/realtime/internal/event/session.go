@@ -0,0 +1,22 @@
+package event
+
+import "github.com/google/uuid"
+
+// 切断前のセッションを再開する。AUTH_REQUESTの代わりに最初のフレームとして送る
+type ResumeRequest struct {
+	Token     string    `json:"token"`
+	SessionID uuid.UUID `json:"session_id"`
+	// 最後に受け取ったイベントのseq
+	Seq int64 `json:"seq"`
+}
+
+// この後に、取りこぼしたイベントが元のseqのまま続けて届く
+type Resumed struct {
+	SessionID uuid.UUID `json:"session_id"`
+	Replayed  int       `json:"replayed"`
+}
+
+// セッションを再開できなかった。クライアントはAUTH_REQUESTで接続し直し、状態を取得し直す
+type InvalidSession struct {
+	Message string `json:"message"`
+}
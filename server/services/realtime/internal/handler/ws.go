@@ -44,47 +44,37 @@ func (h *WebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 最初に認証メッセージを受け取るが、それ以外はタイムアウトまで無視する
+	// 最初に認証メッセージ(AUTH_REQUESTまたはRESUME)を受け取るが、それ以外はタイムアウトまで無視する
 	var authEvent event.Event
 	if err := conn.ReadJSON(&authEvent); err != nil {
 		log.Printf("Failed to read auth message: %v", err)
-		_ = conn.WriteJSON(event.EventResponse[event.AuthError]{
-			Type: event.EventTypeAuthError,
-			Data: event.AuthError{Message: "Failed to read auth message"},
-		})
-		_ = conn.Close()
+		rejectAuth(conn, "Failed to read auth message")
 		return
 	}
 
-	if authEvent.Type != event.EventTypeAuth {
+	switch authEvent.Type {
+	case event.EventTypeAuth:
+		h.authenticate(conn, authEvent.Data)
+	case event.EventTypeResume:
+		h.resume(conn, authEvent.Data)
+	default:
 		log.Printf("Invalid auth message type: %s", authEvent.Type)
-		_ = conn.WriteJSON(event.EventResponse[event.AuthError]{
-			Type: event.EventTypeAuthError,
-			Data: event.AuthError{Message: "Invalid auth message"},
-		})
-		_ = conn.Close()
-		return
+		rejectAuth(conn, "Invalid auth message")
 	}
+}
 
+func (h *WebSocketHandler) authenticate(conn *websocket.Conn, data json.RawMessage) {
 	authRequest := event.AuthRequest{}
-	if err := json.Unmarshal(authEvent.Data, &authRequest); err != nil {
+	if err := json.Unmarshal(data, &authRequest); err != nil {
 		log.Printf("Failed to unmarshal auth data: %v", err)
-		_ = conn.WriteJSON(event.EventResponse[event.AuthError]{
-			Type: event.EventTypeAuthError,
-			Data: event.AuthError{Message: "Invalid auth data"},
-		})
-		_ = conn.Close()
+		rejectAuth(conn, "Invalid auth data")
 		return
 	}
 
 	claims, err := auth.ValidateToken(authRequest.Token, h.jwtSecret)
 	if err != nil {
 		log.Printf("Invalid token: %v", err)
-		_ = conn.WriteJSON(event.EventResponse[event.AuthError]{
-			Type: event.EventTypeAuthError,
-			Data: event.AuthError{Message: "Invalid token"},
-		})
-		_ = conn.Close()
+		rejectAuth(conn, "Invalid token")
 		return
 	}
 
@@ -94,21 +84,54 @@ func (h *WebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := conn.WriteJSON(event.EventResponse[event.AuthSuccess]{
-		Type: event.EventTypeAuthSuccess,
-		Data: event.AuthSuccess{UserID: claims.UserID},
-	}); err != nil {
-		log.Printf("Failed to send auth success: %v", err)
+	client, err := h.hub.Connect(conn, claims.UserID)
+	if err != nil {
+		log.Printf("Failed to register client: %v", err)
 		_ = conn.Close()
 		return
 	}
 
-	log.Printf("WebSocket connection established for user: %s", claims.UserID)
+	log.Printf("WebSocket connection established for user: %s (session %s)", claims.UserID, client.SessionID())
+}
 
-	client := hub.NewClient(h.hub, conn, claims.UserID)
+// 再開できなかった場合はINVALID_SESSIONを返して切断する。クライアントはAUTH_REQUESTからやり直す
+func (h *WebSocketHandler) resume(conn *websocket.Conn, data json.RawMessage) {
+	resumeRequest := event.ResumeRequest{}
+	if err := json.Unmarshal(data, &resumeRequest); err != nil {
+		log.Printf("Failed to unmarshal resume data: %v", err)
+		rejectAuth(conn, "Invalid resume data")
+		return
+	}
 
-	h.hub.Register(client)
+	claims, err := auth.ValidateToken(resumeRequest.Token, h.jwtSecret)
+	if err != nil {
+		log.Printf("Invalid token: %v", err)
+		rejectAuth(conn, "Invalid token")
+		return
+	}
+
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		log.Printf("Failed to reset read deadline: %v", err)
+		_ = conn.Close()
+		return
+	}
+
+	if !h.hub.Resume(conn, claims.UserID, resumeRequest.SessionID, resumeRequest.Seq) {
+		_ = conn.WriteJSON(event.EventResponse[event.InvalidSession]{
+			Type: event.EventTypeInvalidSession,
+			Data: event.InvalidSession{Message: "Session cannot be resumed"},
+		})
+		_ = conn.Close()
+		return
+	}
+
+	log.Printf("WebSocket connection resumed for user: %s (session %s)", claims.UserID, resumeRequest.SessionID)
+}
 
-	go client.WritePump()
-	go client.ReadPump()
+func rejectAuth(conn *websocket.Conn, message string) {
+	_ = conn.WriteJSON(event.EventResponse[event.AuthError]{
+		Type: event.EventTypeAuthError,
+		Data: event.AuthError{Message: message},
+	})
+	_ = conn.Close()
 }
@@ -16,52 +16,103 @@ const (
 	pongWait       = 60 * time.Second
 	pingPeriod     = (pongWait * 9) / 10
 	maxMessageSize = 64 << 10
+
+	sendBufferSize = 256
 )
 
+// 1つのセッション。切断されてもResumeWindowの間は残り、再開すると新しい接続がつく
 type Client struct {
-	hub       *Hub
-	conn      *websocket.Conn
-	userID    uuid.UUID
+	hub    *Hub
+	userID uuid.UUID
+	// 同じユーザーの複数の接続を区別し、再開のときにセッションを指定するために使う
+	sessionID uuid.UUID
 	channels  map[uuid.UUID]bool
+	// 参加しているギルド。guild-serviceから取得するまではnil。GuildMembershipのロックで守る
+	guilds         map[uuid.UUID]bool
+	guildsReleased bool
+
+	// チャンネルごとに最後にTYPING_STARTを受け付けた時刻。再開直後は新旧の接続のReadPumpが重なりうるのでロックする
+	typingMu     sync.Mutex
+	typingSentAt map[uuid.UUID]time.Time
+
+	// 以下はHubのループからだけ触る
+	conn  *connection
+	state sessionState
+	// 最後に割り当てたseq
+	seq        int64
+	detachedAt time.Time
+	savedAt    time.Time
+	// PresenceTrackerに接続中として記録しているか
+	online bool
+	// 再開の処理中に届いたイベント。再開が終わったときにseqを割り当てて送る
+	pending         []*event.Event
+	pendingOverflow bool
+	// ストアへの書き込みを捨てたため、保存された内容からは再開できない
+	unresumable bool
+}
+
+func newClient(hub *Hub, userID, sessionID uuid.UUID) *Client {
+	return &Client{
+		hub:          hub,
+		userID:       userID,
+		sessionID:    sessionID,
+		channels:     make(map[uuid.UUID]bool),
+		typingSentAt: make(map[uuid.UUID]time.Time),
+	}
+}
+
+func (c *Client) SessionID() uuid.UUID {
+	return c.sessionID
+}
+
+// 1本のWebSocket接続
+type connection struct {
+	client    *Client
+	ws        *websocket.Conn
 	send      chan []byte
 	closeOnce sync.Once
 }
 
-func NewClient(hub *Hub, conn *websocket.Conn, userID uuid.UUID) *Client {
-	return &Client{
-		hub:      hub,
-		conn:     conn,
-		userID:   userID,
-		channels: make(map[uuid.UUID]bool),
-		send:     make(chan []byte, 256),
+// 再送するイベントが送信キューからあふれないよう、extra分だけキューを広げる
+func newConnection(client *Client, ws *websocket.Conn, extra int) *connection {
+	return &connection{
+		client: client,
+		ws:     ws,
+		send:   make(chan []byte, sendBufferSize+extra),
 	}
 }
 
-func (c *Client) close() {
+func (c *connection) start() {
+	go c.writePump()
+	go c.readPump()
+}
+
+func (c *connection) close() {
 	c.closeOnce.Do(func() {
-		if err := c.conn.Close(); err != nil {
+		if err := c.ws.Close(); err != nil {
 			log.Printf("Error closing connection: %v", err)
 		}
 	})
 }
 
-func (c *Client) ReadPump() {
+// sendを閉じるのはHubだけなので、読み込みが終わったことを必ずunregisterで伝える
+func (c *connection) readPump() {
 	defer func() {
-		c.hub.unregister <- c
+		c.client.hub.unregister <- c
 		c.close()
 	}()
 
-	c.conn.SetReadLimit(maxMessageSize)
-	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+	c.ws.SetReadLimit(maxMessageSize)
+	if err := c.ws.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
 		log.Printf("Error setting read deadline: %v", err)
 		return
 	}
-	c.conn.SetPongHandler(func(string) error {
-		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(pongWait))
 	})
 
 	for {
-		_, message, err := c.conn.ReadMessage()
+		_, message, err := c.ws.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("error: %v", err)
@@ -69,18 +120,42 @@ func (c *Client) ReadPump() {
 			break
 		}
 
-		event := &event.Event{}
-		if err := json.Unmarshal(message, event); err != nil {
+		evt := &event.Event{}
+		if err := json.Unmarshal(message, evt); err != nil {
 			log.Printf("Error unmarshaling message to event: %v", err)
+			c.sendError("", newClientEventError(event.ErrorCodeInvalidFrame, "frame is not a valid event"))
 			continue
 		}
 
-		c.hub.handleEvent(event)
+		c.client.hub.handleClientEvent(c, evt)
+
+	}
+}
+
+// 送信キューが詰まっている場合はエラーを捨てる
+func (c *connection) sendError(eventType event.EventType, clientErr *ClientEventError) {
+	message, err := json.Marshal(event.EventResponse[event.Error]{
+		Type: event.EventTypeError,
+		Data: event.Error{
+			Code:                       clientErr.Code,
+			Message:                    clientErr.Message,
+			EventType:                  eventType,
+			CommunicationDisabledUntil: clientErr.CommunicationDisabledUntil,
+		},
+	})
+	if err != nil {
+		log.Printf("Error marshaling error event: %v", err)
+		return
+	}
 
+	select {
+	case c.send <- message:
+	default:
+		log.Printf("Dropped error event for client %s", c.client.userID)
 	}
 }
 
-func (c *Client) WritePump() {
+func (c *connection) writePump() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
 		ticker.Stop()
@@ -90,18 +165,18 @@ func (c *Client) WritePump() {
 	for {
 		select {
 		case message, ok := <-c.send:
-			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+			if err := c.ws.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 				log.Printf("Error setting write deadline: %v", err)
 				return
 			}
 			if !ok {
-				if err := c.conn.WriteMessage(websocket.CloseMessage, []byte{}); err != nil {
+				if err := c.ws.WriteMessage(websocket.CloseMessage, []byte{}); err != nil {
 					log.Printf("Error writing close message: %v", err)
 				}
 				return
 			}
 
-			w, err := c.conn.NextWriter(websocket.TextMessage)
+			w, err := c.ws.NextWriter(websocket.TextMessage)
 			if err != nil {
 				return
 			}
@@ -126,11 +201,11 @@ func (c *Client) WritePump() {
 				return
 			}
 		case <-ticker.C:
-			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+			if err := c.ws.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 				log.Printf("Error setting write deadline: %v", err)
 				return
 			}
-			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
 		}
@@ -0,0 +1,122 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"realtime-service/internal/event"
+	"time"
+)
+
+const (
+	// 1回のSUBSCRIBE_CHANNELSで指定できるチャンネル数の上限
+	MaxSubscribeChannels = 500
+
+	accessCheckTimeout = 5 * time.Second
+)
+
+// クライアントが送ってよいイベントの一覧。ここにないtypeのフレームはすべて拒否する
+var clientEventTypes = []event.EventType{
+	event.EventTypeSubscribeChannels,
+	event.EventTypeTypingStart,
+	event.EventTypePresenceUpdate,
+}
+
+// クライアントから届いたイベントを、送信元のクライアントと一緒に処理する
+type ClientEventProcessor interface {
+	ProcessClient(*Hub, *Client, *event.Event) error
+}
+
+// 拒否したフレームについてクライアントに返すエラー
+type ClientEventError struct {
+	Code    event.ErrorCode
+	Message string
+	// COMMUNICATION_DISABLEDの場合に、解除される時刻を返す
+	CommunicationDisabledUntil *time.Time
+}
+
+func (e *ClientEventError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newClientEventError(code event.ErrorCode, format string, args ...any) *ClientEventError {
+	return &ClientEventError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+type ClientEventRegistry struct {
+	processors map[event.EventType]ClientEventProcessor
+}
+
+func NewClientEventRegistry() *ClientEventRegistry {
+	registry := &ClientEventRegistry{
+		processors: make(map[event.EventType]ClientEventProcessor),
+	}
+
+	registry.registerDefaultHandlers()
+
+	return registry
+}
+
+func (r *ClientEventRegistry) registerDefaultHandlers() {
+	r.register(event.EventTypeSubscribeChannels, SubscribeChannelsProcessor{})
+	r.register(event.EventTypeTypingStart, TypingStartProcessor{})
+	r.register(event.EventTypePresenceUpdate, PresenceUpdateProcessor{})
+	log.Printf("Registered %d client event processors", len(r.processors))
+}
+
+func (r *ClientEventRegistry) register(eventType event.EventType, processor ClientEventProcessor) {
+	allowed := false
+	for _, t := range clientEventTypes {
+		if t == eventType {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		panic("event type is not allowed from clients: " + string(eventType))
+	}
+	r.processors[eventType] = processor
+}
+
+// 拒否した場合はClientEventErrorを返す
+func (r *ClientEventRegistry) Handle(hub *Hub, client *Client, evt *event.Event) error {
+	processor, ok := r.processors[evt.Type]
+	if !ok {
+		return newClientEventError(event.ErrorCodeEventNotAllowed, "event type %q is not allowed", evt.Type)
+	}
+
+	return processor.ProcessClient(hub, client, evt)
+}
+
+// guild-serviceでアクセスが許可されたチャンネルだけを購読する
+type SubscribeChannelsProcessor struct{}
+
+func (p SubscribeChannelsProcessor) ProcessClient(hub *Hub, client *Client, evt *event.Event) error {
+	var req event.SubscribeChannels
+	if err := json.Unmarshal(evt.Data, &req); err != nil {
+		return newClientEventError(event.ErrorCodeInvalidPayload, "invalid SUBSCRIBE_CHANNELS payload")
+	}
+	if len(req.ChannelIDs) > MaxSubscribeChannels {
+		return newClientEventError(event.ErrorCodeInvalidPayload, "too many channels to subscribe: %d", len(req.ChannelIDs))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), accessCheckTimeout)
+	defer cancel()
+	allowed, err := hub.access.FilterAccessible(ctx, client.userID, req.ChannelIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, channelID := range allowed {
+		hub.subscriptions.SubscribeChannel(client, channelID)
+	}
+	// 別のレプリカで再開したときに同じチャンネルを購読し直せるよう保存する
+	hub.do(func() { hub.saveSession(client) })
+	log.Printf("User %s subscribed to %d channels (%d denied)", client.userID, len(allowed), len(req.ChannelIDs)-len(allowed))
+
+	return nil
+}
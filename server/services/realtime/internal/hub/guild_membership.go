@@ -0,0 +1,114 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// このレプリカにあるセッションが、どのギルドのイベントを受け取るかを管理する
+type GuildMembership struct {
+	GuildMembers map[uuid.UUID]map[*Client]bool
+	mu           sync.RWMutex
+}
+
+func NewGuildMembership() *GuildMembership {
+	return &GuildMembership{
+		GuildMembers: make(map[uuid.UUID]map[*Client]bool),
+	}
+}
+
+// guild-serviceから取得したギルドの一覧でセッションの参加ギルドを置き換える
+func (gm *GuildMembership) Set(client *Client, guildIDs []uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	// 一覧の取得中に破棄されたセッションは登録しない
+	if client.guildsReleased {
+		return
+	}
+	gm.removeAllLocked(client)
+	client.guilds = make(map[uuid.UUID]bool, len(guildIDs))
+	for _, guildID := range guildIDs {
+		gm.addLocked(client, guildID)
+	}
+}
+
+func (gm *GuildMembership) Add(client *Client, guildID uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	if client.guilds == nil {
+		// まだ一覧を取得していないセッションは、取得したときに反映される
+		return
+	}
+	gm.addLocked(client, guildID)
+}
+
+func (gm *GuildMembership) addLocked(client *Client, guildID uuid.UUID) {
+	if gm.GuildMembers[guildID] == nil {
+		gm.GuildMembers[guildID] = make(map[*Client]bool)
+	}
+	gm.GuildMembers[guildID][client] = true
+	client.guilds[guildID] = true
+}
+
+func (gm *GuildMembership) Remove(client *Client, guildID uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	if members, ok := gm.GuildMembers[guildID]; ok {
+		delete(members, client)
+		if len(members) == 0 {
+			delete(gm.GuildMembers, guildID)
+		}
+	}
+	delete(client.guilds, guildID)
+}
+
+// セッションを破棄するときに呼ぶ。以降はSetしても登録されない
+func (gm *GuildMembership) RemoveAll(client *Client) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+
+	gm.removeAllLocked(client)
+	client.guildsReleased = true
+}
+
+func (gm *GuildMembership) removeAllLocked(client *Client) {
+	for guildID := range client.guilds {
+		if members, ok := gm.GuildMembers[guildID]; ok {
+			delete(members, client)
+			if len(members) == 0 {
+				delete(gm.GuildMembers, guildID)
+			}
+		}
+	}
+}
+
+// 一覧をまだ取得していない場合はfalseを返す
+func (gm *GuildMembership) GetGuilds(client *Client) ([]uuid.UUID, bool) {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+
+	if client.guilds == nil {
+		return nil, false
+	}
+	guildIDs := make([]uuid.UUID, 0, len(client.guilds))
+	for guildID := range client.guilds {
+		guildIDs = append(guildIDs, guildID)
+	}
+	return guildIDs, true
+}
+
+func (gm *GuildMembership) GetMembers(guildID uuid.UUID) []*Client {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+
+	members := gm.GuildMembers[guildID]
+	clients := make([]*Client, 0, len(members))
+	for client := range members {
+		clients = append(clients, client)
+	}
+	return clients
+}
@@ -30,42 +30,166 @@ func (p MessageEventProcessor[T]) Process(hub *Hub, evt *event.Event) error {
 	return nil
 }
 
-type SubscribeChannelsEvent interface {
+type UserEvent interface {
 	GetUserID() uuid.UUID
-	GetChannelIDs() []uuid.UUID
 }
 
-type SubscribeChannelsEventProcessor[T SubscribeChannelsEvent] struct{}
+type UserEventProcessor[T UserEvent] struct{}
 
-func (p SubscribeChannelsEventProcessor[T]) Process(hub *Hub, evt *event.Event) error {
+func (p UserEventProcessor[T]) Process(hub *Hub, evt *event.Event) error {
 	var e T
 	if err := json.Unmarshal(evt.Data, &e); err != nil {
 		return err
 	}
 
-	hub.mu.RLock()
-	client, ok := hub.clients[e.GetUserID()]
-	hub.mu.RUnlock()
+	hub.sendToUser(e.GetUserID(), evt)
+	return nil
+}
 
-	if !ok {
-		log.Printf("Client not found for user ID: %s", e.GetUserID())
-		return errors.New("client not found for user ID: " + e.GetUserID().String())
+// 参加したユーザーのセッションにもギルドのイベントが届くようにしてから、メンバーに知らせる
+type GuildMemberAddedProcessor struct{}
+
+func (p GuildMemberAddedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.GuildMemberAddedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.access.Invalidate(e.UserID)
+	for _, client := range hub.userSessions(e.UserID) {
+		hub.guilds.Add(client, e.GuildID)
+	}
+	hub.sendToGuild(e.GuildID, evt)
+	return nil
+}
+
+// 抜けたユーザー本人を含むメンバーに知らせてから、そのユーザーの購読を確認し直す
+// GUILD_MEMBER_REMOVEはguild-serviceのmember_usecase(キック・脱退)とban_usecaseが発行する。
+// 購読の確認し直しはguild-serviceのBatchCheckChannelAccessによる権限チェックに依存するため、
+// 発行側がメンバー削除をコミットした後にイベントを送ることが前提になる
+type GuildMemberRemovedProcessor struct{}
+
+func (p GuildMemberRemovedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.GuildMemberRemovedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.sendToGuild(e.GuildID, evt)
+	hub.access.Invalidate(e.UserID)
+	for _, client := range hub.userSessions(e.UserID) {
+		hub.guilds.Remove(client, e.GuildID)
+	}
+	// guild-serviceへの問い合わせでHubのループを止めないよう別goroutineで行う
+	go hub.revalidateSubscriptions(e.UserID)
+	return nil
+}
+
+// メンバーに知らせてから、すべてのセッションからギルドを外し、ギルドのチャンネルの購読を確認し直す
+type GuildDeletedProcessor struct{}
+
+func (p GuildDeletedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.GuildDeletedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.sendToGuild(e.GuildID, evt)
+
+	userIDs := make(map[uuid.UUID]bool)
+	for _, client := range hub.guilds.GetMembers(e.GuildID) {
+		hub.guilds.Remove(client, e.GuildID)
+		userIDs[client.userID] = true
+	}
+	for userID := range userIDs {
+		hub.access.Invalidate(userID)
+		go hub.revalidateSubscriptions(userID)
 	}
+	return nil
+}
+
+// オーナーは常にすべての権限を持つので、新旧のオーナーのキャッシュした権限を捨てる
+type GuildOwnerUpdatedProcessor struct{}
+
+func (p GuildOwnerUpdatedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.GuildOwnerUpdatedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.access.Invalidate(e.PreviousOwnerID)
+	hub.access.Invalidate(e.OwnerID)
+	hub.sendToGuild(e.GuildID, evt)
+	// 前のオーナーは見られなくなったチャンネルがあるかもしれない
+	go hub.revalidateSubscriptions(e.PreviousOwnerID)
+	return nil
+}
+
+// 対象のユーザーのキャッシュした権限を捨て、見られなくなったチャンネルの購読を解除する
+type PermissionsUpdatedProcessor struct{}
+
+func (p PermissionsUpdatedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.PermissionsUpdatedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	var userIDs []uuid.UUID
+	if e.UserID != nil {
+		userIDs = []uuid.UUID{*e.UserID}
+	} else {
+		seen := make(map[uuid.UUID]bool)
+		for _, client := range hub.guilds.GetMembers(e.GuildID) {
+			if !seen[client.userID] {
+				seen[client.userID] = true
+				userIDs = append(userIDs, client.userID)
+			}
+		}
+	}
+
+	for _, userID := range userIDs {
+		hub.access.Invalidate(userID)
+	}
+	hub.sendToGuild(e.GuildID, evt)
+	// guild-serviceへの問い合わせでHubのループを止めないよう別goroutineで行う
+	go func() {
+		for _, userID := range userIDs {
+			hub.revalidateSubscriptions(userID)
+		}
+	}()
+	return nil
+}
 
-	for _, channelID := range e.GetChannelIDs() {
-		hub.subscriptions.SubscribeChannel(client, channelID)
-		log.Printf("User %s subscribed to channel %s", e.GetUserID(), channelID)
+// メンバーに知らせてから、削除されたチャンネルの購読を解除する
+type ChannelDeletedProcessor struct{}
+
+func (p ChannelDeletedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.GuildChannelEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
 	}
 
+	hub.sendToGuild(e.GuildID, evt)
+
+	subscribers := hub.subscriptions.GetSubscribers(e.ID)
+	clients := make([]*Client, 0, len(subscribers))
+	for client := range subscribers {
+		clients = append(clients, client)
+	}
+	for _, client := range clients {
+		hub.subscriptions.UnsubscribeChannel(client, e.ID)
+		hub.saveSession(client)
+	}
 	return nil
 }
 
-type EventHandlerRegistry struct {
+// Redisから届いたサーバー側のイベントだけを扱う。クライアントからのフレームはClientEventRegistryで扱う
+type ServerEventRegistry struct {
 	processors map[event.EventType]EventProcessor
 }
 
-func NewEventHandlerRegistry() *EventHandlerRegistry {
-	registry := &EventHandlerRegistry{
+func NewServerEventRegistry() *ServerEventRegistry {
+	registry := &ServerEventRegistry{
 		processors: make(map[event.EventType]EventProcessor),
 	}
 
@@ -74,16 +198,35 @@ func NewEventHandlerRegistry() *EventHandlerRegistry {
 	return registry
 }
 
-func (r *EventHandlerRegistry) registerDefaultHandlers() {
+func (r *ServerEventRegistry) registerDefaultHandlers() {
 	r.processors[event.EventTypeMessageCreated] = MessageEventProcessor[event.MessageCreatedEvent]{}
 	r.processors[event.EventTypeMessageUpdated] = MessageEventProcessor[event.MessageUpdatedEvent]{}
 	r.processors[event.EventTypeMessageDeleted] = MessageEventProcessor[event.MessageDeletedEvent]{}
-
-	r.processors[event.EventTypeSubscribeChannels] = SubscribeChannelsEventProcessor[event.SubscribeChannels]{}
-	log.Printf("Registered %d event processors", len(r.processors))
+	r.processors[event.EventTypeMessagesDeleted] = MessageEventProcessor[event.MessagesDeletedEvent]{}
+	r.processors[event.EventTypeReactionAdded] = MessageEventProcessor[event.ReactionEvent]{}
+	r.processors[event.EventTypeReactionRemoved] = MessageEventProcessor[event.ReactionEvent]{}
+	r.processors[event.EventTypeChannelPinsUpdated] = MessageEventProcessor[event.ChannelPinsUpdatedEvent]{}
+	r.processors[event.EventTypeChannelAcked] = UserEventProcessor[event.ChannelAckedEvent]{}
+	r.processors[event.EventTypeTypingStart] = TypingStartedProcessor{}
+	r.processors[event.EventTypeGuildUpdated] = GuildEventProcessor[event.GuildUpdatedEvent]{}
+	r.processors[event.EventTypeGuildDeleted] = GuildDeletedProcessor{}
+	r.processors[event.EventTypeGuildOwnerUpdated] = GuildOwnerUpdatedProcessor{}
+	r.processors[event.EventTypeCategoryCreated] = GuildEventProcessor[event.CategoryEvent]{}
+	r.processors[event.EventTypeCategoryUpdated] = GuildEventProcessor[event.CategoryEvent]{}
+	r.processors[event.EventTypeCategoryDeleted] = GuildEventProcessor[event.CategoryEvent]{}
+	r.processors[event.EventTypeChannelCreated] = GuildEventProcessor[event.GuildChannelEvent]{}
+	r.processors[event.EventTypeChannelUpdated] = GuildEventProcessor[event.GuildChannelEvent]{}
+	r.processors[event.EventTypeChannelsReordered] = GuildEventProcessor[event.ChannelsReorderedEvent]{}
+	r.processors[event.EventTypeChannelDeleted] = ChannelDeletedProcessor{}
+	r.processors[event.EventTypeGuildMemberAdded] = GuildMemberAddedProcessor{}
+	r.processors[event.EventTypeGuildMemberRemoved] = GuildMemberRemovedProcessor{}
+	r.processors[event.EventTypeGuildMemberUpdated] = GuildEventProcessor[event.GuildMemberUpdatedEvent]{}
+	r.processors[event.EventTypePermissionsUpdated] = PermissionsUpdatedProcessor{}
+	r.processors[event.EventTypePresenceUpdate] = GuildEventProcessor[event.PresenceUpdatedEvent]{}
+	log.Printf("Registered %d server event processors", len(r.processors))
 }
 
-func (r *EventHandlerRegistry) Handle(hub *Hub, evt *event.Event) error {
+func (r *ServerEventRegistry) Handle(hub *Hub, evt *event.Event) error {
 	processor, ok := r.processors[evt.Type]
 	if !ok {
 		log.Printf("No processor registered for event type: %s", evt.Type)
@@ -1,78 +1,364 @@
 package hub
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"realtime-service/internal/event"
 	"realtime-service/internal/metrics"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 )
 
+// 他のレプリカにもイベントを届けるための配信先
+type Publisher interface {
+	PublishToChannel(ctx context.Context, channelID uuid.UUID, evt *event.Event) error
+	PublishToGuild(ctx context.Context, guildID uuid.UUID, evt *event.Event) error
+}
+
+// チャンネルのアクセス権を確認する。結果はキャッシュされてもよい
+type AccessChecker interface {
+	FilterAccessible(ctx context.Context, userID uuid.UUID, channelIDs []uuid.UUID) ([]uuid.UUID, error)
+	Invalidate(userID uuid.UUID)
+}
+
+// チャンネルに書き込めるかを確認する。タイムアウト中の場合は解除時刻も返す
+type SendChecker interface {
+	CheckSend(ctx context.Context, userID, channelID uuid.UUID) (bool, *time.Time, error)
+}
+
 type Hub struct {
-	mu            sync.RWMutex
-	clients       map[uuid.UUID]*Client
+	mu sync.RWMutex
+	// ユーザーIDごとに、セッションIDをキーとしたセッション。切断中で再開を待っているものも含む
+	sessions      map[uuid.UUID]map[uuid.UUID]*Client
 	subscriptions *SubscriptionManager
-	handlers      *EventHandlerRegistry
-	register      chan *Client
-	unregister    chan *Client
+	guilds        *GuildMembership
+	handlers      *ServerEventRegistry
+	clientEvents  *ClientEventRegistry
+	exec          chan func()
+	unregister    chan *connection
 	broadcast     chan *event.Event
+	presenceOps   chan func()
 	metrics       *metrics.WebSocketMetrics
+	publisher     Publisher
+	access        AccessChecker
+	sendChecker   SendChecker
+	guildLister   GuildLister
+	store         SessionStore
+	presence      PresenceTracker
+}
+
+type NewHubParams struct {
+	Metrics     *metrics.WebSocketMetrics
+	Publisher   Publisher
+	Access      AccessChecker
+	SendChecker SendChecker
+	GuildLister GuildLister
+	Store       SessionStore
+	Presence    PresenceTracker
 }
 
-func NewHub(wsMetrics *metrics.WebSocketMetrics) *Hub {
+func NewHub(params *NewHubParams) *Hub {
 	return &Hub{
-		clients:       make(map[uuid.UUID]*Client),
+		sessions:      make(map[uuid.UUID]map[uuid.UUID]*Client),
 		subscriptions: NewSubscriptionManager(),
-		handlers:      NewEventHandlerRegistry(),
-		register:      make(chan *Client),
-		unregister:    make(chan *Client),
+		guilds:        NewGuildMembership(),
+		handlers:      NewServerEventRegistry(),
+		clientEvents:  NewClientEventRegistry(),
+		exec:          make(chan func()),
+		unregister:    make(chan *connection),
 		broadcast:     make(chan *event.Event),
-		metrics:       wsMetrics,
+		presenceOps:   make(chan func(), presenceQueueSize),
+		metrics:       params.Metrics,
+		publisher:     params.Publisher,
+		access:        params.Access,
+		sendChecker:   params.SendChecker,
+		guildLister:   params.GuildLister,
+		store:         params.Store,
+		presence:      params.Presence,
 	}
 }
 
 func (h *Hub) Run() {
+	go h.runPresence()
+
+	ticker := time.NewTicker(sessionSweepInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
-		case client := <-h.register:
-			h.mu.Lock()
-			h.clients[client.userID] = client
-			h.mu.Unlock()
-
-			h.metrics.ActiveConnections.Inc()
-			h.metrics.TotalConnections.Inc()
-
-			log.Printf("Client registered: %s", client.userID)
-		case client := <-h.unregister:
-			func() {
-				h.mu.Lock()
-				defer h.mu.Unlock()
-				if _, ok := h.clients[client.userID]; ok {
-					delete(h.clients, client.userID)
-					h.subscriptions.UnsubscribeAll(client)
-					close(client.send)
-					h.metrics.ActiveConnections.Dec()
-
-					log.Printf("Client unregistered: %s", client.userID)
-				}
-			}()
+		case fn := <-h.exec:
+			fn()
+		case conn := <-h.unregister:
+			h.handleDisconnect(conn)
 		case event := <-h.broadcast:
 			h.metrics.MessageReceived.Inc()
 			h.handleEvent(event)
+		case <-ticker.C:
+			h.sweepSessions()
 		}
 	}
 }
 
+// fnをHubのループの中で実行し、終わるまで待つ。Hubのループの中から呼んではいけない
+func (h *Hub) do(fn func()) {
+	done := make(chan struct{})
+	h.exec <- func() {
+		fn()
+		close(done)
+	}
+	<-done
+}
+
 func (h *Hub) handleEvent(evt *event.Event) {
 	if err := h.handlers.Handle(h, evt); err != nil {
 		log.Printf("Error handling event %s: %v", evt.Type, err)
 	}
 }
 
+// 拒否したフレームには、理由を表すERRORイベントを送信元にだけ返す
+func (h *Hub) handleClientEvent(conn *connection, evt *event.Event) {
+	client := conn.client
+	err := h.clientEvents.Handle(h, client, evt)
+	if err == nil {
+		return
+	}
+	log.Printf("Rejected client event %s from %s: %v", evt.Type, client.userID, err)
+
+	var clientErr *ClientEventError
+	if !errors.As(err, &clientErr) {
+		clientErr = newClientEventError(event.ErrorCodeInternal, "failed to process event")
+	}
+	conn.sendError(evt.Type, clientErr)
+}
+
+// 新しいセッションを作り、AUTH_SUCCESSを送ってから接続の読み書きを始める
+func (h *Hub) Connect(ws *websocket.Conn, userID uuid.UUID) (*Client, error) {
+	client := newClient(h, userID, uuid.New())
+
+	authSuccess, err := json.Marshal(event.EventResponse[event.AuthSuccess]{
+		Type: event.EventTypeAuthSuccess,
+		Data: event.AuthSuccess{
+			UserID:    userID,
+			SessionID: client.sessionID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	conn := newConnection(client, ws, 0)
+	conn.send <- authSuccess
+
+	h.do(func() {
+		h.addSession(client)
+		h.attach(client, conn)
+		h.saveSession(client)
+		h.sessionOnline(client)
+		conn.start()
+	})
+	h.metrics.TotalConnections.Inc()
+
+	log.Printf("Client registered: user %s session %s", client.userID, client.sessionID)
+	return client, nil
+}
+
+func (h *Hub) addSession(client *Client) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sessions, ok := h.sessions[client.userID]
+	if !ok {
+		sessions = make(map[uuid.UUID]*Client)
+		h.sessions[client.userID] = sessions
+		h.metrics.ActiveUsers.Inc()
+	}
+	if _, exists := sessions[client.sessionID]; exists {
+		return false
+	}
+	sessions[client.sessionID] = client
+	return true
+}
+
+// セッションを破棄する。以降このセッションは再開できない
+func (h *Hub) removeSession(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	client.state = sessionClosed
+	client.pending = nil
+	h.detachConn(client)
+	h.subscriptions.UnsubscribeAll(client)
+	h.guilds.RemoveAll(client)
+	h.sessionOffline(client)
+
+	sessions := h.sessions[client.userID]
+	if sessions[client.sessionID] != client {
+		return
+	}
+	delete(sessions, client.sessionID)
+	if len(sessions) == 0 {
+		delete(h.sessions, client.userID)
+		h.metrics.ActiveUsers.Dec()
+	}
+
+	log.Printf("Session removed: user %s session %s", client.userID, client.sessionID)
+}
+
+func (h *Hub) getSession(userID, sessionID uuid.UUID) *Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.sessions[userID][sessionID]
+}
+
+func (h *Hub) attach(client *Client, conn *connection) {
+	client.conn = conn
+	client.state = sessionAttached
+	h.metrics.ActiveConnections.Inc()
+}
+
+// 接続だけを閉じる。sendはreadPumpが終わった後にhandleDisconnectで閉じる
+func (h *Hub) detachConn(client *Client) {
+	if client.conn == nil {
+		return
+	}
+	client.conn.close()
+	client.conn = nil
+	h.metrics.ActiveConnections.Dec()
+}
+
+// readPumpが終わった接続の後始末をする。セッションはResumeWindowの間、再開を待つ
+func (h *Hub) handleDisconnect(conn *connection) {
+	close(conn.send)
+
+	client := conn.client
+	// 再開や引き継ぎで既に外された接続
+	if client.conn != conn {
+		return
+	}
+	h.detachConn(client)
+	// 再開できないセッションは待たずに破棄する
+	if client.unresumable {
+		h.removeSession(client)
+		return
+	}
+	client.state = sessionDetached
+	client.detachedAt = time.Now()
+	h.saveSession(client)
+
+	log.Printf("Client detached: user %s session %s (seq %d)", client.userID, client.sessionID, client.seq)
+}
+
+// 再開を待つ時間を過ぎたセッションを破棄し、接続中のセッションの保存期限とオンライン状態の期限を延ばす
+func (h *Hub) sweepSessions() {
+	now := time.Now()
+
+	h.mu.RLock()
+	var expired, away, stale []*Client
+	for _, sessions := range h.sessions {
+		for _, client := range sessions {
+			switch {
+			case client.state == sessionDetached && now.Sub(client.detachedAt) > ResumeWindow:
+				expired = append(expired, client)
+			case client.state == sessionDetached && client.online && now.Sub(client.detachedAt) > PresenceGracePeriod:
+				away = append(away, client)
+			case client.state == sessionAttached && now.Sub(client.savedAt) > ResumeWindow/2:
+				stale = append(stale, client)
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, client := range expired {
+		h.removeSession(client)
+	}
+	for _, client := range away {
+		h.sessionOffline(client)
+	}
+	for _, client := range stale {
+		h.saveSession(client)
+	}
+	h.presenceHeartbeat()
+}
+
+// 他のレプリカで再開できるよう、セッションの状態をストアに保存する
+func (h *Hub) saveSession(client *Client) {
+	if client.state == sessionClosed {
+		return
+	}
+	client.savedAt = time.Now()
+	saved := h.store.SaveSession(&SessionState{
+		SessionID:  client.sessionID,
+		UserID:     client.userID,
+		ChannelIDs: h.subscriptions.GetChannels(client),
+	})
+	if !saved {
+		h.storeDropped(client)
+	}
+}
+
+// ストアへの書き込みを捨てたセッションは、保存された内容が欠けているので再開できないようにする
+func (h *Hub) storeDropped(client *Client) {
+	h.metrics.SessionStoreDrops.Inc()
+	if client.unresumable {
+		return
+	}
+	client.unresumable = true
+	log.Printf("Session store queue is full, session will not be resumable: user %s session %s", client.userID, client.sessionID)
+}
+
+// seqを割り当ててストアに積み、接続中であれば送る。再開の処理中はpendingに溜める
+func (h *Hub) dispatch(client *Client, evt *event.Event, body []byte) {
+	switch client.state {
+	case sessionClosed:
+		return
+	case sessionResuming:
+		if len(client.pending) >= maxPendingEvents {
+			client.pendingOverflow = true
+			return
+		}
+		client.pending = append(client.pending, evt)
+		return
+	}
+
+	client.seq++
+	frame := dispatchFrame(body, client.seq)
+	if !h.store.Append(client.sessionID, client.seq, evt.DedupeKey, frame) {
+		h.storeDropped(client)
+	}
+
+	if client.conn == nil {
+		return
+	}
+	select {
+	case client.conn.send <- frame:
+		h.metrics.MessageSent.Inc()
+	default:
+		h.dropSlowClient(client)
+	}
+}
+
+// bodyはseqを含まないイベントのJSON。イベントごとに1回だけMarshalし、セッションごとにseqを差し込む
+func dispatchFrame(body []byte, seq int64) []byte {
+	frame := make([]byte, 0, len(body)+24)
+	frame = append(frame, `{"seq":`...)
+	frame = strconv.AppendInt(frame, seq, 10)
+	frame = append(frame, ',')
+	return append(frame, body[1:]...)
+}
+
 func (h *Hub) broadcastToChannel(channelID uuid.UUID, evt *event.Event) {
-	message, err := json.Marshal(evt)
+	h.broadcastToChannelExcept(channelID, evt, uuid.Nil)
+}
+
+// excludeUserIDのユーザーには送らない。uuid.Nilの場合は全員に送る
+func (h *Hub) broadcastToChannelExcept(channelID uuid.UUID, evt *event.Event, excludeUserID uuid.UUID) {
+	body, err := json.Marshal(evt)
 	if err != nil {
 		log.Printf("Error marshaling event: %v", err)
 		return
@@ -85,23 +371,93 @@ func (h *Hub) broadcastToChannel(channelID uuid.UUID, evt *event.Event) {
 	}
 
 	for client := range subscribers {
-		select {
-		case client.send <- message:
-			h.metrics.MessageSent.Inc()
-		default:
-			close(client.send)
-			h.mu.Lock()
-			delete(h.clients, client.userID)
-			h.mu.Unlock()
-
-			h.subscriptions.UnsubscribeAll(client)
-			log.Printf("Failed to send to client %s, cleaned up", client.userID)
+		if client.userID == excludeUserID {
+			continue
 		}
+		h.dispatch(client, evt, body)
 	}
 
 	log.Printf("Broadcasted event %s to %d subscribers in channel %s", evt.Type, len(subscribers), channelID)
 }
 
+// ユーザーがこのレプリカに持っているすべてのセッションを返す
+func (h *Hub) userSessions(userID uuid.UUID) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	sessions := h.sessions[userID]
+	clients := make([]*Client, 0, len(sessions))
+	for _, client := range sessions {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// このレプリカにあるユーザーのセッションにだけ送る。他のレプリカのセッションにはそれぞれのSubscriberが届ける
+func (h *Hub) sendToUser(userID uuid.UUID, evt *event.Event) {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		log.Printf("Error marshaling event: %v", err)
+		return
+	}
+
+	for _, client := range h.userSessions(userID) {
+		h.dispatch(client, evt, body)
+	}
+}
+
+// アクセスできなくなったチャンネルの購読を、ユーザーのすべてのセッションで解除する。
+// 権限の問い合わせはHubのループの外で行い、購読の解除だけをループの中で行う
+func (h *Hub) revalidateSubscriptions(userID uuid.UUID) {
+	for _, client := range h.userSessions(userID) {
+		h.revalidateClientSubscriptions(client)
+	}
+}
+
+func (h *Hub) revalidateClientSubscriptions(client *Client) {
+	userID := client.userID
+	channelIDs := h.subscriptions.GetChannels(client)
+	if len(channelIDs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), accessCheckTimeout)
+	defer cancel()
+	allowed, err := h.access.FilterAccessible(ctx, userID, channelIDs)
+	if err != nil {
+		log.Printf("Failed to revalidate subscriptions for %s: %v", userID, err)
+		return
+	}
+
+	allowedSet := make(map[uuid.UUID]bool, len(allowed))
+	for _, channelID := range allowed {
+		allowedSet[channelID] = true
+	}
+	var removed []uuid.UUID
+	for _, channelID := range channelIDs {
+		if !allowedSet[channelID] {
+			removed = append(removed, channelID)
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+
+	h.do(func() {
+		for _, channelID := range removed {
+			h.subscriptions.UnsubscribeChannel(client, channelID)
+			log.Printf("Client %s (session %s) unsubscribed from channel %s", userID, client.sessionID, channelID)
+		}
+		h.saveSession(client)
+	})
+}
+
+// 送信キューが詰まった接続を切断する。セッションは残るので、クライアントは再開して取りこぼしを受け取れる
+func (h *Hub) dropSlowClient(client *Client) {
+	client.conn.close()
+	log.Printf("Failed to send to client %s (session %s), disconnected", client.userID, client.sessionID)
+}
+
 func (h *Hub) SubscribeClientToChannel(client *Client, channelID uuid.UUID) {
 	h.subscriptions.SubscribeChannel(client, channelID)
 	log.Printf("Client %s subscribed to channel %s", client.userID, channelID)
@@ -110,7 +466,3 @@ func (h *Hub) SubscribeClientToChannel(client *Client, channelID uuid.UUID) {
 func (h *Hub) Broadcast(evt *event.Event) {
 	h.broadcast <- evt
 }
-
-func (h *Hub) Register(client *Client) {
-	h.register <- client
-}
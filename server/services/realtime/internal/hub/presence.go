@@ -0,0 +1,225 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"realtime-service/internal/event"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// この時間内にハートビートが来なければ、そのセッションはオフラインとみなされる
+	PresenceTTL = 60 * time.Second
+	// 切断からこの時間が経っても再開されなければ、セッションをオフラインにする
+	PresenceGracePeriod = 30 * time.Second
+
+	presenceQueueSize = 1024
+	presenceTimeout   = 5 * time.Second
+)
+
+// ユーザーのオンライン状態をレプリカをまたいで記録する
+type PresenceTracker interface {
+	// セッションを接続中として記録する。ユーザーがオフラインからオンラインになった場合はtrueを返す
+	Connect(ctx context.Context, userID, sessionID uuid.UUID) (event.PresenceStatus, bool, error)
+	// ユーザーの接続中のセッションが1つもなくなった場合はtrueを返す
+	Disconnect(ctx context.Context, userID, sessionID uuid.UUID) (bool, error)
+	// ユーザーIDをキーに、接続中のセッションの期限を延ばす
+	Heartbeat(ctx context.Context, sessions map[uuid.UUID][]uuid.UUID) error
+	// ユーザーが選んだ状態を保存する。他のユーザーから見える状態が変わった場合はtrueを返す
+	SetStatus(ctx context.Context, userID uuid.UUID, status event.PresenceStatus) (bool, error)
+}
+
+// ユーザーが参加しているギルドを調べる
+type GuildLister interface {
+	ListGuildIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Redisへの書き込みを、積んだ順に1つのgoroutineで実行する。接続と切断の順序が入れ替わらないようにするため
+func (h *Hub) runPresence() {
+	for op := range h.presenceOps {
+		op()
+	}
+}
+
+// Hubのループやh.muを持ったまま呼ばれるので待たない。キューが埋まっている場合は積まずにfalseを返す
+func (h *Hub) enqueuePresence(op func()) bool {
+	select {
+	case h.presenceOps <- op:
+		return true
+	default:
+		return false
+	}
+}
+
+// セッションが接続されたときに呼ぶ。Hubのループの中で呼ぶ
+func (h *Hub) sessionOnline(client *Client) {
+	if client.online {
+		return
+	}
+	client.online = true
+
+	queued := h.enqueuePresence(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+		defer cancel()
+
+		if _, loaded := h.guilds.GetGuilds(client); !loaded {
+			guildIDs, err := h.guildLister.ListGuildIDs(ctx, client.userID)
+			if err != nil {
+				log.Printf("Failed to list guilds for %s: %v", client.userID, err)
+				guildIDs = []uuid.UUID{}
+			}
+			h.guilds.Set(client, guildIDs)
+		}
+
+		status, becameOnline, err := h.presence.Connect(ctx, client.userID, client.sessionID)
+		if err != nil {
+			log.Printf("Failed to record presence for %s: %v", client.userID, err)
+			return
+		}
+		if becameOnline {
+			h.publishPresence(ctx, client, status)
+		}
+	})
+	if !queued {
+		// 次に接続か再開されたときにやり直す
+		client.online = false
+		log.Printf("Presence queue is full, dropped online for %s (session %s)", client.userID, client.sessionID)
+	}
+}
+
+// セッションが切断されたまま猶予を過ぎたとき、または破棄されたときに呼ぶ。Hubのループの中で呼ぶ
+func (h *Hub) sessionOffline(client *Client) {
+	if !client.online {
+		return
+	}
+	client.online = false
+
+	queued := h.enqueuePresence(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+		defer cancel()
+
+		wentOffline, err := h.presence.Disconnect(ctx, client.userID, client.sessionID)
+		if err != nil {
+			log.Printf("Failed to clear presence for %s: %v", client.userID, err)
+			return
+		}
+		if wentOffline {
+			h.publishPresence(ctx, client, event.PresenceStatusOffline)
+		}
+	})
+	if !queued {
+		// ハートビートで延長されなくなるので、PresenceTTLが過ぎればオフラインになる
+		log.Printf("Presence queue is full, dropped offline for %s (session %s)", client.userID, client.sessionID)
+	}
+}
+
+// 接続中のセッションの期限を延ばす。Hubのループの中で呼ぶ
+func (h *Hub) presenceHeartbeat() {
+	sessions := make(map[uuid.UUID][]uuid.UUID)
+	h.mu.RLock()
+	for userID, clients := range h.sessions {
+		for sessionID, client := range clients {
+			if client.online && client.state == sessionAttached {
+				sessions[userID] = append(sessions[userID], sessionID)
+			}
+		}
+	}
+	h.mu.RUnlock()
+	if len(sessions) == 0 {
+		return
+	}
+
+	queued := h.enqueuePresence(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+		defer cancel()
+
+		if err := h.presence.Heartbeat(ctx, sessions); err != nil {
+			log.Printf("Failed to refresh presence: %v", err)
+		}
+	})
+	if !queued {
+		log.Printf("Presence queue is full, dropped heartbeat for %d users", len(sessions))
+	}
+}
+
+// ユーザーが参加しているギルドごとにPRESENCE_UPDATEを流す
+func (h *Hub) publishPresence(ctx context.Context, client *Client, status event.PresenceStatus) {
+	guildIDs, _ := h.guilds.GetGuilds(client)
+	now := time.Now()
+	for _, guildID := range guildIDs {
+		data, err := json.Marshal(event.PresenceUpdatedEvent{
+			GuildID:   guildID,
+			UserID:    client.userID,
+			Status:    status,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			log.Printf("Error marshaling presence event: %v", err)
+			return
+		}
+		if err := h.publisher.PublishToGuild(ctx, guildID, &event.Event{
+			Type: event.EventTypePresenceUpdate,
+			Data: data,
+		}); err != nil {
+			log.Printf("Failed to publish presence for %s to guild %s: %v", client.userID, guildID, err)
+		}
+	}
+}
+
+// ギルドのメンバーのうち、このレプリカにあるセッションにだけ送る
+func (h *Hub) sendToGuild(guildID uuid.UUID, evt *event.Event) {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		log.Printf("Error marshaling event: %v", err)
+		return
+	}
+
+	for _, client := range h.guilds.GetMembers(guildID) {
+		h.dispatch(client, evt, body)
+	}
+}
+
+// クライアントが選んだ状態を保存し、見え方が変わった場合はギルドのメンバーに知らせる
+type PresenceUpdateProcessor struct{}
+
+func (p PresenceUpdateProcessor) ProcessClient(hub *Hub, client *Client, evt *event.Event) error {
+	var req event.PresenceUpdate
+	if err := json.Unmarshal(evt.Data, &req); err != nil {
+		return newClientEventError(event.ErrorCodeInvalidPayload, "invalid PRESENCE_UPDATE payload")
+	}
+	switch req.Status {
+	case event.PresenceStatusOnline, event.PresenceStatusIdle, event.PresenceStatusDnd:
+	default:
+		return newClientEventError(event.ErrorCodeInvalidPayload, "invalid presence status %q", req.Status)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+	changed, err := hub.presence.SetStatus(ctx, client.userID, req.Status)
+	if err != nil {
+		return err
+	}
+	if changed {
+		hub.publishPresence(ctx, client, req.Status)
+	}
+	return nil
+}
+
+type GuildEvent interface {
+	GetGuildID() uuid.UUID
+}
+
+type GuildEventProcessor[T GuildEvent] struct{}
+
+func (p GuildEventProcessor[T]) Process(hub *Hub, evt *event.Event) error {
+	var e T
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.sendToGuild(e.GetGuildID(), evt)
+	return nil
+}
@@ -0,0 +1,278 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"realtime-service/internal/event"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// 切断されたセッションを残し、イベントを溜め続ける時間
+	ResumeWindow = 2 * time.Minute
+	// セッションごとに再送用に残すイベントの数
+	ReplayBufferSize = 1000
+
+	// 再開の処理中に溜めておけるイベントの数。超えた場合は再開を諦める
+	maxPendingEvents     = 1000
+	resumeTimeout        = 5 * time.Second
+	sessionSweepInterval = 15 * time.Second
+)
+
+type sessionState int
+
+const (
+	sessionAttached sessionState = iota
+	// 接続が切れ、再開を待っている。イベントは送らずにストアにだけ積む
+	sessionDetached
+	// 取りこぼしを再送している。新しいイベントはpendingに溜める
+	sessionResuming
+	// 破棄された、または他のレプリカに引き継いだ
+	sessionClosed
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	errReplayGap       = errors.New("replay buffer does not cover the requested range")
+	errPendingOverflow = errors.New("too many events while resuming")
+)
+
+// 他のレプリカでセッションを再開するために保存する状態
+type SessionState struct {
+	SessionID  uuid.UUID
+	UserID     uuid.UUID
+	ChannelIDs []uuid.UUID
+}
+
+// 再送用に残しているイベント
+type ReplayEntry struct {
+	Seq int64
+	// Redisに流れてきたペイロードから求めた値。レプリカ間でセッションを引き継ぐときの重複排除に使う
+	DedupeKey string
+	Frame     []byte
+}
+
+// セッションの状態と再送用のイベントを、レプリカをまたいで保存する
+type SessionStore interface {
+	// 書き込みはキューに積むだけで、積んだ順に非同期で保存する。
+	// キューが溢れていて書き込みを捨てた場合はfalseを返す
+	Append(sessionID uuid.UUID, seq int64, dedupeKey string, frame []byte) bool
+	SaveSession(state *SessionState) bool
+	// それまでに積んだ書き込みが終わった後にdoneを呼ぶ。doneの中でブロックしてはいけない
+	Flush(done func())
+
+	LoadSession(ctx context.Context, sessionID uuid.UUID) (*SessionState, error)
+	// afterより後のイベントをseqの昇順で返す
+	ReadAfter(ctx context.Context, sessionID uuid.UUID, after int64) ([]*ReplayEntry, error)
+	// セッションを持っているレプリカに手放させ、最後に割り当てたseqを返す
+	RequestTakeover(ctx context.Context, userID, sessionID uuid.UUID) (int64, error)
+}
+
+// 切断前のセッションを再開し、lastSeqより後のイベントを再送してから読み書きを始める。
+// 再開できなかった場合はfalseを返すので、呼び出し元がINVALID_SESSIONを返す
+func (h *Hub) Resume(ws *websocket.Conn, userID, sessionID uuid.UUID, lastSeq int64) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), resumeTimeout)
+	defer cancel()
+
+	client, finalSeq, err := h.beginResume(ctx, userID, sessionID, lastSeq)
+	if err != nil {
+		log.Printf("Failed to resume session %s for user %s: %v", sessionID, userID, err)
+		h.metrics.InvalidSessions.Inc()
+		return false
+	}
+
+	entries, err := h.store.ReadAfter(ctx, sessionID, lastSeq)
+	if err == nil {
+		err = checkReplay(entries, lastSeq, finalSeq)
+	}
+
+	h.do(func() {
+		if err == nil {
+			err = h.finishResume(client, ws, entries, finalSeq)
+		}
+		if err != nil {
+			h.removeSession(client)
+		}
+	})
+	if err != nil {
+		log.Printf("Failed to resume session %s for user %s: %v", sessionID, userID, err)
+		h.metrics.InvalidSessions.Inc()
+		return false
+	}
+
+	h.metrics.SessionResumes.Inc()
+	log.Printf("Session resumed: user %s session %s (replayed %d events)", userID, sessionID, len(entries))
+	return true
+}
+
+// セッションを再開中の状態にして、再送の終わりとなるseqを返す
+func (h *Hub) beginResume(ctx context.Context, userID, sessionID uuid.UUID, lastSeq int64) (*Client, int64, error) {
+	var (
+		client   *Client
+		finalSeq int64
+		err      error
+	)
+	h.do(func() {
+		client = h.getSession(userID, sessionID)
+		if client == nil {
+			return
+		}
+		if client.state == sessionResuming || client.unresumable || lastSeq > client.seq {
+			err = ErrSessionNotFound
+			return
+		}
+		// 切断に気づく前に再接続してきた場合は古い接続を閉じる
+		h.detachConn(client)
+		client.state = sessionResuming
+		finalSeq = client.seq
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if client == nil {
+		return h.takeOverSession(ctx, userID, sessionID)
+	}
+
+	// 再開中にした時点までのイベントが保存し終わるのを待つ
+	flushed := make(chan struct{})
+	h.store.Flush(func() { close(flushed) })
+	select {
+	case <-flushed:
+	case <-ctx.Done():
+		h.do(func() { h.removeSession(client) })
+		return nil, 0, ctx.Err()
+	}
+	return client, finalSeq, nil
+}
+
+// 他のレプリカにあるセッションを引き継ぐ。取りこぼしを出さないよう、先にこのレプリカで
+// 購読を始めてから元のレプリカに手放させ、重なった分はDedupeKeyで取り除く
+func (h *Hub) takeOverSession(ctx context.Context, userID, sessionID uuid.UUID) (*Client, int64, error) {
+	state, err := h.store.LoadSession(ctx, sessionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if state.UserID != userID {
+		return nil, 0, ErrSessionNotFound
+	}
+
+	channelIDs := state.ChannelIDs
+	if len(channelIDs) > 0 {
+		channelIDs, err = h.access.FilterAccessible(ctx, userID, channelIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	client := newClient(h, userID, sessionID)
+	client.state = sessionResuming
+	added := false
+	h.do(func() {
+		if added = h.addSession(client); !added {
+			return
+		}
+		for _, channelID := range channelIDs {
+			h.subscriptions.SubscribeChannel(client, channelID)
+		}
+	})
+	if !added {
+		return nil, 0, ErrSessionNotFound
+	}
+
+	finalSeq, err := h.store.RequestTakeover(ctx, userID, sessionID)
+	if err != nil {
+		h.do(func() { h.removeSession(client) })
+		return nil, 0, err
+	}
+	return client, finalSeq, nil
+}
+
+// 再送するイベントがlastSeqの次からfinalSeqまで欠けずに揃っているか確かめる
+func checkReplay(entries []*ReplayEntry, lastSeq, finalSeq int64) error {
+	if lastSeq > finalSeq || int64(len(entries)) != finalSeq-lastSeq {
+		return errReplayGap
+	}
+	for i, entry := range entries {
+		if entry.Seq != lastSeq+int64(i)+1 {
+			return errReplayGap
+		}
+	}
+	return nil
+}
+
+// RESUMED、取りこぼしたイベント、再開中に溜めたイベントの順に送る。Hubのループの中で呼ぶ
+func (h *Hub) finishResume(client *Client, ws *websocket.Conn, entries []*ReplayEntry, finalSeq int64) error {
+	if client.state != sessionResuming {
+		return ErrSessionNotFound
+	}
+	if client.pendingOverflow {
+		return errPendingOverflow
+	}
+
+	resumed, err := json.Marshal(event.EventResponse[event.Resumed]{
+		Type: event.EventTypeResumed,
+		Data: event.Resumed{
+			SessionID: client.sessionID,
+			Replayed:  len(entries),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	conn := newConnection(client, ws, len(entries)+len(client.pending)+1)
+	conn.send <- resumed
+	replayed := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		conn.send <- entry.Frame
+		if entry.DedupeKey != "" {
+			replayed[entry.DedupeKey] = true
+		}
+	}
+
+	client.seq = finalSeq
+	h.attach(client, conn)
+
+	pending := client.pending
+	client.pending = nil
+	for _, evt := range pending {
+		if evt.DedupeKey != "" && replayed[evt.DedupeKey] {
+			continue
+		}
+		body, err := json.Marshal(evt)
+		if err != nil {
+			log.Printf("Error marshaling event: %v", err)
+			continue
+		}
+		h.dispatch(client, evt, body)
+	}
+
+	h.saveSession(client)
+	h.sessionOnline(client)
+	conn.start()
+	return nil
+}
+
+// 他のレプリカでの再開のためにセッションを手放す。それまでに積んだイベントを保存し終えてからreplyを呼ぶ
+func (h *Hub) HandleTakeover(userID, sessionID uuid.UUID, reply func(seq int64)) {
+	h.do(func() {
+		client := h.getSession(userID, sessionID)
+		// 再開中のセッションは、このレプリカ自身が引き継ごうとしているものなので手放さない。
+		// 保存できなかったイベントがあるセッションは引き継いでも再送できないので、応答せずに再開を失敗させる
+		if client == nil || client.state == sessionResuming || client.unresumable {
+			return
+		}
+		finalSeq := client.seq
+		// オンライン状態は引き継ぎ先のセッションがそのまま使うので、オフラインにはしない
+		client.online = false
+		h.removeSession(client)
+		h.store.Flush(func() { go reply(finalSeq) })
+
+		log.Printf("Session handed over: user %s session %s (seq %d)", userID, sessionID, finalSeq)
+	})
+}
@@ -59,9 +59,33 @@ func (sm *SubscriptionManager) UnsubscribeAll(client *Client) {
 	}
 }
 
+func (sm *SubscriptionManager) GetChannels(client *Client) []uuid.UUID {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	channelIDs := make([]uuid.UUID, 0, len(client.channels))
+	for channelID := range client.channels {
+		channelIDs = append(channelIDs, channelID)
+	}
+	return channelIDs
+}
+
+func (sm *SubscriptionManager) IsSubscribed(client *Client, channelID uuid.UUID) bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	return sm.ChannelSubs[channelID][client]
+}
+
+// 呼び出し側が反復している間に購読が変わっても影響しないよう、コピーを返す
 func (sm *SubscriptionManager) GetSubscribers(channelID uuid.UUID) map[*Client]bool {
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
 
-	return sm.ChannelSubs[channelID]
+	subs := sm.ChannelSubs[channelID]
+	subscribers := make(map[*Client]bool, len(subs))
+	for client := range subs {
+		subscribers[client] = true
+	}
+	return subscribers
 }
@@ -0,0 +1,86 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"realtime-service/internal/event"
+	"time"
+)
+
+const (
+	// この時間内に次のTYPING_STARTが届かなければ入力中の表示を消す
+	TypingTimeout = 10 * time.Second
+	// 1つの接続から同じチャンネルに送れるTYPING_STARTの間隔
+	TypingThrottleInterval = 5 * time.Second
+
+	typingPublishTimeout = 3 * time.Second
+)
+
+// クライアントから届いたTYPING_STARTを検証し、Redis経由で全レプリカに配信する
+type TypingStartProcessor struct{}
+
+func (p TypingStartProcessor) ProcessClient(hub *Hub, client *Client, evt *event.Event) error {
+	var req event.TypingStart
+	if err := json.Unmarshal(evt.Data, &req); err != nil {
+		return newClientEventError(event.ErrorCodeInvalidPayload, "invalid TYPING_START payload")
+	}
+
+	if !hub.subscriptions.IsSubscribed(client, req.ChannelID) {
+		return newClientEventError(event.ErrorCodeForbidden, "not subscribed to channel %s", req.ChannelID)
+	}
+
+	// 間隔内の再送は捨てる
+	now := time.Now()
+	client.typingMu.Lock()
+	if sentAt, ok := client.typingSentAt[req.ChannelID]; ok && now.Sub(sentAt) < TypingThrottleInterval {
+		client.typingMu.Unlock()
+		return nil
+	}
+	client.typingSentAt[req.ChannelID] = now
+	client.typingMu.Unlock()
+
+	// 購読できても書き込めないチャンネルや、タイムアウト中のメンバーからは受け付けない
+	checkCtx, checkCancel := context.WithTimeout(context.Background(), accessCheckTimeout)
+	defer checkCancel()
+	canSend, disabledUntil, err := hub.sendChecker.CheckSend(checkCtx, client.userID, req.ChannelID)
+	if err != nil {
+		return err
+	}
+	if disabledUntil != nil {
+		clientErr := newClientEventError(event.ErrorCodeCommunicationDisabled, "communication disabled until %s", disabledUntil.Format(time.RFC3339))
+		clientErr.CommunicationDisabledUntil = disabledUntil
+		return clientErr
+	}
+	if !canSend {
+		return newClientEventError(event.ErrorCodeForbidden, "cannot send messages to channel %s", req.ChannelID)
+	}
+
+	data, err := json.Marshal(event.TypingStartedEvent{
+		ChannelID: req.ChannelID,
+		UserID:    client.userID,
+		ExpiresAt: now.Add(TypingTimeout),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), typingPublishTimeout)
+	defer cancel()
+	return hub.publisher.PublishToChannel(ctx, req.ChannelID, &event.Event{
+		Type: event.EventTypeTypingStart,
+		Data: data,
+	})
+}
+
+// Redisから届いたTYPING_STARTを、入力している本人以外の購読者に送る
+type TypingStartedProcessor struct{}
+
+func (p TypingStartedProcessor) Process(hub *Hub, evt *event.Event) error {
+	var e event.TypingStartedEvent
+	if err := json.Unmarshal(evt.Data, &e); err != nil {
+		return err
+	}
+
+	hub.broadcastToChannelExcept(e.ChannelID, evt, e.UserID)
+	return nil
+}
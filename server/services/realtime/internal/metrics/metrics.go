@@ -6,10 +6,17 @@ import (
 )
 
 type WebSocketMetrics struct {
+	// 接続中のセッションの数。同じユーザーの複数タブはそれぞれ数え、再開を待っているセッションは含めない
 	ActiveConnections prometheus.Gauge
-	TotalConnections  prometheus.Counter
-	MessageSent       prometheus.Counter
-	MessageReceived   prometheus.Counter
+	// 1つ以上のセッションを持つユーザーの数
+	ActiveUsers      prometheus.Gauge
+	TotalConnections prometheus.Counter
+	MessageSent      prometheus.Counter
+	MessageReceived  prometheus.Counter
+	SessionResumes   prometheus.Counter
+	InvalidSessions  prometheus.Counter
+	// セッションストアのキューが溢れて捨てた書き込みの数
+	SessionStoreDrops prometheus.Counter
 
 	collectors []prometheus.Collector
 }
@@ -18,7 +25,11 @@ func NewWebSocketMetrics() *WebSocketMetrics {
 	m := &WebSocketMetrics{
 		ActiveConnections: promauto.NewGauge(prometheus.GaugeOpts{
 			Name: "websocket_active_connections",
-			Help: "Number of active WebSocket connections",
+			Help: "Number of active WebSocket sessions",
+		}),
+		ActiveUsers: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "websocket_active_users",
+			Help: "Number of users with at least one active WebSocket session",
 		}),
 		TotalConnections: promauto.NewCounter(prometheus.CounterOpts{
 			Name: "websocket_total_connections",
@@ -32,13 +43,29 @@ func NewWebSocketMetrics() *WebSocketMetrics {
 			Name: "websocket_messages_received_total",
 			Help: "Total number of messages received from Redis Pub/Sub",
 		}),
+		SessionResumes: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "websocket_session_resumes_total",
+			Help: "Total number of WebSocket sessions resumed after a disconnect",
+		}),
+		InvalidSessions: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "websocket_invalid_sessions_total",
+			Help: "Total number of RESUME requests rejected with INVALID_SESSION",
+		}),
+		SessionStoreDrops: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "websocket_session_store_drops_total",
+			Help: "Total number of session store writes dropped because the write queue was full",
+		}),
 	}
 
 	m.collectors = []prometheus.Collector{
 		m.ActiveConnections,
+		m.ActiveUsers,
 		m.TotalConnections,
 		m.MessageSent,
 		m.MessageReceived,
+		m.SessionResumes,
+		m.InvalidSessions,
+		m.SessionStoreDrops,
 	}
 
 	return m
@@ -0,0 +1,125 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"realtime-service/internal/event"
+	"realtime-service/internal/hub"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// guild-serviceも同じキーを読むので、形式を変えるときは両方を直す
+const keyPrefix = "presence:"
+
+// presence:{userID}には接続中のセッションIDを、有効期限(ミリ秒)をスコアにして入れる。
+// presence:{userID}:statusにはユーザーが選んだ状態を入れ、onlineの場合は消す
+type RedisTracker struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewRedisTracker(client *redis.Client, ttl time.Duration) *RedisTracker {
+	return &RedisTracker{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func sessionsKey(userID uuid.UUID) string {
+	return keyPrefix + userID.String()
+}
+
+func statusKey(userID uuid.UUID) string {
+	return keyPrefix + userID.String() + ":status"
+}
+
+func (t *RedisTracker) expiry(now time.Time) float64 {
+	return float64(now.Add(t.ttl).UnixMilli())
+}
+
+func nowScore(now time.Time) string {
+	return strconv.FormatInt(now.UnixMilli(), 10)
+}
+
+func (t *RedisTracker) Connect(ctx context.Context, userID, sessionID uuid.UUID) (event.PresenceStatus, bool, error) {
+	now := time.Now()
+	key := sessionsKey(userID)
+
+	// 他のレプリカと同時に接続しても、どちらか一方だけがオンラインになったと判断するようにする
+	pipe := t.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", nowScore(now))
+	live := pipe.ZCard(ctx, key)
+	pipe.ZAdd(ctx, key, redis.Z{Score: t.expiry(now), Member: sessionID.String()})
+	pipe.Expire(ctx, key, t.ttl)
+	status := pipe.Get(ctx, statusKey(userID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return "", false, err
+	}
+
+	return toStatus(status.Val()), live.Val() == 0, nil
+}
+
+func (t *RedisTracker) Disconnect(ctx context.Context, userID, sessionID uuid.UUID) (bool, error) {
+	now := time.Now()
+	key := sessionsKey(userID)
+
+	pipe := t.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", nowScore(now))
+	removed := pipe.ZRem(ctx, key, sessionID.String())
+	live := pipe.ZCard(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return removed.Val() > 0 && live.Val() == 0, nil
+}
+
+func (t *RedisTracker) Heartbeat(ctx context.Context, sessions map[uuid.UUID][]uuid.UUID) error {
+	now := time.Now()
+	score := t.expiry(now)
+
+	pipe := t.client.Pipeline()
+	for userID, sessionIDs := range sessions {
+		key := sessionsKey(userID)
+		members := make([]redis.Z, len(sessionIDs))
+		for i, sessionID := range sessionIDs {
+			members[i] = redis.Z{Score: score, Member: sessionID.String()}
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, t.ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (t *RedisTracker) SetStatus(ctx context.Context, userID uuid.UUID, status event.PresenceStatus) (bool, error) {
+	key := statusKey(userID)
+
+	pipe := t.client.TxPipeline()
+	previous := pipe.Get(ctx, key)
+	if status == event.PresenceStatusOnline {
+		pipe.Del(ctx, key)
+	} else {
+		pipe.Set(ctx, key, string(status), 0)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	return toStatus(previous.Val()) != status, nil
+}
+
+func toStatus(value string) event.PresenceStatus {
+	switch status := event.PresenceStatus(value); status {
+	case event.PresenceStatusIdle, event.PresenceStatusDnd:
+		return status
+	default:
+		return event.PresenceStatusOnline
+	}
+}
+
+var _ hub.PresenceTracker = (*RedisTracker)(nil)
@@ -0,0 +1,47 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"realtime-service/internal/event"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	RedisChannelMessagePrefix = "message"
+	RedisChannelGuildPrefix   = "guild"
+)
+
+type RedisPublisher struct {
+	client *redis.Client
+}
+
+func NewRedisPublisher(client *redis.Client) *RedisPublisher {
+	return &RedisPublisher{
+		client: client,
+	}
+}
+
+// message-serviceと同じRedisチャンネルに流すことで、他のレプリカに接続しているクライアントにも届ける
+func (p *RedisPublisher) PublishToChannel(ctx context.Context, channelID uuid.UUID, evt *event.Event) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	redisChannel := RedisChannelMessagePrefix + ":" + channelID.String()
+	return p.client.Publish(ctx, redisChannel, payload).Err()
+}
+
+// guild-serviceと同じRedisチャンネルに流し、各レプリカがギルドのメンバーに届ける
+func (p *RedisPublisher) PublishToGuild(ctx context.Context, guildID uuid.UUID, evt *event.Event) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	redisChannel := RedisChannelGuildPrefix + ":" + guildID.String()
+	return p.client.Publish(ctx, redisChannel, payload).Err()
+}
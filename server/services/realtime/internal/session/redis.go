@@ -0,0 +1,308 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"realtime-service/internal/hub"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix = "realtime:session:"
+	// 再開するレプリカから、セッションを持っているレプリカへの引き継ぎ要求
+	takeoverChannel = "realtime:session-takeover"
+
+	// この時間内に元のレプリカが応答しなければ、セッションは既に無いものとみなす
+	takeoverTimeout = 2 * time.Second
+	replyTimeout    = 3 * time.Second
+
+	opQueueSize  = 4096
+	maxBatchSize = 256
+)
+
+type RedisStore struct {
+	client    *redis.Client
+	retention time.Duration
+	maxLen    int64
+	ops       chan op
+}
+
+// 書き込みキューの1件。applyが無いものはFlushの目印
+type op struct {
+	apply func(ctx context.Context, pipe redis.Pipeliner)
+	done  func()
+}
+
+// retentionは最後の書き込みからセッションを残す時間、maxLenはセッションごとに残すイベントの数
+func NewRedisStore(client *redis.Client, retention time.Duration, maxLen int64) *RedisStore {
+	return &RedisStore{
+		client:    client,
+		retention: retention,
+		maxLen:    maxLen,
+		ops:       make(chan op, opQueueSize),
+	}
+}
+
+func stateKey(sessionID uuid.UUID) string {
+	return keyPrefix + sessionID.String()
+}
+
+func channelsKey(sessionID uuid.UUID) string {
+	return keyPrefix + sessionID.String() + ":channels"
+}
+
+// seqをそのままストリームのIDにして、XRANGEで続きを読めるようにする
+func eventsKey(sessionID uuid.UUID) string {
+	return keyPrefix + sessionID.String() + ":events"
+}
+
+// キューに積まれた書き込みを、積まれた順にまとめてパイプラインで実行する
+func (s *RedisStore) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case first := <-s.ops:
+			batch := []op{first}
+		drain:
+			for len(batch) < maxBatchSize {
+				select {
+				case o := <-s.ops:
+					batch = append(batch, o)
+				default:
+					break drain
+				}
+			}
+
+			pipe := s.client.Pipeline()
+			for _, o := range batch {
+				if o.apply != nil {
+					o.apply(ctx, pipe)
+				}
+			}
+			if pipe.Len() > 0 {
+				if _, err := pipe.Exec(ctx); err != nil {
+					log.Printf("Error writing session store: %v", err)
+				}
+			}
+			for _, o := range batch {
+				if o.done != nil {
+					o.done()
+				}
+			}
+		}
+	}
+}
+
+// Hubのループから呼ばれるので、キューが溢れている場合は待たずに捨ててfalseを返す
+func (s *RedisStore) enqueue(o op) bool {
+	select {
+	case s.ops <- o:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *RedisStore) Append(sessionID uuid.UUID, seq int64, dedupeKey string, frame []byte) bool {
+	return s.enqueue(op{apply: func(ctx context.Context, pipe redis.Pipeliner) {
+		key := eventsKey(sessionID)
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: key,
+			MaxLen: s.maxLen,
+			Approx: true,
+			ID:     strconv.FormatInt(seq, 10) + "-0",
+			Values: []any{"key", dedupeKey, "frame", frame},
+		})
+		pipe.Expire(ctx, key, s.retention)
+	}})
+}
+
+func (s *RedisStore) SaveSession(state *hub.SessionState) bool {
+	return s.enqueue(op{apply: func(ctx context.Context, pipe redis.Pipeliner) {
+		key := stateKey(state.SessionID)
+		pipe.HSet(ctx, key, "user_id", state.UserID.String())
+		pipe.Expire(ctx, key, s.retention)
+
+		chKey := channelsKey(state.SessionID)
+		pipe.Del(ctx, chKey)
+		if len(state.ChannelIDs) > 0 {
+			members := make([]any, len(state.ChannelIDs))
+			for i, channelID := range state.ChannelIDs {
+				members[i] = channelID.String()
+			}
+			pipe.SAdd(ctx, chKey, members...)
+			pipe.Expire(ctx, chKey, s.retention)
+		}
+
+		// イベントの無い間にストリームだけが消えないよう、期限をそろえる
+		pipe.Expire(ctx, eventsKey(state.SessionID), s.retention)
+	}})
+}
+
+func (s *RedisStore) Flush(done func()) {
+	s.ops <- op{done: done}
+}
+
+func (s *RedisStore) LoadSession(ctx context.Context, sessionID uuid.UUID) (*hub.SessionState, error) {
+	userID, err := s.client.HGet(ctx, stateKey(sessionID), "user_id").Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, hub.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	parsedUserID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.client.SMembers(ctx, channelsKey(sessionID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	channelIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		channelID, err := uuid.Parse(member)
+		if err != nil {
+			return nil, err
+		}
+		channelIDs = append(channelIDs, channelID)
+	}
+
+	return &hub.SessionState{
+		SessionID:  sessionID,
+		UserID:     parsedUserID,
+		ChannelIDs: channelIDs,
+	}, nil
+}
+
+func (s *RedisStore) ReadAfter(ctx context.Context, sessionID uuid.UUID, after int64) ([]*hub.ReplayEntry, error) {
+	messages, err := s.client.XRange(ctx, eventsKey(sessionID), strconv.FormatInt(after+1, 10)+"-0", "+").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*hub.ReplayEntry, 0, len(messages))
+	for _, message := range messages {
+		seqStr, _, _ := strings.Cut(message.ID, "-")
+		seq, err := strconv.ParseInt(seqStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid replay entry id %q: %w", message.ID, err)
+		}
+		dedupeKey, _ := message.Values["key"].(string)
+		frame, _ := message.Values["frame"].(string)
+		entries = append(entries, &hub.ReplayEntry{
+			Seq:       seq,
+			DedupeKey: dedupeKey,
+			Frame:     []byte(frame),
+		})
+	}
+	return entries, nil
+}
+
+type takeoverRequest struct {
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"session_id"`
+	ReplyTo   string    `json:"reply_to"`
+}
+
+type takeoverReply struct {
+	Seq int64 `json:"seq"`
+}
+
+func (s *RedisStore) RequestTakeover(ctx context.Context, userID, sessionID uuid.UUID) (int64, error) {
+	replyTo := takeoverChannel + ":" + uuid.NewString()
+	pubsub := s.client.Subscribe(ctx, replyTo)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.Printf("Error closing pubsub: %v", err)
+		}
+	}()
+	// 応答を取りこぼさないよう、購読が始まってから要求を出す
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return 0, err
+	}
+
+	payload, err := json.Marshal(takeoverRequest{
+		UserID:    userID,
+		SessionID: sessionID,
+		ReplyTo:   replyTo,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := s.client.Publish(ctx, takeoverChannel, payload).Err(); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, takeoverTimeout)
+	defer cancel()
+	msg, err := pubsub.ReceiveMessage(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return 0, hub.ErrSessionNotFound
+		}
+		return 0, err
+	}
+
+	var reply takeoverReply
+	if err := json.Unmarshal([]byte(msg.Payload), &reply); err != nil {
+		return 0, err
+	}
+	return reply.Seq, nil
+}
+
+// 他のレプリカからの引き継ぎ要求を待ち受け、このレプリカにあるセッションであれば手放す
+func (s *RedisStore) ListenTakeover(ctx context.Context, h *hub.Hub) error {
+	pubsub := s.client.Subscribe(ctx, takeoverChannel)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.Printf("Error closing pubsub: %v", err)
+		}
+	}()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-ch:
+			if msg == nil {
+				continue
+			}
+			var req takeoverRequest
+			if err := json.Unmarshal([]byte(msg.Payload), &req); err != nil {
+				log.Printf("Error unmarshaling takeover request: %v", err)
+				continue
+			}
+			h.HandleTakeover(req.UserID, req.SessionID, func(seq int64) {
+				s.reply(req.ReplyTo, seq)
+			})
+		}
+	}
+}
+
+func (s *RedisStore) reply(replyTo string, seq int64) {
+	payload, err := json.Marshal(takeoverReply{Seq: seq})
+	if err != nil {
+		log.Printf("Error marshaling takeover reply: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replyTimeout)
+	defer cancel()
+	if err := s.client.Publish(ctx, replyTo, payload).Err(); err != nil {
+		log.Printf("Error publishing takeover reply: %v", err)
+	}
+}
+
+var _ hub.SessionStore = (*RedisStore)(nil)
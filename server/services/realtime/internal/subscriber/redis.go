@@ -3,9 +3,11 @@ package subscriber
 import (
 	"context"
 	"encoding/json"
+	"hash/fnv"
 	"log"
 	"realtime-service/internal/event"
 	"realtime-service/internal/hub"
+	"strconv"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -14,6 +16,8 @@ type Pattern string
 
 const (
 	MessageEventPattern Pattern = "message:*"
+	UserEventPattern    Pattern = "user:*"
+	GuildEventPattern   Pattern = "guild:*"
 )
 
 type Subscriber struct {
@@ -56,7 +60,15 @@ func (s *Subscriber) Start(ctx context.Context) error {
 				log.Printf("Error unmarshaling event: %v", err)
 				continue
 			}
+			evt.DedupeKey = dedupeKey(msg.Payload)
 			s.hub.Broadcast(&evt)
 		}
 	}
 }
+
+// 発行元がイベントにタイムスタンプを含めているので、別々のPublishが同じ値になることはまずない
+func dedupeKey(payload string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(payload))
+	return strconv.FormatUint(h.Sum64(), 16)
+}
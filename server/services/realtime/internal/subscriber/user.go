@@ -0,0 +1,11 @@
+package subscriber
+
+import (
+	"realtime-service/internal/hub"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func NewUserSubscriber(redisClient *redis.Client, hub *hub.Hub) *Subscriber {
+	return NewSubscriber(redisClient, hub, UserEventPattern)
+}
@@ -36,6 +36,7 @@ type GetPasswordByEmailParams struct {
 type UserRepository interface {
 	Create(ctx context.Context, params *CreateUserParams) (*User, error)
 	GetPasswordByEmail(ctx context.Context, email string) (*GetPasswordByEmailParams, error)
+	GetPasswordByID(ctx context.Context, id uuid.UUID) (string, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	ExistsByDisplayId(ctx context.Context, displayId string) (bool, error)
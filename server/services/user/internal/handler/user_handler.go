@@ -275,3 +275,22 @@ func (h *UserHandler) GetUsersByIDs(ctx context.Context, req *pb.GetUsersByIDsRe
 
 	return &pb.GetUsersByIDsResponse{Users: pbUsers}, nil
 }
+
+func (h *UserHandler) VerifyPassword(ctx context.Context, req *pb.VerifyPasswordRequest) (*pb.VerifyPasswordResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		h.logger.Warn("Invalid user ID format", "user_id", req.UserId, "error", err)
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidUserID.Error())
+	}
+
+	valid, err := h.userUsecase.VerifyPassword(ctx, &usecase.VerifyPasswordParams{
+		ID:       userID,
+		Password: req.Password,
+	})
+	if err != nil {
+		h.logger.Error("Failed to verify password", "user_id", userID, "error", err)
+		return nil, status.Error(codes.Internal, "failed to verify password")
+	}
+
+	return &pb.VerifyPasswordResponse{Valid: valid}, nil
+}
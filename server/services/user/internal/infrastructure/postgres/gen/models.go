@@ -9,12 +9,33 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ActorID    pgtype.UUID
+	ActionType string
+	TargetID   pgtype.UUID
+	Changes    []byte
+	Reason     string
+	CreatedAt  pgtype.Timestamp
+}
+
+type Ban struct {
+	GuildID     uuid.UUID
+	UserID      uuid.UUID
+	ModeratorID pgtype.UUID
+	Reason      string
+	ExpiresAt   pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
+}
+
 type Category struct {
 	ID        uuid.UUID
 	GuildID   uuid.UUID
 	Name      string
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	Position  int32
 }
 
 type Channel struct {
@@ -23,12 +44,31 @@ type Channel struct {
 	CategoryID uuid.UUID
 	CreatedAt  pgtype.Timestamp
 	UpdatedAt  pgtype.Timestamp
+	Position   int32
+}
+
+type ChannelPurgeJob struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	Target    string
+	Attempts  int32
+	NextRunAt pgtype.Timestamp
+	LastError *string
+	CreatedAt pgtype.Timestamp
+}
+
+type ChannelReadState struct {
+	UserID            uuid.UUID
+	ChannelID         uuid.UUID
+	LastReadMessageID uuid.UUID
+	LastReadAt        pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
 }
 
 type Guild struct {
 	ID               uuid.UUID
 	Name             string
-	OwnerID          uuid.UUID
+	OwnerID          pgtype.UUID
 	Description      string
 	IconUrl          string
 	DefaultChannelID uuid.UUID
@@ -44,23 +84,92 @@ type Invite struct {
 	CurrentUses int32
 	ExpiresAt   pgtype.Timestamp
 	CreatedAt   pgtype.Timestamp
+	Vanity      bool
+}
+
+type InviteUse struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	InviteCode string
+	UserID     uuid.UUID
+	UsedAt     pgtype.Timestamp
 }
 
 type Member struct {
-	UserID    uuid.UUID
+	UserID                     uuid.UUID
+	GuildID                    uuid.UUID
+	JoinedAt                   pgtype.Timestamp
+	UpdatedAt                  pgtype.Timestamp
+	CommunicationDisabledUntil pgtype.Timestamp
+}
+
+type MemberRole struct {
 	GuildID   uuid.UUID
-	JoinedAt  pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt pgtype.Timestamp
 }
 
 type Message struct {
-	ID        uuid.UUID
-	SenderID  uuid.UUID
-	ChannelID uuid.UUID
-	Content   string
-	ReplyID   pgtype.UUID
+	ID         uuid.UUID
+	SenderID   uuid.UUID
+	ChannelID  uuid.UUID
+	Content    string
+	ReplyID    pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+	EditedAt   pgtype.Timestamp
+	PinnedAt   pgtype.Timestamp
+	ContentTsv interface{}
+}
+
+type MessageAttachment struct {
+	ID          uuid.UUID
+	MessageID   uuid.UUID
+	ObjectKey   string
+	Filename    string
+	Size        int64
+	ContentType string
+	Url         string
+	Position    int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type MessageMention struct {
+	MessageID uuid.UUID
+	Type      string
+	TargetID  uuid.UUID
+	Position  int32
+}
+
+type MessageReaction struct {
+	MessageID uuid.UUID
+	UserID    uuid.UUID
+	Emoji     string
 	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
+}
+
+type PermissionOverwrite struct {
+	ID         uuid.UUID
+	GuildID    uuid.UUID
+	ChannelID  pgtype.UUID
+	CategoryID pgtype.UUID
+	RoleID     pgtype.UUID
+	UserID     pgtype.UUID
+	Allow      int64
+	Deny       int64
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
+type Role struct {
+	ID          uuid.UUID
+	GuildID     uuid.UUID
+	Name        string
+	Permissions int64
+	Position    int32
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
 }
 
 type User struct {
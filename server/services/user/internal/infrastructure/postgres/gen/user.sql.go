@@ -101,6 +101,17 @@ func (q *Queries) GetPasswordByEmail(ctx context.Context, email string) (*GetPas
 	return &i, err
 }
 
+const getPasswordByID = `-- name: GetPasswordByID :one
+SELECT password_hash FROM users WHERE id = $1
+`
+
+func (q *Queries) GetPasswordByID(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getPasswordByID, id)
+	var password_hash string
+	err := row.Scan(&password_hash)
+	return password_hash, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT id, display_id, username, email, bio, icon_url, created_at FROM users WHERE id = $1
 `
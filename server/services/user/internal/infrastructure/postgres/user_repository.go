@@ -59,6 +59,17 @@ func (r *userRepository) GetPasswordByEmail(ctx context.Context, email string) (
 	}, nil
 }
 
+func (r *userRepository) GetPasswordByID(ctx context.Context, id uuid.UUID) (string, error) {
+	passwordHash, err := r.queries.GetPasswordByID(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", domain.ErrUserNotFound
+		}
+		return "", err
+	}
+	return passwordHash, nil
+}
+
 func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	dbUser, err := r.queries.GetUserByID(ctx, id)
 	if err != nil {
@@ -18,6 +18,7 @@ type UserUsecase interface {
 	GetUserByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	Update(ctx context.Context, params *UpdateParams) (*domain.User, error)
 	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.User, error)
+	VerifyPassword(ctx context.Context, params *VerifyPasswordParams) (bool, error)
 }
 
 type Config struct {
@@ -38,6 +39,11 @@ type LoginParams struct {
 	Password string `validate:"required,min=8"`
 }
 
+type VerifyPasswordParams struct {
+	ID       uuid.UUID `validate:"required"`
+	Password string    `validate:"required"`
+}
+
 type UpdateParams struct {
 	ID        uuid.UUID `validate:"required"`
 	DisplayID string    `validate:"required,min=3,max=20,display_id"`
@@ -136,6 +142,25 @@ func (u *userUsecase) Login(ctx context.Context, params *LoginParams) (*string,
 	return &tokenString, nil
 }
 
+// ユーザーが存在しない場合もfalseを返す
+func (u *userUsecase) VerifyPassword(ctx context.Context, params *VerifyPasswordParams) (bool, error) {
+	if err := u.validator.Struct(params); err != nil {
+		return false, nil
+	}
+	passwordHash, err := u.userRepo.GetPasswordByID(ctx, params.ID)
+	if err != nil {
+		if err == domain.ErrUserNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(params.Password)); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (u *userUsecase) Update(ctx context.Context, params *UpdateParams) (*domain.User, error) {
 	if err := u.validator.Struct(params); err != nil {
 		return nil, domain.ErrInvalidUserData
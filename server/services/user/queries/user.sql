@@ -6,6 +6,9 @@ RETURNING id, display_id, username, email, bio, icon_url, created_at;
 -- name: GetPasswordByEmail :one
 SELECT id, password_hash FROM users WHERE email = $1;
 
+-- name: GetPasswordByID :one
+SELECT password_hash FROM users WHERE id = $1;
+
 -- name: GetUserByID :one
 SELECT id, display_id, username, email, bio, icon_url, created_at FROM users WHERE id = $1;
 
@@ -0,0 +1,30 @@
+package metadata
+
+import (
+	"context"
+	"net/url"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// api-gatewayがX-Audit-Log-Reasonヘッダーを転送するときのキー
+const AuditLogReasonKey = "x-audit-log-reason"
+
+// ヘッダーにはASCIIしか入らないので、理由はURLエンコードして送られる。ない場合は空文字を返す
+func GetAuditLogReasonFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	reasons := md.Get(AuditLogReasonKey)
+	if len(reasons) == 0 {
+		return ""
+	}
+
+	reason, err := url.PathUnescape(reasons[0])
+	if err != nil {
+		return reasons[0]
+	}
+	return reason
+}